@@ -0,0 +1,108 @@
+package azurerm
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+// appServiceSiteConfigCustomizeDiff validates the `site_config` block against the App Service Plan it's
+// deployed into, so that invalid combinations are caught at plan time rather than as an error from ARM
+func appServiceSiteConfigCustomizeDiff(diff *schema.ResourceDiff, v interface{}) error {
+	siteConfigs := diff.Get("site_config").([]interface{})
+	if len(siteConfigs) == 0 || siteConfigs[0] == nil {
+		return nil
+	}
+
+	// values which are interpolated from other resources aren't known until apply, so can't be validated
+	siteConfig := make(map[string]interface{})
+	for k, val := range siteConfigs[0].(map[string]interface{}) {
+		if diff.NewValueKnown(fmt.Sprintf("site_config.0.%s", k)) {
+			siteConfig[k] = val
+		}
+	}
+
+	if err := validateAppServiceSiteConfigJava(siteConfig); err != nil {
+		return err
+	}
+
+	// the remaining checks depend on the App Service Plan, which may not exist yet
+	if !diff.NewValueKnown("app_service_plan_id") {
+		return nil
+	}
+
+	// avoid looking up the App Service Plan during every plan when neither it nor the `site_config` has changed
+	if diff.Id() != "" && !diff.HasChange("site_config") && !diff.HasChange("app_service_plan_id") {
+		return nil
+	}
+
+	appServicePlanId := diff.Get("app_service_plan_id").(string)
+	id, err := parseAzureResourceID(appServicePlanId)
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	name := id.Path["serverfarms"]
+
+	client := v.(*ArmClient).appServicePlansClient
+	ctx := v.(*ArmClient).StopContext
+	plan, err := client.Get(ctx, resourceGroup, name)
+	if err != nil {
+		if utils.ResponseWasNotFound(plan.Response) {
+			return nil
+		}
+
+		return fmt.Errorf("Error retrieving App Service Plan %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	tier := ""
+	if sku := plan.Sku; sku != nil && sku.Tier != nil {
+		tier = *sku.Tier
+	}
+
+	linux := false
+	if props := plan.AppServicePlanProperties; props != nil && props.Reserved != nil {
+		linux = *props.Reserved
+	}
+
+	return validateAppServiceSiteConfigForPlan(siteConfig, name, tier, linux)
+}
+
+func validateAppServiceSiteConfigJava(siteConfig map[string]interface{}) error {
+	javaFields := []string{"java_version", "java_container", "java_container_version"}
+
+	specified := 0
+	for _, field := range javaFields {
+		if v, ok := siteConfig[field]; ok && v.(string) != "" {
+			specified++
+		}
+	}
+
+	if specified > 0 && specified < len(javaFields) {
+		return fmt.Errorf("`site_config.0.java_version`, `site_config.0.java_container` and `site_config.0.java_container_version` must be specified together")
+	}
+
+	return nil
+}
+
+func validateAppServiceSiteConfigForPlan(siteConfig map[string]interface{}, planName string, tier string, linux bool) error {
+	if strings.EqualFold(tier, "Free") || strings.EqualFold(tier, "Shared") {
+		if v, ok := siteConfig["always_on"]; ok && v.(bool) {
+			return fmt.Errorf("`site_config.0.always_on` cannot be enabled since App Service Plan %q uses the %q tier - either disable `always_on` or use an App Service Plan with a `Basic` tier or above", planName, tier)
+		}
+
+		if v, ok := siteConfig["use_32_bit_worker_process"]; ok && !v.(bool) {
+			return fmt.Errorf("`site_config.0.use_32_bit_worker_process` must be `true` since App Service Plan %q uses the %q tier, which only supports 32-bit worker processes", planName, tier)
+		}
+	}
+
+	if !linux {
+		if v, ok := siteConfig["linux_fx_version"]; ok && v.(string) != "" {
+			return fmt.Errorf("`site_config.0.linux_fx_version` can only be specified when using a Linux App Service Plan - App Service Plan %q is a Windows plan", planName)
+		}
+	}
+
+	return nil
+}
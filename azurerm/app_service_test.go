@@ -0,0 +1,160 @@
+package azurerm
+
+import "testing"
+
+func TestValidateAppServiceSiteConfigJava(t *testing.T) {
+	cases := []struct {
+		Name        string
+		SiteConfig  map[string]interface{}
+		ShouldError bool
+	}{
+		{
+			Name:        "None",
+			SiteConfig:  map[string]interface{}{},
+			ShouldError: false,
+		},
+		{
+			Name: "Empty",
+			SiteConfig: map[string]interface{}{
+				"java_version":           "",
+				"java_container":         "",
+				"java_container_version": "",
+			},
+			ShouldError: false,
+		},
+		{
+			Name: "All",
+			SiteConfig: map[string]interface{}{
+				"java_version":           "1.8",
+				"java_container":         "TOMCAT",
+				"java_container_version": "9.0",
+			},
+			ShouldError: false,
+		},
+		{
+			Name: "Version Only",
+			SiteConfig: map[string]interface{}{
+				"java_version":           "1.8",
+				"java_container":         "",
+				"java_container_version": "",
+			},
+			ShouldError: true,
+		},
+		{
+			Name: "Container Without Version",
+			SiteConfig: map[string]interface{}{
+				"java_version":   "1.8",
+				"java_container": "JETTY",
+			},
+			ShouldError: true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Logf("[DEBUG] Testing %q", tc.Name)
+
+		err := validateAppServiceSiteConfigJava(tc.SiteConfig)
+		hasError := err != nil
+		if hasError != tc.ShouldError {
+			t.Fatalf("Expected %q to return an error %t but got %+v", tc.Name, tc.ShouldError, err)
+		}
+	}
+}
+
+func TestValidateAppServiceSiteConfigForPlan(t *testing.T) {
+	cases := []struct {
+		Name        string
+		SiteConfig  map[string]interface{}
+		Tier        string
+		Linux       bool
+		ShouldError bool
+	}{
+		{
+			Name: "Always On on a Free Plan",
+			SiteConfig: map[string]interface{}{
+				"always_on": true,
+			},
+			Tier:        "Free",
+			ShouldError: true,
+		},
+		{
+			Name: "Always On on a Shared Plan",
+			SiteConfig: map[string]interface{}{
+				"always_on": true,
+			},
+			Tier:        "Shared",
+			ShouldError: true,
+		},
+		{
+			Name: "Always On on a Basic Plan",
+			SiteConfig: map[string]interface{}{
+				"always_on": true,
+			},
+			Tier:        "Basic",
+			ShouldError: false,
+		},
+		{
+			Name: "64-bit Worker on a Free Plan",
+			SiteConfig: map[string]interface{}{
+				"always_on":                 false,
+				"use_32_bit_worker_process": false,
+			},
+			Tier:        "Free",
+			ShouldError: true,
+		},
+		{
+			Name: "32-bit Worker on a Free Plan",
+			SiteConfig: map[string]interface{}{
+				"always_on":                 false,
+				"use_32_bit_worker_process": true,
+			},
+			Tier:        "Free",
+			ShouldError: false,
+		},
+		{
+			Name: "64-bit Worker on a Standard Plan",
+			SiteConfig: map[string]interface{}{
+				"use_32_bit_worker_process": false,
+			},
+			Tier:        "Standard",
+			ShouldError: false,
+		},
+		{
+			Name: "Linux FX Version on a Windows Plan",
+			SiteConfig: map[string]interface{}{
+				"linux_fx_version": "DOCKER|(golang:latest)",
+			},
+			Tier:        "Standard",
+			Linux:       false,
+			ShouldError: true,
+		},
+		{
+			Name: "Linux FX Version on a Linux Plan",
+			SiteConfig: map[string]interface{}{
+				"linux_fx_version": "DOCKER|(golang:latest)",
+			},
+			Tier:        "Standard",
+			Linux:       true,
+			ShouldError: false,
+		},
+		{
+			Name: "Empty Linux FX Version on a Windows Plan",
+			SiteConfig: map[string]interface{}{
+				"linux_fx_version": "",
+			},
+			Tier:        "Standard",
+			Linux:       false,
+			ShouldError: false,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Logf("[DEBUG] Testing %q", tc.Name)
+
+		err := validateAppServiceSiteConfigForPlan(tc.SiteConfig, "example", tc.Tier, tc.Linux)
+		hasError := err != nil
+		if hasError != tc.ShouldError {
+			t.Fatalf("Expected %q to return an error %t but got %+v", tc.Name, tc.ShouldError, err)
+		}
+	}
+}
@@ -1,11 +1,13 @@
 package azurerm
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"os"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/authentication"
@@ -39,7 +41,15 @@ func buildConfigForSweepers() (*ArmClient, error) {
 		SkipProviderRegistration: false,
 	}
 
-	return getArmClient(config)
+	client, err := getArmClient(config)
+	if err != nil {
+		return nil, err
+	}
+
+	// the StopContext is otherwise configured by the Provider, which isn't used by the Sweepers
+	client.StopContext = context.Background()
+
+	return client, nil
 }
 
 func shouldSweepAcceptanceTestResource(name string, resourceLocation string, region string) bool {
@@ -60,3 +70,57 @@ func shouldSweepAcceptanceTestResource(name string, resourceLocation string, reg
 
 	return true
 }
+
+// sweeperMinimumAge returns how long ago a Resource Group must have last been modified for it to be swept, which
+// allows the Sweepers to be run alongside the Acceptance Tests. This is configured using `ARM_SWEEP_MIN_AGE` (e.g. `3h`)
+func sweeperMinimumAge() (time.Duration, error) {
+	v := os.Getenv("ARM_SWEEP_MIN_AGE")
+	if v == "" {
+		return 0, nil
+	}
+
+	age, err := time.ParseDuration(v)
+	if err != nil {
+		return 0, fmt.Errorf("Error parsing `ARM_SWEEP_MIN_AGE` %q: %+v", v, err)
+	}
+
+	return age, nil
+}
+
+// resourceGroupModifiedWithin returns whether the Activity Log contains any events for the Resource Group
+// within the specified duration, in which case it's likely to be in use by a running Acceptance Test
+func resourceGroupModifiedWithin(ctx context.Context, client *ArmClient, resourceGroup string, age time.Duration) (bool, error) {
+	now := time.Now().UTC()
+	filter := fmt.Sprintf("eventTimestamp ge '%s' and eventTimestamp le '%s' and resourceGroupName eq '%s'", now.Add(-age).Format(time.RFC3339), now.Format(time.RFC3339), resourceGroup)
+
+	events, err := client.monitorActivityLogsClient.List(ctx, filter, "eventTimestamp,operationName")
+	if err != nil {
+		return false, fmt.Errorf("Error listing Activity Log events for Resource Group %q: %+v", resourceGroup, err)
+	}
+
+	return len(events.Values()) > 0, nil
+}
+
+// sweeperResourceGroupInUse returns whether the Resource Group containing a resource has been modified within
+// `ARM_SWEEP_MIN_AGE`, in which case the resource is likely to be in use by a running Acceptance Test
+func sweeperResourceGroupInUse(ctx context.Context, client *ArmClient, resourceGroup string) (bool, error) {
+	minimumAge, err := sweeperMinimumAge()
+	if err != nil {
+		return false, err
+	}
+
+	if minimumAge == 0 {
+		return false, nil
+	}
+
+	inUse, err := resourceGroupModifiedWithin(ctx, client, resourceGroup, minimumAge)
+	if err != nil {
+		return false, err
+	}
+
+	if inUse {
+		log.Printf("Resource Group %q has been modified within the last %s - skipping", resourceGroup, minimumAge)
+	}
+
+	return inUse, nil
+}
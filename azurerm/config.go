@@ -2,8 +2,10 @@ package azurerm
 
 import (
 	"context"
+	"encoding/base64"
 	"fmt"
 	"log"
+	"net/http"
 	"os"
 	"strings"
 	"sync"
@@ -11,6 +13,7 @@ import (
 
 	appinsights "github.com/Azure/azure-sdk-for-go/services/appinsights/mgmt/2015-05-01/insights"
 	"github.com/Azure/azure-sdk-for-go/services/automation/mgmt/2015-10-31/automation"
+	"github.com/Azure/azure-sdk-for-go/services/batch/mgmt/2017-09-01/batch"
 	"github.com/Azure/azure-sdk-for-go/services/cdn/mgmt/2017-10-12/cdn"
 	"github.com/Azure/azure-sdk-for-go/services/cognitiveservices/mgmt/2017-04-18/cognitiveservices"
 	"github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2018-06-01/compute"
@@ -26,18 +29,24 @@ import (
 	"github.com/Azure/azure-sdk-for-go/services/eventgrid/mgmt/2018-01-01/eventgrid"
 	"github.com/Azure/azure-sdk-for-go/services/eventhub/mgmt/2017-04-01/eventhub"
 	"github.com/Azure/azure-sdk-for-go/services/graphrbac/1.6/graphrbac"
+	"github.com/Azure/azure-sdk-for-go/services/iotcentral/mgmt/2018-09-01/iotcentral"
 	"github.com/Azure/azure-sdk-for-go/services/iothub/mgmt/2018-04-01/devices"
 	keyVault "github.com/Azure/azure-sdk-for-go/services/keyvault/2016-10-01/keyvault"
 	"github.com/Azure/azure-sdk-for-go/services/keyvault/mgmt/2018-02-14/keyvault"
 	"github.com/Azure/azure-sdk-for-go/services/logic/mgmt/2016-06-01/logic"
+	"github.com/Azure/azure-sdk-for-go/services/migrate/mgmt/2018-02-02/migrate"
 	"github.com/Azure/azure-sdk-for-go/services/mysql/mgmt/2017-12-01/mysql"
-	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2018-04-01/network"
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2018-08-01/network"
 	"github.com/Azure/azure-sdk-for-go/services/notificationhubs/mgmt/2017-04-01/notificationhubs"
+	"github.com/Azure/azure-sdk-for-go/services/policyinsights/mgmt/2018-04-04/policyinsights"
 	"github.com/Azure/azure-sdk-for-go/services/postgresql/mgmt/2017-12-01/postgresql"
 	"github.com/Azure/azure-sdk-for-go/services/preview/apimanagement/mgmt/2018-06-01-preview/apimanagement"
 	"github.com/Azure/azure-sdk-for-go/services/preview/authorization/mgmt/2018-01-01-preview/authorization"
+	automationPreview "github.com/Azure/azure-sdk-for-go/services/preview/automation/mgmt/2018-06-preview/automation"
+	"github.com/Azure/azure-sdk-for-go/services/preview/datamigration/mgmt/2018-03-31-preview/datamigration"
 	"github.com/Azure/azure-sdk-for-go/services/preview/devspaces/mgmt/2018-06-01-preview/devspaces"
 	"github.com/Azure/azure-sdk-for-go/services/preview/dns/mgmt/2018-03-01-preview/dns"
+	"github.com/Azure/azure-sdk-for-go/services/preview/frontdoor/mgmt/2018-08-01-preview/frontdoor"
 	"github.com/Azure/azure-sdk-for-go/services/preview/monitor/mgmt/2018-03-01/insights"
 	"github.com/Azure/azure-sdk-for-go/services/preview/msi/mgmt/2015-08-31-preview/msi"
 	"github.com/Azure/azure-sdk-for-go/services/preview/operationalinsights/mgmt/2015-11-01-preview/operationalinsights"
@@ -45,19 +54,24 @@ import (
 	"github.com/Azure/azure-sdk-for-go/services/preview/resources/mgmt/2018-03-01-preview/management"
 	"github.com/Azure/azure-sdk-for-go/services/preview/security/mgmt/2017-08-01-preview/security"
 	"github.com/Azure/azure-sdk-for-go/services/preview/sql/mgmt/2015-05-01-preview/sql"
+	sqlBackup "github.com/Azure/azure-sdk-for-go/services/preview/sql/mgmt/2017-10-01-preview/sql"
+	webPreview "github.com/Azure/azure-sdk-for-go/services/preview/web/mgmt/2015-08-preview/web"
 	"github.com/Azure/azure-sdk-for-go/services/recoveryservices/mgmt/2016-06-01/backup"
 	"github.com/Azure/azure-sdk-for-go/services/recoveryservices/mgmt/2016-06-01/recoveryservices"
+	workloadBackup "github.com/Azure/azure-sdk-for-go/services/recoveryservices/mgmt/2017-07-01/backup"
 	"github.com/Azure/azure-sdk-for-go/services/redis/mgmt/2018-03-01/redis"
 	"github.com/Azure/azure-sdk-for-go/services/relay/mgmt/2017-04-01/relay"
 	"github.com/Azure/azure-sdk-for-go/services/resources/mgmt/2016-06-01/subscriptions"
 	"github.com/Azure/azure-sdk-for-go/services/resources/mgmt/2016-09-01/locks"
 	"github.com/Azure/azure-sdk-for-go/services/resources/mgmt/2016-12-01/policy"
 	"github.com/Azure/azure-sdk-for-go/services/resources/mgmt/2017-05-10/resources"
+	subscriptionResources "github.com/Azure/azure-sdk-for-go/services/resources/mgmt/2018-05-01/resources"
 	"github.com/Azure/azure-sdk-for-go/services/scheduler/mgmt/2016-03-01/scheduler"
 	"github.com/Azure/azure-sdk-for-go/services/search/mgmt/2015-08-19/search"
 	"github.com/Azure/azure-sdk-for-go/services/servicebus/mgmt/2017-04-01/servicebus"
 	"github.com/Azure/azure-sdk-for-go/services/servicefabric/mgmt/2018-02-01/servicefabric"
-	"github.com/Azure/azure-sdk-for-go/services/storage/mgmt/2017-10-01/storage"
+	"github.com/Azure/azure-sdk-for-go/services/storage/mgmt/2018-07-01/storage"
+	"github.com/Azure/azure-sdk-for-go/services/timeseriesinsights/mgmt/2017-11-15/timeseriesinsights"
 	"github.com/Azure/azure-sdk-for-go/services/trafficmanager/mgmt/2017-05-01/trafficmanager"
 	"github.com/Azure/azure-sdk-for-go/services/web/mgmt/2018-02-01/web"
 
@@ -82,6 +96,11 @@ type ArmClient struct {
 	usingServicePrincipal    bool
 	environment              az.Environment
 	skipProviderRegistration bool
+	storageUseAzureAD        bool
+
+	// the Sender and User Agent are shared by every client, rather than being built per-client
+	sender    autorest.Sender
+	userAgent string
 
 	StopContext context.Context
 
@@ -96,10 +115,13 @@ type ArmClient struct {
 	automationRunbookClient               automation.RunbookClient
 	automationRunbookDraftClient          automation.RunbookDraftClient
 	automationScheduleClient              automation.ScheduleClient
+	automationSoftwareUpdateConfigClient  automationPreview.SoftwareUpdateConfigurationsClient
 
 	dnsClient   dns.RecordSetsClient
 	zonesClient dns.ZonesClient
 
+	frontDoorsClient frontdoor.FrontDoorsClient
+
 	containerRegistryClient  containerregistry.RegistriesClient
 	containerServicesClient  containerservice.ContainerServicesClient
 	kubernetesClustersClient containerservice.ManagedClustersClient
@@ -117,7 +139,10 @@ type ArmClient struct {
 	redisPatchSchedulesClient redis.PatchSchedulesClient
 
 	// API Management
-	apiManagementServiceClient apimanagement.ServiceClient
+	apiManagementEmailTemplateClient              apimanagement.EmailTemplateClient
+	apiManagementNotificationRecipientEmailClient apimanagement.NotificationRecipientEmailClient
+	apiManagementNotificationRecipientUserClient  apimanagement.NotificationRecipientUserClient
+	apiManagementServiceClient                    apimanagement.ServiceClient
 
 	// Application Insights
 	appInsightsClient appinsights.ComponentsClient
@@ -131,6 +156,9 @@ type ArmClient struct {
 	// Autoscale Settings
 	autoscaleSettingsClient insights.AutoscaleSettingsClient
 
+	// Batch
+	batchAccountClient batch.AccountClient
+
 	// CDN
 	cdnCustomDomainsClient cdn.CustomDomainsClient
 	cdnEndpointsClient     cdn.EndpointsClient
@@ -151,11 +179,20 @@ type ArmClient struct {
 	vmExtensionImageClient     compute.VirtualMachineExtensionImagesClient
 	vmExtensionClient          compute.VirtualMachineExtensionsClient
 	vmScaleSetClient           compute.VirtualMachineScaleSetsClient
+	vmScaleSetVMsClient        compute.VirtualMachineScaleSetVMsClient
 	vmImageClient              compute.VirtualMachineImagesClient
 	vmClient                   compute.VirtualMachinesClient
 
+	// Database Migration
+	dmsProjectsClient datamigration.ProjectsClient
+	dmsServicesClient datamigration.ServicesClient
+
 	// Devices
-	iothubResourceClient devices.IotHubResourceClient
+	iothubResourceClient    devices.IotHubResourceClient
+	iotCentralAppsClient    iotcentral.AppsClient
+	tsiEnvironmentsClient   timeseriesinsights.EnvironmentsClient
+	tsiAccessPoliciesClient timeseriesinsights.AccessPoliciesClient
+	tsiEventSourcesClient   timeseriesinsights.EventSourcesClient
 
 	// DevTestLabs
 	devTestLabsClient            dtl.LabsClient
@@ -180,9 +217,13 @@ type ArmClient struct {
 	sqlDatabasesClient                       sql.DatabasesClient
 	sqlDatabaseThreatDetectionPoliciesClient sql.DatabaseThreatDetectionPoliciesClient
 	sqlElasticPoolsClient                    sql.ElasticPoolsClient
+	sqlEncryptionProtectorsClient            sql.EncryptionProtectorsClient
 	sqlFirewallRulesClient                   sql.FirewallRulesClient
+	sqlGeoBackupPoliciesClient               sql.GeoBackupPoliciesClient
 	sqlServersClient                         sql.ServersClient
 	sqlServerAzureADAdministratorsClient     sql.ServerAzureADAdministratorsClient
+	sqlServerKeysClient                      sql.ServerKeysClient
+	sqlShortTermRetentionPoliciesClient      sqlBackup.BackupShortTermRetentionPoliciesClient
 	sqlVirtualNetworkRulesClient             sql.VirtualNetworkRulesClient
 
 	// Data Lake Store
@@ -212,9 +253,15 @@ type ArmClient struct {
 	managementGroupsClient             managementgroups.Client
 	managementGroupsSubscriptionClient managementgroups.SubscriptionsClient
 
+	// Migrate
+	migrateAssessmentsClient migrate.AssessmentsClient
+	migrateGroupsClient      migrate.GroupsClient
+	migrateProjectsClient    migrate.ProjectsClient
+
 	// Monitor
 	monitorActionGroupsClient      insights.ActionGroupsClient
 	monitorActivityLogAlertsClient insights.ActivityLogAlertsClient
+	monitorActivityLogsClient      insights.ActivityLogsClient
 	monitorAlertRulesClient        insights.AlertRulesClient
 	monitorLogProfilesClient       insights.LogProfilesClient
 	monitorMetricAlertsClient      insights.MetricAlertsClient
@@ -226,6 +273,7 @@ type ArmClient struct {
 	applicationGatewayClient        network.ApplicationGatewaysClient
 	applicationSecurityGroupsClient network.ApplicationSecurityGroupsClient
 	azureFirewallsClient            network.AzureFirewallsClient
+	ddosProtectionPlanClient        network.DdosProtectionPlansClient
 	expressRouteAuthsClient         network.ExpressRouteCircuitAuthorizationsClient
 	expressRouteCircuitClient       network.ExpressRouteCircuitsClient
 	expressRoutePeeringsClient      network.ExpressRouteCircuitPeeringsClient
@@ -234,15 +282,20 @@ type ArmClient struct {
 	localNetConnClient              network.LocalNetworkGatewaysClient
 	packetCapturesClient            network.PacketCapturesClient
 	publicIPClient                  network.PublicIPAddressesClient
+	routeFiltersClient              network.RouteFiltersClient
 	routesClient                    network.RoutesClient
 	routeTablesClient               network.RouteTablesClient
 	secGroupClient                  network.SecurityGroupsClient
 	secRuleClient                   network.SecurityRulesClient
 	subnetClient                    network.SubnetsClient
+	virtualHubClient                network.VirtualHubsClient
+	virtualWanClient                network.VirtualWansClient
 	vnetGatewayConnectionsClient    network.VirtualNetworkGatewayConnectionsClient
 	vnetGatewayClient               network.VirtualNetworkGatewaysClient
 	vnetClient                      network.VirtualNetworksClient
 	vnetPeeringsClient              network.VirtualNetworkPeeringsClient
+	vpnGatewayClient                network.VpnGatewaysClient
+	vpnSitesClient                  network.VpnSitesClient
 	watcherClient                   network.WatchersClient
 
 	// Notification Hubs
@@ -250,20 +303,29 @@ type ArmClient struct {
 	notificationNamespacesClient notificationhubs.NamespacesClient
 
 	// Recovery Services
-	recoveryServicesVaultsClient             recoveryservices.VaultsClient
-	recoveryServicesProtectedItemsClient     backup.ProtectedItemsClient
-	recoveryServicesProtectionPoliciesClient backup.ProtectionPoliciesClient
+	recoveryServicesVaultsClient                 recoveryservices.VaultsClient
+	recoveryServicesProtectedItemsClient         backup.ProtectedItemsClient
+	recoveryServicesProtectionPoliciesClient     backup.ProtectionPoliciesClient
+	recoveryServicesProtectionContainersClient   workloadBackup.ProtectionContainersClient
+	recoveryServicesProtectableItemsClient       workloadBackup.ProtectableItemsClient
+	recoveryServicesWorkloadProtectedItemsClient workloadBackup.ProtectedItemsGroupClient
+	recoveryServicesWorkloadPoliciesClient       workloadBackup.ProtectionPoliciesClient
+	recoveryServicesRecoveryPointsClient         workloadBackup.RecoveryPointsClient
+	recoveryServicesRestoresClient               workloadBackup.RestoresClient
+	recoveryServicesOperationStatusesClient      workloadBackup.ProtectedItemOperationStatusesClient
+	recoveryServicesJobDetailsClient             workloadBackup.JobDetailsClient
 
 	// Relay
 	relayNamespacesClient relay.NamespacesClient
 
 	// Resources
-	managementLocksClient locks.ManagementLocksClient
-	deploymentsClient     resources.DeploymentsClient
-	providersClient       resources.ProvidersClient
-	resourcesClient       resources.Client
-	resourceGroupsClient  resources.GroupsClient
-	subscriptionsClient   subscriptions.Client
+	managementLocksClient         locks.ManagementLocksClient
+	deploymentsClient             resources.DeploymentsClient
+	providersClient               resources.ProvidersClient
+	resourcesClient               resources.Client
+	resourceGroupsClient          resources.GroupsClient
+	subscriptionDeploymentsClient subscriptionResources.DeploymentsClient
+	subscriptionsClient           subscriptions.Client
 
 	// Scheduler
 	schedulerJobCollectionsClient scheduler.JobCollectionsClient //nolint: megacheck
@@ -290,8 +352,9 @@ type ArmClient struct {
 	serviceFabricClustersClient servicefabric.ClustersClient
 
 	// Storage
+	storageAuth          autorest.Authorizer
 	storageServiceClient storage.AccountsClient
-	storageUsageClient   storage.UsageClient
+	storageUsageClient   storage.UsagesClient
 
 	// Traffic Manager
 	trafficManagerGeographialHierarchiesClient trafficmanager.GeographicHierarchiesClient
@@ -299,12 +362,16 @@ type ArmClient struct {
 	trafficManagerEndpointsClient              trafficmanager.EndpointsClient
 
 	// Web
-	appServicePlansClient web.AppServicePlansClient
-	appServicesClient     web.AppsClient
+	apiConnectionsClient     webPreview.ConnectionsClient
+	appServicePlansClient    web.AppServicePlansClient
+	appServiceProviderClient web.ProviderClient
+	appServicesClient        web.AppsClient
+	managedApisClient        webPreview.ManagedApisClient
 
 	// Policy
 	policyAssignmentsClient policy.AssignmentsClient
 	policyDefinitionsClient policy.DefinitionsClient
+	policyStatesClient      policyinsights.PolicyStatesClient
 }
 
 var (
@@ -328,28 +395,30 @@ func clientRequestID() string {
 }
 
 func (c *ArmClient) configureClient(client *autorest.Client, auth autorest.Authorizer) {
-	setUserAgent(client)
+	client.UserAgent = strings.TrimSpace(fmt.Sprintf("%s %s", client.UserAgent, c.userAgent))
 	client.Authorizer = auth
 	//client.RequestInspector = azure.WithClientID(clientRequestID())
-	client.Sender = azure.BuildSender()
+	client.Sender = c.sender
 	client.SkipResourceProviderRegistration = c.skipProviderRegistration
 	client.PollingDuration = 60 * time.Minute
 }
 
-func setUserAgent(client *autorest.Client) {
+// buildUserAgent returns the User Agent which is appended to the one provided by the SDK for each client -
+// since this is the same for every client it's built once rather than per-client
+func buildUserAgent() string {
 	// TODO: This is the SDK version not the CLI version, once we are on 0.12, should revisit
 	tfUserAgent := httpclient.UserAgentString()
 
 	pv := version.ProviderVersion
-	providerUserAgent := fmt.Sprintf("%s terraform-provider-azurerm/%s", tfUserAgent, pv)
-	client.UserAgent = strings.TrimSpace(fmt.Sprintf("%s %s", client.UserAgent, providerUserAgent))
+	userAgent := fmt.Sprintf("%s terraform-provider-azurerm/%s", tfUserAgent, pv)
 
 	// append the CloudShell version to the user agent if it exists
 	if azureAgent := os.Getenv("AZURE_HTTP_USER_AGENT"); azureAgent != "" {
-		client.UserAgent = fmt.Sprintf("%s %s", client.UserAgent, azureAgent)
+		userAgent = fmt.Sprintf("%s %s", userAgent, azureAgent)
 	}
 
-	log.Printf("[DEBUG] AzureRM Client User Agent: %s\n", client.UserAgent)
+	log.Printf("[DEBUG] AzureRM Client User Agent: %s\n", userAgent)
+	return userAgent
 }
 
 // getArmClient is a helper method which returns a fully instantiated
@@ -368,6 +437,9 @@ func getArmClient(c *authentication.Config) (*ArmClient, error) {
 		environment:              *env,
 		usingServicePrincipal:    c.ClientSecret != "",
 		skipProviderRegistration: c.SkipProviderRegistration,
+		storageUseAzureAD:        c.StorageUseAzureAD,
+		sender:                   azure.BuildSender(),
+		userAgent:                buildUserAgent(),
 	}
 
 	oauthConfig, err := adal.NewOAuthConfig(env.ActiveDirectoryEndpoint, c.TenantID)
@@ -395,8 +467,7 @@ func getArmClient(c *authentication.Config) (*ArmClient, error) {
 	}
 
 	// Key Vault Endpoints
-	sender := azure.BuildSender()
-	keyVaultAuth := autorest.NewBearerAuthorizerCallback(sender, func(tenantID, resource string) (*autorest.BearerAuthorizer, error) {
+	keyVaultAuth := autorest.NewBearerAuthorizerCallback(client.sender, func(tenantID, resource string) (*autorest.BearerAuthorizer, error) {
 		keyVaultSpt, err := authentication.GetAuthorizationToken(c, oauthConfig, resource)
 		if err != nil {
 			return nil, err
@@ -405,54 +476,85 @@ func getArmClient(c *authentication.Config) (*ArmClient, error) {
 		return keyVaultSpt, nil
 	})
 
-	client.registerApiManagementServiceClients(endpoint, c.SubscriptionID, auth)
-	client.registerAppInsightsClients(endpoint, c.SubscriptionID, auth)
-	client.registerAutomationClients(endpoint, c.SubscriptionID, auth)
-	client.registerAuthentication(endpoint, graphEndpoint, c.SubscriptionID, c.TenantID, auth, graphAuth)
-	client.registerCDNClients(endpoint, c.SubscriptionID, auth)
-	client.registerCognitiveServiceClients(endpoint, c.SubscriptionID, auth)
-	client.registerComputeClients(endpoint, c.SubscriptionID, auth)
-	client.registerContainerInstanceClients(endpoint, c.SubscriptionID, auth)
-	client.registerContainerRegistryClients(endpoint, c.SubscriptionID, auth)
-	client.registerContainerServicesClients(endpoint, c.SubscriptionID, auth)
-	client.registerCosmosDBClients(endpoint, c.SubscriptionID, auth)
-	client.registerDatabricksClients(endpoint, c.SubscriptionID, auth)
-	client.registerDatabases(endpoint, c.SubscriptionID, auth, sender)
-	client.registerDataLakeStoreClients(endpoint, c.SubscriptionID, auth)
-	client.registerDeviceClients(endpoint, c.SubscriptionID, auth)
-	client.registerDevSpaceClients(endpoint, c.SubscriptionID, auth)
-	client.registerDevTestClients(endpoint, c.SubscriptionID, auth)
-	client.registerDNSClients(endpoint, c.SubscriptionID, auth)
-	client.registerEventGridClients(endpoint, c.SubscriptionID, auth)
-	client.registerEventHubClients(endpoint, c.SubscriptionID, auth)
-	client.registerKeyVaultClients(endpoint, c.SubscriptionID, auth, keyVaultAuth)
-	client.registerLogicClients(endpoint, c.SubscriptionID, auth)
-	client.registerMonitorClients(endpoint, c.SubscriptionID, auth)
-	client.registerNetworkingClients(endpoint, c.SubscriptionID, auth)
-	client.registerNotificationHubsClient(endpoint, c.SubscriptionID, auth)
-	client.registerOperationalInsightsClients(endpoint, c.SubscriptionID, auth)
-	client.registerRecoveryServiceClients(endpoint, c.SubscriptionID, auth)
-	client.registerPolicyClients(endpoint, c.SubscriptionID, auth)
-	client.registerManagementGroupClients(endpoint, auth)
-	client.registerRedisClients(endpoint, c.SubscriptionID, auth)
-	client.registerRelayClients(endpoint, c.SubscriptionID, auth)
-	client.registerResourcesClients(endpoint, c.SubscriptionID, auth)
-	client.registerSearchClients(endpoint, c.SubscriptionID, auth)
-	client.registerSecurityCenterClients(endpoint, c.SubscriptionID, auth)
-	client.registerServiceBusClients(endpoint, c.SubscriptionID, auth)
-	client.registerServiceFabricClients(endpoint, c.SubscriptionID, auth)
-	client.registerSchedulerClients(endpoint, c.SubscriptionID, auth)
-	client.registerStorageClients(endpoint, c.SubscriptionID, auth)
-	client.registerTrafficManagerClients(endpoint, c.SubscriptionID, auth)
-	client.registerWebClients(endpoint, c.SubscriptionID, auth)
+	// Storage Endpoints
+	if c.StorageUseAzureAD {
+		storageAuth, err := authentication.GetAuthorizationToken(c, oauthConfig, storageAzureADResource)
+		if err != nil {
+			return nil, err
+		}
+
+		client.storageAuth = storageAuth
+	}
+
+	client.registerClients(endpoint, graphEndpoint, c.SubscriptionID, c.TenantID, auth, graphAuth, keyVaultAuth)
 
 	return &client, nil
 }
 
+// registerClients configures each of the SDK Clients used by the Provider
+func (c *ArmClient) registerClients(endpoint, graphEndpoint, subscriptionId, tenantId string, auth, graphAuth, keyVaultAuth autorest.Authorizer) {
+	c.registerApiManagementServiceClients(endpoint, subscriptionId, auth)
+	c.registerAppInsightsClients(endpoint, subscriptionId, auth)
+	c.registerAutomationClients(endpoint, subscriptionId, auth)
+	c.registerAuthentication(endpoint, graphEndpoint, subscriptionId, tenantId, auth, graphAuth)
+	c.registerBatchClients(endpoint, subscriptionId, auth)
+	c.registerCDNClients(endpoint, subscriptionId, auth)
+	c.registerCognitiveServiceClients(endpoint, subscriptionId, auth)
+	c.registerComputeClients(endpoint, subscriptionId, auth)
+	c.registerContainerInstanceClients(endpoint, subscriptionId, auth)
+	c.registerContainerRegistryClients(endpoint, subscriptionId, auth)
+	c.registerContainerServicesClients(endpoint, subscriptionId, auth)
+	c.registerCosmosDBClients(endpoint, subscriptionId, auth)
+	c.registerDatabricksClients(endpoint, subscriptionId, auth)
+	c.registerDatabases(endpoint, subscriptionId, auth)
+	c.registerDataLakeStoreClients(endpoint, subscriptionId, auth)
+	c.registerDatabaseMigrationClients(endpoint, subscriptionId, auth)
+	c.registerDeviceClients(endpoint, subscriptionId, auth)
+	c.registerDevSpaceClients(endpoint, subscriptionId, auth)
+	c.registerDevTestClients(endpoint, subscriptionId, auth)
+	c.registerDNSClients(endpoint, subscriptionId, auth)
+	c.registerEventGridClients(endpoint, subscriptionId, auth)
+	c.registerEventHubClients(endpoint, subscriptionId, auth)
+	c.registerFrontDoorClients(endpoint, subscriptionId, auth)
+	c.registerKeyVaultClients(endpoint, subscriptionId, auth, keyVaultAuth)
+	c.registerLogicClients(endpoint, subscriptionId, auth)
+	c.registerMigrateClients(endpoint, subscriptionId, auth)
+	c.registerMonitorClients(endpoint, subscriptionId, auth)
+	c.registerNetworkingClients(endpoint, subscriptionId, auth)
+	c.registerNotificationHubsClient(endpoint, subscriptionId, auth)
+	c.registerOperationalInsightsClients(endpoint, subscriptionId, auth)
+	c.registerRecoveryServiceClients(endpoint, subscriptionId, auth)
+	c.registerPolicyClients(endpoint, subscriptionId, auth)
+	c.registerManagementGroupClients(endpoint, auth)
+	c.registerRedisClients(endpoint, subscriptionId, auth)
+	c.registerRelayClients(endpoint, subscriptionId, auth)
+	c.registerResourcesClients(endpoint, subscriptionId, auth)
+	c.registerSearchClients(endpoint, subscriptionId, auth)
+	c.registerSecurityCenterClients(endpoint, subscriptionId, auth)
+	c.registerServiceBusClients(endpoint, subscriptionId, auth)
+	c.registerServiceFabricClients(endpoint, subscriptionId, auth)
+	c.registerSchedulerClients(endpoint, subscriptionId, auth)
+	c.registerStorageClients(endpoint, subscriptionId, auth)
+	c.registerTrafficManagerClients(endpoint, subscriptionId, auth)
+	c.registerWebClients(endpoint, subscriptionId, auth)
+}
+
 func (c *ArmClient) registerApiManagementServiceClients(endpoint, subscriptionId string, auth autorest.Authorizer) {
 	ams := apimanagement.NewServiceClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&ams.Client, auth)
 	c.apiManagementServiceClient = ams
+
+	emailTemplateClient := apimanagement.NewEmailTemplateClientWithBaseURI(endpoint, subscriptionId)
+	c.configureClient(&emailTemplateClient.Client, auth)
+	c.apiManagementEmailTemplateClient = emailTemplateClient
+
+	notificationRecipientEmailClient := apimanagement.NewNotificationRecipientEmailClientWithBaseURI(endpoint, subscriptionId)
+	c.configureClient(&notificationRecipientEmailClient.Client, auth)
+	c.apiManagementNotificationRecipientEmailClient = notificationRecipientEmailClient
+
+	notificationRecipientUserClient := apimanagement.NewNotificationRecipientUserClientWithBaseURI(endpoint, subscriptionId)
+	c.configureClient(&notificationRecipientUserClient.Client, auth)
+	c.apiManagementNotificationRecipientUserClient = notificationRecipientUserClient
 }
 
 func (c *ArmClient) registerAppInsightsClients(endpoint, subscriptionId string, auth autorest.Authorizer) {
@@ -497,6 +599,11 @@ func (c *ArmClient) registerAutomationClients(endpoint, subscriptionId string, a
 	runbookDraftClient := automation.NewRunbookDraftClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&runbookDraftClient.Client, auth)
 	c.automationRunbookDraftClient = runbookDraftClient
+
+	// the count type is only used by the Node Count API
+	softwareUpdateConfigClient := automationPreview.NewSoftwareUpdateConfigurationsClientWithBaseURI(endpoint, subscriptionId, "")
+	c.configureClient(&softwareUpdateConfigClient.Client, auth)
+	c.automationSoftwareUpdateConfigClient = softwareUpdateConfigClient
 }
 
 func (c *ArmClient) registerAuthentication(endpoint, graphEndpoint, subscriptionId, tenantId string, auth, graphAuth autorest.Authorizer) {
@@ -517,6 +624,12 @@ func (c *ArmClient) registerAuthentication(endpoint, graphEndpoint, subscription
 	c.servicePrincipalsClient = servicePrincipalsClient
 }
 
+func (c *ArmClient) registerBatchClients(endpoint, subscriptionId string, auth autorest.Authorizer) {
+	batchAccount := batch.NewAccountClientWithBaseURI(endpoint, subscriptionId)
+	c.configureClient(&batchAccount.Client, auth)
+	c.batchAccountClient = batchAccount
+}
+
 func (c *ArmClient) registerCDNClients(endpoint, subscriptionId string, auth autorest.Authorizer) {
 	customDomainsClient := cdn.NewCustomDomainsClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&customDomainsClient.Client, auth)
@@ -580,6 +693,10 @@ func (c *ArmClient) registerComputeClients(endpoint, subscriptionId string, auth
 	c.configureClient(&scaleSetsClient.Client, auth)
 	c.vmScaleSetClient = scaleSetsClient
 
+	scaleSetVMsClient := compute.NewVirtualMachineScaleSetVMsClientWithBaseURI(endpoint, subscriptionId)
+	c.configureClient(&scaleSetVMsClient.Client, auth)
+	c.vmScaleSetVMsClient = scaleSetVMsClient
+
 	virtualMachinesClient := compute.NewVirtualMachinesClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&virtualMachinesClient.Client, auth)
 	c.vmClient = virtualMachinesClient
@@ -627,7 +744,7 @@ func (c *ArmClient) registerDatabricksClients(endpoint, subscriptionId string, a
 	c.databricksWorkspacesClient = databricksWorkspacesClient
 }
 
-func (c *ArmClient) registerDatabases(endpoint, subscriptionId string, auth autorest.Authorizer, sender autorest.Sender) {
+func (c *ArmClient) registerDatabases(endpoint, subscriptionId string, auth autorest.Authorizer) {
 	// MySQL
 	mysqlConfigClient := mysql.NewConfigurationsClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&mysqlConfigClient.Client, auth)
@@ -676,20 +793,25 @@ func (c *ArmClient) registerDatabases(endpoint, subscriptionId string, auth auto
 	c.sqlDatabasesClient = sqlDBClient
 
 	sqlDTDPClient := sql.NewDatabaseThreatDetectionPoliciesClientWithBaseURI(endpoint, subscriptionId)
-	setUserAgent(&sqlDTDPClient.Client)
-	sqlDTDPClient.Authorizer = auth
-	sqlDTDPClient.Sender = sender
-	sqlDTDPClient.SkipResourceProviderRegistration = c.skipProviderRegistration
+	c.configureClient(&sqlDTDPClient.Client, auth)
 	c.sqlDatabaseThreatDetectionPoliciesClient = sqlDTDPClient
 
 	sqlFWClient := sql.NewFirewallRulesClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&sqlFWClient.Client, auth)
 	c.sqlFirewallRulesClient = sqlFWClient
 
+	sqlGeoBackupPoliciesClient := sql.NewGeoBackupPoliciesClientWithBaseURI(endpoint, subscriptionId)
+	c.configureClient(&sqlGeoBackupPoliciesClient.Client, auth)
+	c.sqlGeoBackupPoliciesClient = sqlGeoBackupPoliciesClient
+
 	sqlEPClient := sql.NewElasticPoolsClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&sqlEPClient.Client, auth)
 	c.sqlElasticPoolsClient = sqlEPClient
 
+	sqlEncryptionProtectorsClient := sql.NewEncryptionProtectorsClientWithBaseURI(endpoint, subscriptionId)
+	c.configureClient(&sqlEncryptionProtectorsClient.Client, auth)
+	c.sqlEncryptionProtectorsClient = sqlEncryptionProtectorsClient
+
 	sqlSrvClient := sql.NewServersClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&sqlSrvClient.Client, auth)
 	c.sqlServersClient = sqlSrvClient
@@ -698,6 +820,14 @@ func (c *ArmClient) registerDatabases(endpoint, subscriptionId string, auth auto
 	c.configureClient(&sqlADClient.Client, auth)
 	c.sqlServerAzureADAdministratorsClient = sqlADClient
 
+	sqlServerKeysClient := sql.NewServerKeysClientWithBaseURI(endpoint, subscriptionId)
+	c.configureClient(&sqlServerKeysClient.Client, auth)
+	c.sqlServerKeysClient = sqlServerKeysClient
+
+	sqlShortTermRetentionPoliciesClient := sqlBackup.NewBackupShortTermRetentionPoliciesClientWithBaseURI(endpoint, subscriptionId)
+	c.configureClient(&sqlShortTermRetentionPoliciesClient.Client, auth)
+	c.sqlShortTermRetentionPoliciesClient = sqlShortTermRetentionPoliciesClient
+
 	sqlVNRClient := sql.NewVirtualNetworkRulesClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&sqlVNRClient.Client, auth)
 	c.sqlVirtualNetworkRulesClient = sqlVNRClient
@@ -725,10 +855,36 @@ func (c *ArmClient) registerDataLakeStoreClients(endpoint, subscriptionId string
 	c.dataLakeAnalyticsFirewallRulesClient = analyticsFirewallRulesClient
 }
 
+func (c *ArmClient) registerDatabaseMigrationClients(endpoint, subscriptionId string, auth autorest.Authorizer) {
+	projectsClient := datamigration.NewProjectsClientWithBaseURI(endpoint, subscriptionId)
+	c.configureClient(&projectsClient.Client, auth)
+	c.dmsProjectsClient = projectsClient
+
+	servicesClient := datamigration.NewServicesClientWithBaseURI(endpoint, subscriptionId)
+	c.configureClient(&servicesClient.Client, auth)
+	c.dmsServicesClient = servicesClient
+}
+
 func (c *ArmClient) registerDeviceClients(endpoint, subscriptionId string, auth autorest.Authorizer) {
 	iotClient := devices.NewIotHubResourceClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&iotClient.Client, auth)
 	c.iothubResourceClient = iotClient
+
+	iotCentralAppsClient := iotcentral.NewAppsClientWithBaseURI(endpoint, subscriptionId)
+	c.configureClient(&iotCentralAppsClient.Client, auth)
+	c.iotCentralAppsClient = iotCentralAppsClient
+
+	tsiEnvironmentsClient := timeseriesinsights.NewEnvironmentsClientWithBaseURI(endpoint, subscriptionId)
+	c.configureClient(&tsiEnvironmentsClient.Client, auth)
+	c.tsiEnvironmentsClient = tsiEnvironmentsClient
+
+	tsiAccessPoliciesClient := timeseriesinsights.NewAccessPoliciesClientWithBaseURI(endpoint, subscriptionId)
+	c.configureClient(&tsiAccessPoliciesClient.Client, auth)
+	c.tsiAccessPoliciesClient = tsiAccessPoliciesClient
+
+	tsiEventSourcesClient := timeseriesinsights.NewEventSourcesClientWithBaseURI(endpoint, subscriptionId)
+	c.configureClient(&tsiEventSourcesClient.Client, auth)
+	c.tsiEventSourcesClient = tsiEventSourcesClient
 }
 
 func (c *ArmClient) registerDevTestClients(endpoint, subscriptionId string, auth autorest.Authorizer) {
@@ -785,6 +941,12 @@ func (c *ArmClient) registerEventHubClients(endpoint, subscriptionId string, aut
 	c.eventHubNamespacesClient = ehnc
 }
 
+func (c *ArmClient) registerFrontDoorClients(endpoint, subscriptionId string, auth autorest.Authorizer) {
+	frontDoorsClient := frontdoor.NewFrontDoorsClientWithBaseURI(endpoint, subscriptionId)
+	c.configureClient(&frontDoorsClient.Client, auth)
+	c.frontDoorsClient = frontDoorsClient
+}
+
 func (c *ArmClient) registerKeyVaultClients(endpoint, subscriptionId string, auth autorest.Authorizer, keyVaultAuth autorest.Authorizer) {
 	keyVaultClient := keyvault.NewVaultsClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&keyVaultClient.Client, auth)
@@ -801,6 +963,20 @@ func (c *ArmClient) registerLogicClients(endpoint, subscriptionId string, auth a
 	c.logicWorkflowsClient = workflowsClient
 }
 
+func (c *ArmClient) registerMigrateClients(endpoint, subscriptionId string, auth autorest.Authorizer) {
+	assessmentsClient := migrate.NewAssessmentsClientWithBaseURI(endpoint, subscriptionId, "")
+	c.configureClient(&assessmentsClient.Client, auth)
+	c.migrateAssessmentsClient = assessmentsClient
+
+	groupsClient := migrate.NewGroupsClientWithBaseURI(endpoint, subscriptionId, "")
+	c.configureClient(&groupsClient.Client, auth)
+	c.migrateGroupsClient = groupsClient
+
+	projectsClient := migrate.NewProjectsClientWithBaseURI(endpoint, subscriptionId, "")
+	c.configureClient(&projectsClient.Client, auth)
+	c.migrateProjectsClient = projectsClient
+}
+
 func (c *ArmClient) registerMonitorClients(endpoint, subscriptionId string, auth autorest.Authorizer) {
 	agc := insights.NewActionGroupsClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&agc.Client, auth)
@@ -810,6 +986,10 @@ func (c *ArmClient) registerMonitorClients(endpoint, subscriptionId string, auth
 	c.configureClient(&alac.Client, auth)
 	c.monitorActivityLogAlertsClient = alac
 
+	alc := insights.NewActivityLogsClientWithBaseURI(endpoint, subscriptionId)
+	c.configureClient(&alc.Client, auth)
+	c.monitorActivityLogsClient = alc
+
 	arc := insights.NewAlertRulesClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&arc.Client, auth)
 	c.monitorAlertRulesClient = arc
@@ -840,6 +1020,10 @@ func (c *ArmClient) registerNetworkingClients(endpoint, subscriptionId string, a
 	c.configureClient(&azureFirewallsClient.Client, auth)
 	c.azureFirewallsClient = azureFirewallsClient
 
+	ddosProtectionPlanClient := network.NewDdosProtectionPlansClientWithBaseURI(endpoint, subscriptionId)
+	c.configureClient(&ddosProtectionPlanClient.Client, auth)
+	c.ddosProtectionPlanClient = ddosProtectionPlanClient
+
 	expressRouteAuthsClient := network.NewExpressRouteCircuitAuthorizationsClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&expressRouteAuthsClient.Client, auth)
 	c.expressRouteAuthsClient = expressRouteAuthsClient
@@ -888,6 +1072,10 @@ func (c *ArmClient) registerNetworkingClients(endpoint, subscriptionId string, a
 	c.configureClient(&publicIPAddressesClient.Client, auth)
 	c.publicIPClient = publicIPAddressesClient
 
+	routeFiltersClient := network.NewRouteFiltersClientWithBaseURI(endpoint, subscriptionId)
+	c.configureClient(&routeFiltersClient.Client, auth)
+	c.routeFiltersClient = routeFiltersClient
+
 	routesClient := network.NewRoutesClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&routesClient.Client, auth)
 	c.routesClient = routesClient
@@ -912,6 +1100,22 @@ func (c *ArmClient) registerNetworkingClients(endpoint, subscriptionId string, a
 	c.configureClient(&userAssignedIdentitiesClient.Client, auth)
 	c.userAssignedIdentitiesClient = userAssignedIdentitiesClient
 
+	virtualHubClient := network.NewVirtualHubsClientWithBaseURI(endpoint, subscriptionId)
+	c.configureClient(&virtualHubClient.Client, auth)
+	c.virtualHubClient = virtualHubClient
+
+	virtualWanClient := network.NewVirtualWansClientWithBaseURI(endpoint, subscriptionId)
+	c.configureClient(&virtualWanClient.Client, auth)
+	c.virtualWanClient = virtualWanClient
+
+	vpnGatewayClient := network.NewVpnGatewaysClientWithBaseURI(endpoint, subscriptionId)
+	c.configureClient(&vpnGatewayClient.Client, auth)
+	c.vpnGatewayClient = vpnGatewayClient
+
+	vpnSitesClient := network.NewVpnSitesClientWithBaseURI(endpoint, subscriptionId)
+	c.configureClient(&vpnSitesClient.Client, auth)
+	c.vpnSitesClient = vpnSitesClient
+
 	watchersClient := network.NewWatchersClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&watchersClient.Client, auth)
 	c.watcherClient = watchersClient
@@ -953,6 +1157,38 @@ func (c *ArmClient) registerRecoveryServiceClients(endpoint, subscriptionId stri
 	protectionPoliciesClient := backup.NewProtectionPoliciesClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&protectionPoliciesClient.Client, auth)
 	c.recoveryServicesProtectionPoliciesClient = protectionPoliciesClient
+
+	protectionContainersClient := workloadBackup.NewProtectionContainersClientWithBaseURI(endpoint, subscriptionId)
+	c.configureClient(&protectionContainersClient.Client, auth)
+	c.recoveryServicesProtectionContainersClient = protectionContainersClient
+
+	protectableItemsClient := workloadBackup.NewProtectableItemsClientWithBaseURI(endpoint, subscriptionId)
+	c.configureClient(&protectableItemsClient.Client, auth)
+	c.recoveryServicesProtectableItemsClient = protectableItemsClient
+
+	workloadProtectedItemsClient := workloadBackup.NewProtectedItemsGroupClientWithBaseURI(endpoint, subscriptionId)
+	c.configureClient(&workloadProtectedItemsClient.Client, auth)
+	c.recoveryServicesWorkloadProtectedItemsClient = workloadProtectedItemsClient
+
+	workloadPoliciesClient := workloadBackup.NewProtectionPoliciesClientWithBaseURI(endpoint, subscriptionId)
+	c.configureClient(&workloadPoliciesClient.Client, auth)
+	c.recoveryServicesWorkloadPoliciesClient = workloadPoliciesClient
+
+	recoveryPointsClient := workloadBackup.NewRecoveryPointsClientWithBaseURI(endpoint, subscriptionId)
+	c.configureClient(&recoveryPointsClient.Client, auth)
+	c.recoveryServicesRecoveryPointsClient = recoveryPointsClient
+
+	restoresClient := workloadBackup.NewRestoresClientWithBaseURI(endpoint, subscriptionId)
+	c.configureClient(&restoresClient.Client, auth)
+	c.recoveryServicesRestoresClient = restoresClient
+
+	operationStatusesClient := workloadBackup.NewProtectedItemOperationStatusesClientWithBaseURI(endpoint, subscriptionId)
+	c.configureClient(&operationStatusesClient.Client, auth)
+	c.recoveryServicesOperationStatusesClient = operationStatusesClient
+
+	jobDetailsClient := workloadBackup.NewJobDetailsClientWithBaseURI(endpoint, subscriptionId)
+	c.configureClient(&jobDetailsClient.Client, auth)
+	c.recoveryServicesJobDetailsClient = jobDetailsClient
 }
 
 func (c *ArmClient) registerRedisClients(endpoint, subscriptionId string, auth autorest.Authorizer) {
@@ -984,6 +1220,11 @@ func (c *ArmClient) registerResourcesClients(endpoint, subscriptionId string, au
 	c.configureClient(&deploymentsClient.Client, auth)
 	c.deploymentsClient = deploymentsClient
 
+	// deployments at the Subscription scope were introduced in API version 2018-05-01
+	subscriptionDeploymentsClient := subscriptionResources.NewDeploymentsClientWithBaseURI(endpoint, subscriptionId)
+	c.configureClient(&subscriptionDeploymentsClient.Client, auth)
+	c.subscriptionDeploymentsClient = subscriptionDeploymentsClient
+
 	resourcesClient := resources.NewClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&resourcesClient.Client, auth)
 	c.resourcesClient = resourcesClient
@@ -1070,7 +1311,7 @@ func (c *ArmClient) registerStorageClients(endpoint, subscriptionId string, auth
 	c.configureClient(&accountsClient.Client, auth)
 	c.storageServiceClient = accountsClient
 
-	usageClient := storage.NewUsageClientWithBaseURI(endpoint, subscriptionId)
+	usageClient := storage.NewUsagesClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&usageClient.Client, auth)
 	c.storageUsageClient = usageClient
 }
@@ -1090,13 +1331,25 @@ func (c *ArmClient) registerTrafficManagerClients(endpoint, subscriptionId strin
 }
 
 func (c *ArmClient) registerWebClients(endpoint, subscriptionId string, auth autorest.Authorizer) {
+	apiConnectionsClient := webPreview.NewConnectionsClientWithBaseURI(endpoint, subscriptionId)
+	c.configureClient(&apiConnectionsClient.Client, auth)
+	c.apiConnectionsClient = apiConnectionsClient
+
 	appServicePlansClient := web.NewAppServicePlansClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&appServicePlansClient.Client, auth)
 	c.appServicePlansClient = appServicePlansClient
 
+	appServiceProviderClient := web.NewProviderClientWithBaseURI(endpoint, subscriptionId)
+	c.configureClient(&appServiceProviderClient.Client, auth)
+	c.appServiceProviderClient = appServiceProviderClient
+
 	appsClient := web.NewAppsClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&appsClient.Client, auth)
 	c.appServicesClient = appsClient
+
+	managedApisClient := webPreview.NewManagedApisClientWithBaseURI(endpoint, subscriptionId)
+	c.configureClient(&managedApisClient.Client, auth)
+	c.managedApisClient = managedApisClient
 }
 
 func (c *ArmClient) registerPolicyClients(endpoint, subscriptionId string, auth autorest.Authorizer) {
@@ -1107,6 +1360,10 @@ func (c *ArmClient) registerPolicyClients(endpoint, subscriptionId string, auth
 	policyDefinitionsClient := policy.NewDefinitionsClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&policyDefinitionsClient.Client, auth)
 	c.policyDefinitionsClient = policyDefinitionsClient
+
+	policyStatesClient := policyinsights.NewPolicyStatesClientWithBaseURI(endpoint)
+	c.configureClient(&policyStatesClient.Client, auth)
+	c.policyStatesClient = policyStatesClient
 }
 
 func (c *ArmClient) registerManagementGroupClients(endpoint string, auth autorest.Authorizer) {
@@ -1170,6 +1427,16 @@ func (armClient *ArmClient) getKeyForStorageAccount(ctx context.Context, resourc
 }
 
 func (armClient *ArmClient) getBlobStorageClientForStorageAccount(ctx context.Context, resourceGroupName, storageAccountName string) (*mainStorage.BlobStorageClient, bool, error) {
+	if armClient.storageUseAzureAD {
+		storageClient, accountExists, err := armClient.getAzureADStorageClientForStorageAccount(ctx, resourceGroupName, storageAccountName)
+		if err != nil || !accountExists {
+			return nil, accountExists, err
+		}
+
+		blobClient := storageClient.GetBlobService()
+		return &blobClient, true, nil
+	}
+
 	key, accountExists, err := armClient.getKeyForStorageAccount(ctx, resourceGroupName, storageAccountName)
 	if err != nil {
 		return nil, accountExists, err
@@ -1227,6 +1494,16 @@ func (armClient *ArmClient) getTableServiceClientForStorageAccount(ctx context.C
 }
 
 func (armClient *ArmClient) getQueueServiceClientForStorageAccount(ctx context.Context, resourceGroupName, storageAccountName string) (*mainStorage.QueueServiceClient, bool, error) {
+	if armClient.storageUseAzureAD {
+		storageClient, accountExists, err := armClient.getAzureADStorageClientForStorageAccount(ctx, resourceGroupName, storageAccountName)
+		if err != nil || !accountExists {
+			return nil, accountExists, err
+		}
+
+		queueClient := storageClient.GetQueueService()
+		return &queueClient, true, nil
+	}
+
 	key, accountExists, err := armClient.getKeyForStorageAccount(ctx, resourceGroupName, storageAccountName)
 	if err != nil {
 		return nil, accountExists, err
@@ -1244,3 +1521,55 @@ func (armClient *ArmClient) getQueueServiceClientForStorageAccount(ctx context.C
 	queueClient := storageClient.GetQueueService()
 	return &queueClient, true, nil
 }
+
+// the AzureAD resource used to obtain tokens for the Storage data plane, which is the same across clouds
+const storageAzureADResource = "https://storage.azure.com/"
+
+// authenticating to the Storage data plane using AzureAD requires API Version 2017-11-09 or later
+const storageAzureADAPIVersion = "2017-11-09"
+
+// getAzureADStorageClientForStorageAccount returns a Storage Client which authenticates using an AzureAD token
+// rather than the Access Key for the Storage Account, which allows Accounts where Shared Key access is disabled to be used
+func (armClient *ArmClient) getAzureADStorageClientForStorageAccount(ctx context.Context, resourceGroupName, storageAccountName string) (*mainStorage.Client, bool, error) {
+	account, err := armClient.storageServiceClient.GetProperties(ctx, resourceGroupName, storageAccountName)
+	if err != nil {
+		if utils.ResponseWasNotFound(account.Response) {
+			return nil, false, nil
+		}
+
+		return nil, true, fmt.Errorf("Error retrieving Storage Account %q (Resource Group %q): %s", storageAccountName, resourceGroupName, err)
+	}
+
+	// the Storage SDK requires an Account Key to construct a Client - however since the `Authorization` header
+	// is replaced with a Bearer token by the Sender below, this placeholder is never used to sign a request
+	placeholderKey := base64.StdEncoding.EncodeToString([]byte(storageAccountName))
+	storageClient, err := mainStorage.NewClient(storageAccountName, placeholderKey, armClient.environment.StorageEndpointSuffix,
+		storageAzureADAPIVersion, true)
+	if err != nil {
+		return nil, true, fmt.Errorf("Error creating AzureAD storage client for Storage Account %q: %s", storageAccountName, err)
+	}
+
+	storageClient.Sender = storageAzureADSender{
+		authorizer: armClient.storageAuth,
+		sender:     storageClient.Sender,
+	}
+
+	return &storageClient, true, nil
+}
+
+// storageAzureADSender replaces the Shared Key signature generated by the Storage SDK with an AzureAD Bearer token
+type storageAzureADSender struct {
+	authorizer autorest.Authorizer
+	sender     mainStorage.Sender
+}
+
+func (s storageAzureADSender) Send(c *mainStorage.Client, req *http.Request) (*http.Response, error) {
+	req.Header.Del("Authorization")
+
+	req, err := autorest.Prepare(req, s.authorizer.WithAuthorization())
+	if err != nil {
+		return nil, fmt.Errorf("Error authorizing Storage request using AzureAD: %s", err)
+	}
+
+	return s.sender.Send(c, req)
+}
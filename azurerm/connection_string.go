@@ -0,0 +1,42 @@
+package azurerm
+
+import (
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+const (
+	connectionStringKeyPrimary   = "primary"
+	connectionStringKeySecondary = "secondary"
+)
+
+// connectionStringKeySchema is used by the Connection String Data Sources to select which of the keys
+// is used, which allows consumers to switch between the keys whilst they're being rotated
+func connectionStringKeySchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeString,
+		Optional: true,
+		Default:  connectionStringKeyPrimary,
+		ValidateFunc: validation.StringInSlice([]string{
+			connectionStringKeyPrimary,
+			connectionStringKeySecondary,
+		}, false),
+	}
+}
+
+// connectionStringWithoutEntityPath removes the `EntityPath` component from an Event Hub / Service Bus
+// Connection String, since some SDK's require the Entity to be specified separately
+func connectionStringWithoutEntityPath(input string) string {
+	components := make([]string, 0)
+	for _, component := range strings.Split(input, ";") {
+		if strings.HasPrefix(strings.ToLower(component), "entitypath=") {
+			continue
+		}
+
+		components = append(components, component)
+	}
+
+	return strings.Join(components, ";")
+}
@@ -0,0 +1,30 @@
+package azurerm
+
+import "testing"
+
+func TestConnectionStringWithoutEntityPath(t *testing.T) {
+	cases := []struct {
+		Input    string
+		Expected string
+	}{
+		{
+			Input:    "Endpoint=sb://example.servicebus.windows.net/;SharedAccessKeyName=send;SharedAccessKey=abc123=",
+			Expected: "Endpoint=sb://example.servicebus.windows.net/;SharedAccessKeyName=send;SharedAccessKey=abc123=",
+		},
+		{
+			Input:    "Endpoint=sb://example.servicebus.windows.net/;SharedAccessKeyName=send;SharedAccessKey=abc123=;EntityPath=example",
+			Expected: "Endpoint=sb://example.servicebus.windows.net/;SharedAccessKeyName=send;SharedAccessKey=abc123=",
+		},
+		{
+			Input:    "Endpoint=sb://example.servicebus.windows.net/;entityPath=example;SharedAccessKeyName=send;SharedAccessKey=abc123=",
+			Expected: "Endpoint=sb://example.servicebus.windows.net/;SharedAccessKeyName=send;SharedAccessKey=abc123=",
+		},
+	}
+
+	for _, v := range cases {
+		actual := connectionStringWithoutEntityPath(v.Input)
+		if v.Expected != actual {
+			t.Fatalf("Expected %q but got %q", v.Expected, actual)
+		}
+	}
+}
@@ -0,0 +1,145 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/services/web/mgmt/2018-02-01/web"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func dataSourceArmAppServiceAvailableStacks() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceArmAppServiceAvailableStacksRead,
+
+		Schema: map[string]*schema.Schema{
+			"os_type": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "Windows",
+				ValidateFunc: validation.StringInSlice([]string{
+					"Linux",
+					"Windows",
+				}, false),
+			},
+
+			"name": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"stacks": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"display_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"default_version": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"versions": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceArmAppServiceAvailableStacksRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).appServiceProviderClient
+	ctx := meta.(*ArmClient).StopContext
+
+	osType := d.Get("os_type").(string)
+	name := d.Get("name").(string)
+
+	log.Printf("[DEBUG] Listing the available App Service Stacks for %q", osType)
+	iterator, err := client.GetAvailableStacksComplete(ctx, osType)
+	if err != nil {
+		return fmt.Errorf("Error listing the available App Service Stacks for %q: %+v", osType, err)
+	}
+
+	stacks := make([]web.ApplicationStack, 0)
+	for iterator.NotDone() {
+		stack := iterator.Value()
+		if stack.Name != nil && (name == "" || strings.EqualFold(*stack.Name, name)) {
+			stacks = append(stacks, stack)
+		}
+
+		if err := iterator.Next(); err != nil {
+			return fmt.Errorf("Error listing the available App Service Stacks for %q: %+v", osType, err)
+		}
+	}
+
+	if name != "" && len(stacks) == 0 {
+		return fmt.Errorf("Error: App Service Stack %q was not found for %q", name, osType)
+	}
+
+	d.SetId(fmt.Sprintf("appServiceAvailableStacks-%s-%s", osType, name))
+
+	if err := d.Set("stacks", flattenAppServiceAvailableStacks(stacks)); err != nil {
+		return fmt.Errorf("Error setting `stacks`: %+v", err)
+	}
+
+	return nil
+}
+
+func flattenAppServiceAvailableStacks(input []web.ApplicationStack) []interface{} {
+	results := make([]interface{}, 0)
+
+	for _, stack := range input {
+		output := make(map[string]interface{})
+
+		if stack.Name != nil {
+			output["name"] = *stack.Name
+		}
+
+		if stack.Display != nil {
+			output["display_name"] = *stack.Display
+		}
+
+		defaultVersion := ""
+		versions := make([]interface{}, 0)
+		if majorVersions := stack.MajorVersions; majorVersions != nil {
+			for _, major := range *majorVersions {
+				if major.RuntimeVersion != nil && *major.RuntimeVersion != "" {
+					versions = append(versions, *major.RuntimeVersion)
+
+					if major.IsDefault != nil && *major.IsDefault {
+						defaultVersion = *major.RuntimeVersion
+					}
+				}
+
+				if minorVersions := major.MinorVersions; minorVersions != nil {
+					for _, minor := range *minorVersions {
+						if minor.RuntimeVersion != nil && *minor.RuntimeVersion != "" {
+							versions = append(versions, *minor.RuntimeVersion)
+						}
+					}
+				}
+			}
+		}
+		output["default_version"] = defaultVersion
+		output["versions"] = versions
+
+		results = append(results, output)
+	}
+
+	return results
+}
@@ -0,0 +1,58 @@
+package azurerm
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccDataSourceAzureRMAppServiceAvailableStacks_windows(t *testing.T) {
+	dataSourceName := "data.azurerm_app_service_available_stacks.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceAppServiceAvailableStacks_windows(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(dataSourceName, "stacks.#"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDataSourceAzureRMAppServiceAvailableStacks_linuxByName(t *testing.T) {
+	dataSourceName := "data.azurerm_app_service_available_stacks.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceAppServiceAvailableStacks_linuxByName(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "stacks.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "stacks.0.name", "php"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "stacks.0.versions.#"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceAppServiceAvailableStacks_windows() string {
+	return `
+data "azurerm_app_service_available_stacks" "test" {}
+`
+}
+
+func testAccDataSourceAppServiceAvailableStacks_linuxByName() string {
+	return `
+data "azurerm_app_service_available_stacks" "test" {
+  os_type = "Linux"
+  name    = "php"
+}
+`
+}
@@ -26,8 +26,9 @@ func dataSourceArmContainerRegistry() *schema.Resource {
 				Computed: true,
 			},
 			"admin_password": {
-				Type:     schema.TypeString,
-				Computed: true,
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
 			},
 			"admin_username": {
 				Type:     schema.TypeString,
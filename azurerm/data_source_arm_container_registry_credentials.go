@@ -0,0 +1,100 @@
+package azurerm
+
+import (
+	"fmt"
+
+	"github.com/Azure/azure-sdk-for-go/services/containerregistry/mgmt/2017-10-01/containerregistry"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func dataSourceArmContainerRegistryCredentials() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceArmContainerRegistryCredentialsRead,
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateAzureRMContainerRegistryName,
+			},
+
+			"resource_group_name": resourceGroupNameForDataSourceSchema(),
+
+			"login_server": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"username": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"password": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+
+			"password2": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+		},
+	}
+}
+
+func dataSourceArmContainerRegistryCredentialsRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).containerRegistryClient
+	ctx := meta.(*ArmClient).StopContext
+
+	name := d.Get("name").(string)
+	resourceGroup := d.Get("resource_group_name").(string)
+
+	resp, err := client.Get(ctx, resourceGroup, name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			return fmt.Errorf("Container Registry %q was not found in Resource Group %q", name, resourceGroup)
+		}
+
+		return fmt.Errorf("Error retrieving Container Registry %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	if resp.AdminUserEnabled == nil || !*resp.AdminUserEnabled {
+		return fmt.Errorf("The Admin User must be enabled on Container Registry %q (Resource Group %q) to retrieve its Credentials", name, resourceGroup)
+	}
+
+	credsResp, err := client.ListCredentials(ctx, resourceGroup, name)
+	if err != nil {
+		return fmt.Errorf("Error retrieving Credentials for Container Registry %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	d.SetId(*resp.ID)
+	d.Set("name", resp.Name)
+	d.Set("resource_group_name", resourceGroup)
+	d.Set("login_server", resp.LoginServer)
+	d.Set("username", credsResp.Username)
+
+	password := ""
+	password2 := ""
+	if passwords := credsResp.Passwords; passwords != nil {
+		for _, v := range *passwords {
+			if v.Value == nil {
+				continue
+			}
+
+			switch v.Name {
+			case containerregistry.Password:
+				password = *v.Value
+			case containerregistry.Password2:
+				password2 = *v.Value
+			}
+		}
+	}
+	d.Set("password", password)
+	d.Set("password2", password2)
+
+	return nil
+}
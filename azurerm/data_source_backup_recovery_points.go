@@ -0,0 +1,137 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/recoveryservices/mgmt/2017-07-01/backup"
+
+	"github.com/Azure/go-autorest/autorest/date"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+func dataSourceArmBackupRecoveryPoints() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceArmBackupRecoveryPointsRead,
+
+		Schema: map[string]*schema.Schema{
+			"protected_item_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: azure.ValidateResourceID,
+			},
+
+			"recovery_points": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"recovery_point_time": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"recovery_point_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceArmBackupRecoveryPointsRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).recoveryServicesRecoveryPointsClient
+	ctx := meta.(*ArmClient).StopContext
+
+	protectedItemId := d.Get("protected_item_id").(string)
+	id, err := parseAzureResourceID(protectedItemId)
+	if err != nil {
+		return err
+	}
+
+	resourceGroup := id.ResourceGroup
+	vaultName := id.Path["vaults"]
+	fabricName := id.Path["backupFabrics"]
+	containerName := id.Path["protectionContainers"]
+	protectedItemName := id.Path["protectedItems"]
+	if vaultName == "" || fabricName == "" || containerName == "" || protectedItemName == "" {
+		return fmt.Errorf("Error: `protected_item_id` %q is not the ID of a Backup Protected Item", protectedItemId)
+	}
+
+	log.Printf("[DEBUG] Listing Recovery Points for Backup Protected Item %q (Vault %q / Resource Group %q)", protectedItemName, vaultName, resourceGroup)
+
+	results, err := client.ListComplete(ctx, vaultName, resourceGroup, fabricName, containerName, protectedItemName, "")
+	if err != nil {
+		return fmt.Errorf("Error listing Recovery Points for Backup Protected Item %q (Vault %q / Resource Group %q): %+v", protectedItemName, vaultName, resourceGroup, err)
+	}
+
+	recoveryPoints := make([]interface{}, 0)
+	for results.NotDone() {
+		recoveryPoints = append(recoveryPoints, flattenArmBackupRecoveryPoint(results.Value()))
+
+		if err := results.Next(); err != nil {
+			return fmt.Errorf("Error listing Recovery Points for Backup Protected Item %q (Vault %q / Resource Group %q): %+v", protectedItemName, vaultName, resourceGroup, err)
+		}
+	}
+
+	d.SetId(protectedItemId)
+
+	if err := d.Set("recovery_points", recoveryPoints); err != nil {
+		return fmt.Errorf("Error setting `recovery_points`: %+v", err)
+	}
+
+	return nil
+}
+
+func flattenArmBackupRecoveryPoint(input backup.RecoveryPointResource) map[string]interface{} {
+	output := make(map[string]interface{})
+
+	if input.ID != nil {
+		output["id"] = *input.ID
+	}
+	if input.Name != nil {
+		output["name"] = *input.Name
+	}
+
+	var pointTime *date.Time
+	pointType := ""
+	if props := input.Properties; props != nil {
+		if vm, ok := props.AsIaasVMRecoveryPoint(); ok && vm != nil {
+			pointTime = vm.RecoveryPointTime
+			if vm.RecoveryPointType != nil {
+				pointType = *vm.RecoveryPointType
+			}
+		} else if sql, ok := props.AsAzureWorkloadSQLRecoveryPoint(); ok && sql != nil {
+			pointTime = sql.RecoveryPointTimeInUTC
+			pointType = string(sql.Type)
+		} else if hana, ok := props.AsAzureWorkloadSAPHanaRecoveryPoint(); ok && hana != nil {
+			pointTime = hana.RecoveryPointTimeInUTC
+			pointType = string(hana.Type)
+		} else if workload, ok := props.AsAzureWorkloadRecoveryPoint(); ok && workload != nil {
+			pointTime = workload.RecoveryPointTimeInUTC
+			pointType = string(workload.Type)
+		}
+	}
+
+	if pointTime != nil {
+		output["recovery_point_time"] = pointTime.Format(time.RFC3339)
+	}
+	output["recovery_point_type"] = pointType
+
+	return output
+}
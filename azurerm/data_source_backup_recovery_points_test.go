@@ -0,0 +1,43 @@
+package azurerm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccDataSourceAzureRMBackupRecoveryPoints_basic(t *testing.T) {
+	dataSourceName := "data.azurerm_backup_recovery_points.test"
+	ri := acctest.RandInt()
+	location := testLocation()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceAzureRMBackupRecoveryPoints_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(dataSourceName, "protected_item_id"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "recovery_points.#"),
+				),
+			},
+			{ //vault cannot be deleted unless we unregister all backups
+				Config: testAccAzureRMRecoveryServicesProtectedVm_base(ri, location),
+				Check:  resource.ComposeTestCheckFunc(),
+			},
+		},
+	})
+}
+
+func testAccDataSourceAzureRMBackupRecoveryPoints_basic(rInt int, location string) string {
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_backup_recovery_points" "test" {
+  protected_item_id = "${azurerm_recovery_services_protected_vm.test.id}"
+}
+`, testAccAzureRMRecoveryServicesProtectedVm_basic(rInt, location))
+}
@@ -0,0 +1,100 @@
+package azurerm
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func dataSourceArmCosmosDBAccountConnectionString() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceArmCosmosDBAccountConnectionStringRead,
+
+		Schema: map[string]*schema.Schema{
+			"account_name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"resource_group_name": resourceGroupNameForDataSourceSchema(),
+
+			"key": connectionStringKeySchema(),
+
+			"read_only": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"connection_string": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+
+			"account_key": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+		},
+	}
+}
+
+func dataSourceArmCosmosDBAccountConnectionStringRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).cosmosDBClient
+	ctx := meta.(*ArmClient).StopContext
+
+	name := d.Get("account_name").(string)
+	resourceGroup := d.Get("resource_group_name").(string)
+	secondary := d.Get("key").(string) == connectionStringKeySecondary
+
+	resp, err := client.Get(ctx, resourceGroup, name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			return fmt.Errorf("Error: CosmosDB Account %q (Resource Group %q) was not found", name, resourceGroup)
+		}
+
+		return fmt.Errorf("Error retrieving CosmosDB Account %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	if resp.DatabaseAccountProperties == nil || resp.DatabaseAccountProperties.DocumentEndpoint == nil {
+		return fmt.Errorf("Error retrieving CosmosDB Account %q (Resource Group %q): `documentEndpoint` was nil", name, resourceGroup)
+	}
+	endpoint := *resp.DatabaseAccountProperties.DocumentEndpoint
+
+	var accountKey *string
+	if d.Get("read_only").(bool) {
+		keys, err := client.ListReadOnlyKeys(ctx, resourceGroup, name)
+		if err != nil {
+			return fmt.Errorf("Error listing read-only keys for CosmosDB Account %q (Resource Group %q): %+v", name, resourceGroup, err)
+		}
+
+		accountKey = keys.PrimaryReadonlyMasterKey
+		if secondary {
+			accountKey = keys.SecondaryReadonlyMasterKey
+		}
+	} else {
+		keys, err := client.ListKeys(ctx, resourceGroup, name)
+		if err != nil {
+			return fmt.Errorf("Error listing keys for CosmosDB Account %q (Resource Group %q): %+v", name, resourceGroup, err)
+		}
+
+		accountKey = keys.PrimaryMasterKey
+		if secondary {
+			accountKey = keys.SecondaryMasterKey
+		}
+	}
+
+	if accountKey == nil {
+		return fmt.Errorf("Error: the %s key was not returned for CosmosDB Account %q (Resource Group %q)", d.Get("key").(string), name, resourceGroup)
+	}
+
+	d.SetId(*resp.ID)
+
+	d.Set("connection_string", fmt.Sprintf("AccountEndpoint=%s;AccountKey=%s;", endpoint, *accountKey))
+	d.Set("account_key", *accountKey)
+
+	return nil
+}
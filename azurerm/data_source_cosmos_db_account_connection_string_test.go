@@ -0,0 +1,51 @@
+package azurerm
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/services/cosmos-db/mgmt/2015-04-08/documentdb"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccDataSourceAzureRMCosmosDBAccountConnectionString_basic(t *testing.T) {
+	ri := acctest.RandInt()
+	config := testAccDataSourceAzureRMCosmosDBAccountConnectionString_basic(ri, testLocation())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMCosmosDBAccountDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.azurerm_cosmosdb_account_connection_string.primary", "account_key", "azurerm_cosmosdb_account.test", "primary_master_key"),
+					resource.TestMatchResourceAttr("data.azurerm_cosmosdb_account_connection_string.primary", "connection_string", regexp.MustCompile("^AccountEndpoint=https://.+;AccountKey=.+;$")),
+					resource.TestCheckResourceAttrPair("data.azurerm_cosmosdb_account_connection_string.secondaryReadOnly", "account_key", "azurerm_cosmosdb_account.test", "secondary_readonly_master_key"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceAzureRMCosmosDBAccountConnectionString_basic(rInt int, location string) string {
+	template := testAccAzureRMCosmosDBAccount_basic(rInt, location, string(documentdb.Session), "", "")
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_cosmosdb_account_connection_string" "primary" {
+  account_name        = "${azurerm_cosmosdb_account.test.name}"
+  resource_group_name = "${azurerm_cosmosdb_account.test.resource_group_name}"
+}
+
+data "azurerm_cosmosdb_account_connection_string" "secondaryReadOnly" {
+  account_name        = "${azurerm_cosmosdb_account.test.name}"
+  resource_group_name = "${azurerm_cosmosdb_account.test.resource_group_name}"
+  key                 = "secondary"
+  read_only           = true
+}
+`, template)
+}
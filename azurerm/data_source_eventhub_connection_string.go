@@ -0,0 +1,126 @@
+package azurerm
+
+import (
+	"fmt"
+
+	"github.com/Azure/azure-sdk-for-go/services/eventhub/mgmt/2017-04-01/eventhub"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func dataSourceArmEventHubConnectionString() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceArmEventHubConnectionStringRead,
+
+		Schema: map[string]*schema.Schema{
+			"namespace_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: azure.ValidateEventHubNamespaceName(),
+			},
+
+			"resource_group_name": resourceGroupNameForDataSourceSchema(),
+
+			// when specified the Authorization Rule is looked up on the Event Hub rather than the Namespace
+			"eventhub_name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: azure.ValidateEventHubName(),
+			},
+
+			"authorization_rule_name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      eventHubNamespaceDefaultAuthorizationRule,
+				ValidateFunc: azure.ValidateEventHubAuthorizationRuleName(),
+			},
+
+			"key": connectionStringKeySchema(),
+
+			"include_entity_path": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+
+			"connection_string": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+
+			"shared_access_key": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+		},
+	}
+}
+
+func dataSourceArmEventHubConnectionStringRead(d *schema.ResourceData, meta interface{}) error {
+	namespacesClient := meta.(*ArmClient).eventHubNamespacesClient
+	eventHubsClient := meta.(*ArmClient).eventHubClient
+	ctx := meta.(*ArmClient).StopContext
+
+	resourceGroup := d.Get("resource_group_name").(string)
+	namespaceName := d.Get("namespace_name").(string)
+	eventHubName := d.Get("eventhub_name").(string)
+	ruleName := d.Get("authorization_rule_name").(string)
+
+	var rule eventhub.AuthorizationRule
+	var keys eventhub.AccessKeys
+	var err error
+	if eventHubName != "" {
+		rule, err = eventHubsClient.GetAuthorizationRule(ctx, resourceGroup, namespaceName, eventHubName, ruleName)
+		if err != nil {
+			if utils.ResponseWasNotFound(rule.Response) {
+				return fmt.Errorf("Error: Authorization Rule %q (EventHub %q / Namespace %q / Resource Group %q) was not found", ruleName, eventHubName, namespaceName, resourceGroup)
+			}
+
+			return fmt.Errorf("Error retrieving Authorization Rule %q (EventHub %q / Namespace %q / Resource Group %q): %+v", ruleName, eventHubName, namespaceName, resourceGroup, err)
+		}
+
+		keys, err = eventHubsClient.ListKeys(ctx, resourceGroup, namespaceName, eventHubName, ruleName)
+		if err != nil {
+			return fmt.Errorf("Error listing keys for Authorization Rule %q (EventHub %q / Namespace %q / Resource Group %q): %+v", ruleName, eventHubName, namespaceName, resourceGroup, err)
+		}
+	} else {
+		rule, err = namespacesClient.GetAuthorizationRule(ctx, resourceGroup, namespaceName, ruleName)
+		if err != nil {
+			if utils.ResponseWasNotFound(rule.Response) {
+				return fmt.Errorf("Error: Authorization Rule %q (EventHub Namespace %q / Resource Group %q) was not found", ruleName, namespaceName, resourceGroup)
+			}
+
+			return fmt.Errorf("Error retrieving Authorization Rule %q (EventHub Namespace %q / Resource Group %q): %+v", ruleName, namespaceName, resourceGroup, err)
+		}
+
+		keys, err = namespacesClient.ListKeys(ctx, resourceGroup, namespaceName, ruleName)
+		if err != nil {
+			return fmt.Errorf("Error listing keys for Authorization Rule %q (EventHub Namespace %q / Resource Group %q): %+v", ruleName, namespaceName, resourceGroup, err)
+		}
+	}
+
+	if rule.ID == nil {
+		return fmt.Errorf("Cannot read Authorization Rule %q (EventHub Namespace %q / Resource Group %q) ID", ruleName, namespaceName, resourceGroup)
+	}
+
+	d.SetId(*rule.ID)
+
+	connectionString := keys.PrimaryConnectionString
+	sharedAccessKey := keys.PrimaryKey
+	if d.Get("key").(string) == connectionStringKeySecondary {
+		connectionString = keys.SecondaryConnectionString
+		sharedAccessKey = keys.SecondaryKey
+	}
+
+	if connectionString != nil && !d.Get("include_entity_path").(bool) {
+		connectionString = utils.String(connectionStringWithoutEntityPath(*connectionString))
+	}
+
+	d.Set("connection_string", connectionString)
+	d.Set("shared_access_key", sharedAccessKey)
+
+	return nil
+}
@@ -0,0 +1,84 @@
+package azurerm
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccDataSourceAzureRMEventHubConnectionString_basic(t *testing.T) {
+	ri := acctest.RandInt()
+	config := testAccDataSourceAzureRMEventHubConnectionString_basic(ri, testLocation())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMEventHubAuthorizationRuleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.azurerm_eventhub_connection_string.namespace", "connection_string", "azurerm_eventhub_namespace.test", "default_secondary_connection_string"),
+					resource.TestCheckResourceAttrPair("data.azurerm_eventhub_connection_string.namespace", "shared_access_key", "azurerm_eventhub_namespace.test", "default_secondary_key"),
+					resource.TestCheckResourceAttrPair("data.azurerm_eventhub_connection_string.eventhub", "connection_string", "azurerm_eventhub_authorization_rule.test", "primary_connection_string"),
+					resource.TestMatchResourceAttr("data.azurerm_eventhub_connection_string.eventhub", "connection_string", regexp.MustCompile(";EntityPath=")),
+					testCheckAzureRMConnectionStringHasNoEntityPath("data.azurerm_eventhub_connection_string.noEntityPath"),
+					resource.TestCheckResourceAttrPair("data.azurerm_eventhub_connection_string.noEntityPath", "shared_access_key", "azurerm_eventhub_authorization_rule.test", "primary_key"),
+				),
+			},
+		},
+	})
+}
+
+func testCheckAzureRMConnectionStringHasNoEntityPath(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		connectionString := rs.Primary.Attributes["connection_string"]
+		if connectionString == "" {
+			return fmt.Errorf("Expected `connection_string` to be set for %q", resourceName)
+		}
+
+		if strings.Contains(strings.ToLower(connectionString), "entitypath=") {
+			return fmt.Errorf("Expected `connection_string` for %q not to contain an `EntityPath`", resourceName)
+		}
+
+		return nil
+	}
+}
+
+func testAccDataSourceAzureRMEventHubConnectionString_basic(rInt int, location string) string {
+	template := testAccAzureRMEventHubAuthorizationRule_base(rInt, location, true, true, false)
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_eventhub_connection_string" "namespace" {
+  namespace_name      = "${azurerm_eventhub_namespace.test.name}"
+  resource_group_name = "${azurerm_eventhub_namespace.test.resource_group_name}"
+  key                 = "secondary"
+}
+
+data "azurerm_eventhub_connection_string" "eventhub" {
+  namespace_name          = "${azurerm_eventhub_authorization_rule.test.namespace_name}"
+  eventhub_name           = "${azurerm_eventhub_authorization_rule.test.eventhub_name}"
+  authorization_rule_name = "${azurerm_eventhub_authorization_rule.test.name}"
+  resource_group_name     = "${azurerm_eventhub_authorization_rule.test.resource_group_name}"
+}
+
+data "azurerm_eventhub_connection_string" "noEntityPath" {
+  namespace_name          = "${azurerm_eventhub_authorization_rule.test.namespace_name}"
+  eventhub_name           = "${azurerm_eventhub_authorization_rule.test.eventhub_name}"
+  authorization_rule_name = "${azurerm_eventhub_authorization_rule.test.name}"
+  resource_group_name     = "${azurerm_eventhub_authorization_rule.test.resource_group_name}"
+  include_entity_path     = false
+}
+`, template)
+}
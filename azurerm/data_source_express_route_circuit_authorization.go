@@ -0,0 +1,70 @@
+package azurerm
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func dataSourceArmExpressRouteCircuitAuthorization() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceArmExpressRouteCircuitAuthorizationRead,
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"resource_group_name": resourceGroupNameForDataSourceSchema(),
+
+			"express_route_circuit_name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"authorization_key": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+
+			"authorization_use_status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceArmExpressRouteCircuitAuthorizationRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).expressRouteAuthsClient
+	ctx := meta.(*ArmClient).StopContext
+
+	name := d.Get("name").(string)
+	resourceGroup := d.Get("resource_group_name").(string)
+	circuitName := d.Get("express_route_circuit_name").(string)
+
+	resp, err := client.Get(ctx, resourceGroup, circuitName, name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			return fmt.Errorf("Error: Express Route Circuit Authorization %q (Circuit %q / Resource Group %q) was not found", name, circuitName, resourceGroup)
+		}
+
+		return fmt.Errorf("Error retrieving Express Route Circuit Authorization %q (Circuit %q / Resource Group %q): %+v", name, circuitName, resourceGroup, err)
+	}
+
+	d.SetId(*resp.ID)
+
+	d.Set("name", name)
+	d.Set("resource_group_name", resourceGroup)
+	d.Set("express_route_circuit_name", circuitName)
+
+	if props := resp.AuthorizationPropertiesFormat; props != nil {
+		d.Set("authorization_key", props.AuthorizationKey)
+		d.Set("authorization_use_status", string(props.AuthorizationUseStatus))
+	}
+
+	return nil
+}
@@ -0,0 +1,42 @@
+package azurerm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+// NOTE: this is run as a part of the combined TestAccAzureRMExpressRouteCircuit test
+func testAccDataSourceAzureRMExpressRouteCircuitAuthorization_basic(t *testing.T) {
+	dataSourceName := "data.azurerm_express_route_circuit_authorization.test"
+	ri := acctest.RandInt()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceAzureRMExpressRouteCircuitAuthorization_basicConfig(ri, testLocation()),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(dataSourceName, "authorization_key"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "authorization_use_status"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceAzureRMExpressRouteCircuitAuthorization_basicConfig(rInt int, location string) string {
+	config := testAccAzureRMExpressRouteCircuitAuthorization_basicConfig(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_express_route_circuit_authorization" "test" {
+  name                       = "${azurerm_express_route_circuit_authorization.test.name}"
+  express_route_circuit_name = "${azurerm_express_route_circuit_authorization.test.express_route_circuit_name}"
+  resource_group_name        = "${azurerm_express_route_circuit_authorization.test.resource_group_name}"
+}
+`, config)
+}
@@ -2,6 +2,7 @@ package azurerm
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/Azure/azure-sdk-for-go/services/containerservice/mgmt/2018-03-31/containerservice"
 	"github.com/hashicorp/terraform/helper/schema"
@@ -183,6 +184,11 @@ func dataSourceArmKubernetesCluster() *schema.Resource {
 							Computed: true,
 						},
 
+						"network_policy": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
 						"service_cidr": {
 							Type:     schema.TypeString,
 							Computed: true,
@@ -244,6 +250,53 @@ func dataSourceArmKubernetesCluster() *schema.Resource {
 								},
 							},
 						},
+
+						"azure_policy": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"enabled": {
+										Type:     schema.TypeBool,
+										Computed: true,
+									},
+								},
+							},
+						},
+
+						"open_service_mesh": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"enabled": {
+										Type:     schema.TypeBool,
+										Computed: true,
+									},
+								},
+							},
+						},
+
+						"azure_keyvault_secrets_provider": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"enabled": {
+										Type:     schema.TypeBool,
+										Computed: true,
+									},
+									"secret_rotation_enabled": {
+										Type:     schema.TypeBool,
+										Computed: true,
+									},
+									"secret_rotation_interval": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
 					},
 				},
 			},
@@ -453,6 +506,7 @@ func flattenKubernetesClusterDataSourceNetworkProfile(profile *containerservice.
 	values := make(map[string]interface{})
 
 	values["network_plugin"] = profile.NetworkPlugin
+	values["network_policy"] = string(profile.NetworkPolicy)
 
 	if profile.ServiceCidr != nil {
 		values["service_cidr"] = *profile.ServiceCidr
@@ -516,5 +570,60 @@ func flattenKubernetesClusterDataSourceAddonProfiles(profile map[string]*contain
 	}
 	values["oms_agent"] = agents
 
+	azurePolicies := make([]interface{}, 0)
+	if azurePolicy := profile["azurepolicy"]; azurePolicy != nil {
+		enabled := false
+		if enabledVal := azurePolicy.Enabled; enabledVal != nil {
+			enabled = *enabledVal
+		}
+
+		output := map[string]interface{}{
+			"enabled": enabled,
+		}
+		azurePolicies = append(azurePolicies, output)
+	}
+	values["azure_policy"] = azurePolicies
+
+	meshes := make([]interface{}, 0)
+	if openServiceMesh := profile["openServiceMesh"]; openServiceMesh != nil {
+		enabled := false
+		if enabledVal := openServiceMesh.Enabled; enabledVal != nil {
+			enabled = *enabledVal
+		}
+
+		output := map[string]interface{}{
+			"enabled": enabled,
+		}
+		meshes = append(meshes, output)
+	}
+	values["open_service_mesh"] = meshes
+
+	secretsProviders := make([]interface{}, 0)
+	if secretsProvider := profile["azureKeyvaultSecretsProvider"]; secretsProvider != nil {
+		enabled := false
+		if enabledVal := secretsProvider.Enabled; enabledVal != nil {
+			enabled = *enabledVal
+		}
+
+		rotationEnabled := false
+		if v := secretsProvider.Config["enableSecretRotation"]; v != nil {
+			rotationEnabled = strings.EqualFold(*v, "true")
+		}
+
+		// the API omits the interval when it's the default
+		rotationInterval := "2m"
+		if v := secretsProvider.Config["rotationPollInterval"]; v != nil && *v != "" {
+			rotationInterval = *v
+		}
+
+		output := map[string]interface{}{
+			"enabled":                  enabled,
+			"secret_rotation_enabled":  rotationEnabled,
+			"secret_rotation_interval": rotationInterval,
+		}
+		secretsProviders = append(secretsProviders, output)
+	}
+	values["azure_keyvault_secrets_provider"] = secretsProviders
+
 	return []interface{}{values}
 }
@@ -0,0 +1,100 @@
+package azurerm
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceArmKubernetesServiceVersions() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceArmKubernetesServiceVersionsRead,
+
+		Schema: map[string]*schema.Schema{
+			"location": {
+				Type:             schema.TypeString,
+				Required:         true,
+				StateFunc:        azureRMNormalizeLocation,
+				DiffSuppressFunc: azureRMSuppressLocationDiff,
+			},
+
+			"version_prefix": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"versions": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+
+			"latest_version": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceArmKubernetesServiceVersionsRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).containerServicesClient
+	ctx := meta.(*ArmClient).StopContext
+
+	location := azureRMNormalizeLocation(d.Get("location").(string))
+	versionPrefix := d.Get("version_prefix").(string)
+
+	resp, err := client.ListOrchestrators(ctx, location, "managedClusters")
+	if err != nil {
+		return fmt.Errorf("Error listing Kubernetes Versions in %q: %+v", location, err)
+	}
+
+	if resp.ID == nil {
+		return fmt.Errorf("Error listing Kubernetes Versions in %q: `id` was nil", location)
+	}
+
+	versions := make([]*version.Version, 0)
+	if props := resp.OrchestratorVersionProfileProperties; props != nil && props.Orchestrators != nil {
+		for _, orchestrator := range *props.Orchestrators {
+			if orchestrator.OrchestratorType == nil || !strings.EqualFold(*orchestrator.OrchestratorType, "Kubernetes") {
+				continue
+			}
+
+			if orchestrator.OrchestratorVersion == nil || !strings.HasPrefix(*orchestrator.OrchestratorVersion, versionPrefix) {
+				continue
+			}
+
+			v, err := version.NewVersion(*orchestrator.OrchestratorVersion)
+			if err != nil {
+				return fmt.Errorf("Error parsing Kubernetes Version %q: %+v", *orchestrator.OrchestratorVersion, err)
+			}
+			versions = append(versions, v)
+		}
+	}
+
+	sort.Sort(version.Collection(versions))
+
+	output := make([]string, 0)
+	for _, v := range versions {
+		output = append(output, v.String())
+	}
+
+	latestVersion := ""
+	if len(output) > 0 {
+		latestVersion = output[len(output)-1]
+	}
+
+	d.SetId(*resp.ID)
+
+	if err := d.Set("versions", output); err != nil {
+		return fmt.Errorf("Error setting `versions`: %+v", err)
+	}
+	d.Set("latest_version", latestVersion)
+
+	return nil
+}
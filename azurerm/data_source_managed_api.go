@@ -0,0 +1,64 @@
+package azurerm
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func dataSourceArmManagedApi() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceArmManagedApiRead,
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+
+			"location": locationSchema(),
+
+			"display_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceArmManagedApiRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).managedApisClient
+	ctx := meta.(*ArmClient).StopContext
+
+	name := d.Get("name").(string)
+	location := azureRMNormalizeLocation(d.Get("location").(string))
+
+	resp, err := client.Get(ctx, location, name, nil)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			return fmt.Errorf("Error: Managed API %q was not found in %q", name, location)
+		}
+
+		return fmt.Errorf("Error retrieving Managed API %q (Location %q): %+v", name, location, err)
+	}
+
+	if resp.ID == nil {
+		return fmt.Errorf("Error retrieving Managed API %q (Location %q): `id` was nil", name, location)
+	}
+
+	d.SetId(*resp.ID)
+
+	d.Set("name", resp.Name)
+	d.Set("location", location)
+
+	if props := resp.APIEntityProperties; props != nil {
+		if info := props.GeneralInformation; info != nil && info.GeneralAPIInformationProperties != nil {
+			d.Set("display_name", info.GeneralAPIInformationProperties.DisplayName)
+		}
+	}
+
+	return nil
+}
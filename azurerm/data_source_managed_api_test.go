@@ -0,0 +1,37 @@
+package azurerm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccDataSourceAzureRMManagedApi_basic(t *testing.T) {
+	dataSourceName := "data.azurerm_managed_api.test"
+	location := testLocation()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceAzureRMManagedApi_basic(location),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(dataSourceName, "id"),
+					resource.TestCheckResourceAttr(dataSourceName, "name", "servicebus"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "display_name"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceAzureRMManagedApi_basic(location string) string {
+	return fmt.Sprintf(`
+data "azurerm_managed_api" "test" {
+  name     = "servicebus"
+  location = "%s"
+}
+`, location)
+}
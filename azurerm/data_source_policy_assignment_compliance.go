@@ -0,0 +1,173 @@
+package azurerm
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/services/policyinsights/mgmt/2018-04-04/policyinsights"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+func dataSourceArmPolicyAssignmentCompliance() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceArmPolicyAssignmentComplianceRead,
+
+		Schema: map[string]*schema.Schema{
+			"policy_assignment_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: azure.ValidateResourceID,
+			},
+
+			"compliant": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+
+			"non_compliant_resources": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
+			"non_compliant_policies": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
+			"policy_definition": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"policy_definition_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"policy_definition_reference_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"effect": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"non_compliant_resources": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceArmPolicyAssignmentComplianceRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).policyStatesClient
+	ctx := meta.(*ArmClient).StopContext
+
+	assignmentId := d.Get("policy_assignment_id").(string)
+	scope, err := policyAssignmentScopeFromID(assignmentId)
+	if err != nil {
+		return err
+	}
+
+	// the summary only contains the Policy Assignments which have non-compliant resources
+	filter := fmt.Sprintf("policyAssignmentId eq '%s'", strings.ToLower(assignmentId))
+	resp, err := client.SummarizeForResource(ctx, strings.TrimPrefix(scope, "/"), nil, nil, nil, filter)
+	if err != nil {
+		return fmt.Errorf("Error summarizing the Policy States for Policy Assignment %q: %+v", assignmentId, err)
+	}
+
+	d.SetId(assignmentId)
+
+	var summary *policyinsights.PolicyAssignmentSummary
+	if values := resp.Value; values != nil {
+		for _, v := range *values {
+			if v.PolicyAssignments == nil {
+				continue
+			}
+
+			for _, assignment := range *v.PolicyAssignments {
+				if assignment.PolicyAssignmentID != nil && strings.EqualFold(*assignment.PolicyAssignmentID, assignmentId) {
+					a := assignment
+					summary = &a
+				}
+			}
+		}
+	}
+
+	nonCompliantResources := 0
+	nonCompliantPolicies := 0
+	var definitions *[]policyinsights.PolicyDefinitionSummary
+	if summary != nil {
+		if results := summary.Results; results != nil {
+			if v := results.NonCompliantResources; v != nil {
+				nonCompliantResources = int(*v)
+			}
+			if v := results.NonCompliantPolicies; v != nil {
+				nonCompliantPolicies = int(*v)
+			}
+		}
+		definitions = summary.PolicyDefinitions
+	}
+
+	d.Set("compliant", nonCompliantResources == 0)
+	d.Set("non_compliant_resources", nonCompliantResources)
+	d.Set("non_compliant_policies", nonCompliantPolicies)
+
+	if err := d.Set("policy_definition", flattenPolicyAssignmentComplianceDefinitions(definitions)); err != nil {
+		return fmt.Errorf("Error setting `policy_definition`: %+v", err)
+	}
+
+	return nil
+}
+
+// policyAssignmentScopeFromID returns the scope (Management Group, Subscription, Resource Group or Resource)
+// at which the Policy Assignment is assigned
+func policyAssignmentScopeFromID(id string) (string, error) {
+	segment := "/providers/microsoft.authorization/policyassignments/"
+	index := strings.LastIndex(strings.ToLower(id), segment)
+	if index <= 0 || len(id) == index+len(segment) {
+		return "", fmt.Errorf("Unable to determine the scope of Policy Assignment %q", id)
+	}
+
+	return id[:index], nil
+}
+
+func flattenPolicyAssignmentComplianceDefinitions(input *[]policyinsights.PolicyDefinitionSummary) []interface{} {
+	results := make([]interface{}, 0)
+	if input == nil {
+		return results
+	}
+
+	for _, v := range *input {
+		output := make(map[string]interface{})
+
+		if v.PolicyDefinitionID != nil {
+			output["policy_definition_id"] = *v.PolicyDefinitionID
+		}
+
+		if v.PolicyDefinitionReferenceID != nil {
+			output["policy_definition_reference_id"] = *v.PolicyDefinitionReferenceID
+		}
+
+		if v.Effect != nil {
+			output["effect"] = *v.Effect
+		}
+
+		nonCompliantResources := 0
+		if r := v.Results; r != nil && r.NonCompliantResources != nil {
+			nonCompliantResources = int(*r.NonCompliantResources)
+		}
+		output["non_compliant_resources"] = nonCompliantResources
+
+		results = append(results, output)
+	}
+
+	return results
+}
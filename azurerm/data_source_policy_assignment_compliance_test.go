@@ -0,0 +1,96 @@
+package azurerm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestPolicyAssignmentScopeFromID(t *testing.T) {
+	cases := []struct {
+		Input    string
+		Expected string
+		Error    bool
+	}{
+		{
+			Input: "",
+			Error: true,
+		},
+		{
+			Input: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1",
+			Error: true,
+		},
+		{
+			Input: "/subscriptions/00000000-0000-0000-0000-000000000000/providers/Microsoft.Authorization/policyAssignments/",
+			Error: true,
+		},
+		{
+			Input:    "/providers/Microsoft.Management/managementGroups/group1/providers/Microsoft.Authorization/policyAssignments/assignment1",
+			Expected: "/providers/Microsoft.Management/managementGroups/group1",
+		},
+		{
+			Input:    "/subscriptions/00000000-0000-0000-0000-000000000000/providers/Microsoft.Authorization/policyAssignments/assignment1",
+			Expected: "/subscriptions/00000000-0000-0000-0000-000000000000",
+		},
+		{
+			Input:    "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Authorization/policyAssignments/assignment1",
+			Expected: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1",
+		},
+		{
+			Input:    "/subscriptions/00000000-0000-0000-0000-000000000000/resourcegroups/group1/providers/microsoft.authorization/policyassignments/assignment1",
+			Expected: "/subscriptions/00000000-0000-0000-0000-000000000000/resourcegroups/group1",
+		},
+	}
+
+	for _, tc := range cases {
+		actual, err := policyAssignmentScopeFromID(tc.Input)
+		if err != nil {
+			if tc.Error {
+				continue
+			}
+
+			t.Fatalf("Expected no error for %q but got: %+v", tc.Input, err)
+		}
+
+		if tc.Error {
+			t.Fatalf("Expected an error for %q but didn't get one", tc.Input)
+		}
+
+		if actual != tc.Expected {
+			t.Fatalf("Expected %q but got %q for %q", tc.Expected, actual, tc.Input)
+		}
+	}
+}
+
+func TestAccDataSourceAzureRMPolicyAssignmentCompliance_basic(t *testing.T) {
+	dataSourceName := "data.azurerm_policy_assignment_compliance.test"
+	ri := acctest.RandInt()
+	location := testLocation()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceAzureRMPolicyAssignmentCompliance_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(dataSourceName, "compliant"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "non_compliant_resources"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "non_compliant_policies"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceAzureRMPolicyAssignmentCompliance_basic(rInt int, location string) string {
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_policy_assignment_compliance" "test" {
+  policy_assignment_id = "${azurerm_policy_assignment.test.id}"
+}
+`, testAzureRMPolicyAssignment_basic(rInt, location))
+}
@@ -0,0 +1,142 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/resources/mgmt/2017-05-10/resources"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceArmResourceGroups() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceArmResourceGroupsRead,
+
+		Schema: map[string]*schema.Schema{
+			"name_prefix": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"location": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				StateFunc:        azureRMNormalizeLocation,
+				DiffSuppressFunc: azureRMSuppressLocationDiff,
+			},
+
+			"tags": {
+				Type:     schema.TypeMap,
+				Optional: true,
+			},
+
+			"resource_groups": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"location": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"tags": {
+							Type:     schema.TypeMap,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceArmResourceGroupsRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).resourceGroupsClient
+	ctx := meta.(*ArmClient).StopContext
+
+	namePrefix := d.Get("name_prefix").(string)
+	location := azureRMNormalizeLocation(d.Get("location").(string))
+	tags := d.Get("tags").(map[string]interface{})
+
+	log.Printf("[DEBUG] Listing Resource Groups")
+	iterator, err := client.ListComplete(ctx, "", nil)
+	if err != nil {
+		return fmt.Errorf("Error listing Resource Groups: %+v", err)
+	}
+
+	resourceGroups := make([]interface{}, 0)
+	for iterator.NotDone() {
+		group := iterator.Value()
+		if dataSourceArmResourceGroupsShouldInclude(group, namePrefix, location, tags) {
+			resourceGroups = append(resourceGroups, flattenDataSourceResourceGroup(group))
+		}
+
+		if err := iterator.Next(); err != nil {
+			return fmt.Errorf("Error listing Resource Groups: %+v", err)
+		}
+	}
+
+	d.SetId(time.Now().UTC().String())
+
+	if err := d.Set("resource_groups", resourceGroups); err != nil {
+		return fmt.Errorf("Error setting `resource_groups`: %+v", err)
+	}
+
+	return nil
+}
+
+func dataSourceArmResourceGroupsShouldInclude(group resources.Group, namePrefix string, location string, tags map[string]interface{}) bool {
+	if namePrefix != "" && (group.Name == nil || !strings.HasPrefix(*group.Name, namePrefix)) {
+		return false
+	}
+
+	if location != "" && (group.Location == nil || azureRMNormalizeLocation(*group.Location) != location) {
+		return false
+	}
+
+	// all of the specified tags must be present on the Resource Group with the same value
+	for k, v := range tags {
+		value, ok := group.Tags[k]
+		if !ok || value == nil || *value != v.(string) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func flattenDataSourceResourceGroup(input resources.Group) map[string]interface{} {
+	output := make(map[string]interface{})
+
+	if input.ID != nil {
+		output["id"] = *input.ID
+	}
+
+	if input.Name != nil {
+		output["name"] = *input.Name
+	}
+
+	if input.Location != nil {
+		output["location"] = azureRMNormalizeLocation(*input.Location)
+	}
+
+	tags := make(map[string]interface{})
+	for k, v := range input.Tags {
+		if v != nil {
+			tags[k] = *v
+		}
+	}
+	output["tags"] = tags
+
+	return output
+}
@@ -0,0 +1,84 @@
+package azurerm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccDataSourceAzureRMResourceGroups_basic(t *testing.T) {
+	prefixDataSourceName := "data.azurerm_resource_groups.prefix"
+	tagsDataSourceName := "data.azurerm_resource_groups.tags"
+	ri := acctest.RandInt()
+	location := testLocation()
+
+	resourceConfig := testAccDataSourceAzureRMResourceGroups_resources(ri, location)
+	dataSourceConfig := testAccDataSourceAzureRMResourceGroups_basic(ri, location)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMResourceGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: resourceConfig,
+				Check:  resource.ComposeTestCheckFunc(),
+			},
+			{
+				Config: dataSourceConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(prefixDataSourceName, "resource_groups.#", "2"),
+					resource.TestCheckResourceAttr(prefixDataSourceName, "resource_groups.0.location", azureRMNormalizeLocation(location)),
+					resource.TestCheckResourceAttr(tagsDataSourceName, "resource_groups.#", "1"),
+					resource.TestCheckResourceAttr(tagsDataSourceName, "resource_groups.0.name", fmt.Sprintf("acctestRG-%d-b", ri)),
+					resource.TestCheckResourceAttr(tagsDataSourceName, "resource_groups.0.tags.%", "1"),
+					resource.TestCheckResourceAttr(tagsDataSourceName, "resource_groups.0.tags.environment", "staging"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceAzureRMResourceGroups_resources(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "a" {
+  name     = "acctestRG-%d-a"
+  location = "%s"
+
+  tags {
+    environment = "production"
+  }
+}
+
+resource "azurerm_resource_group" "b" {
+  name     = "acctestRG-%d-b"
+  location = "%s"
+
+  tags {
+    environment = "staging"
+  }
+}
+`, rInt, location, rInt, location)
+}
+
+func testAccDataSourceAzureRMResourceGroups_basic(rInt int, location string) string {
+	resources := testAccDataSourceAzureRMResourceGroups_resources(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_resource_groups" "prefix" {
+  name_prefix = "acctestRG-%d-"
+  location    = "%s"
+}
+
+data "azurerm_resource_groups" "tags" {
+  name_prefix = "acctestRG-%d-"
+
+  tags {
+    environment = "staging"
+  }
+}
+`, resources, rInt, location, rInt)
+}
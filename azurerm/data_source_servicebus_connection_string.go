@@ -0,0 +1,153 @@
+package azurerm
+
+import (
+	"fmt"
+
+	"github.com/Azure/azure-sdk-for-go/services/servicebus/mgmt/2017-04-01/servicebus"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func dataSourceArmServiceBusConnectionString() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceArmServiceBusConnectionStringRead,
+
+		Schema: map[string]*schema.Schema{
+			"namespace_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: azure.ValidateServiceBusNamespaceName(),
+			},
+
+			"resource_group_name": resourceGroupNameForDataSourceSchema(),
+
+			// when either of these are specified the Authorization Rule is looked up on the Queue/Topic rather than the Namespace
+			"queue_name": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ValidateFunc:  azure.ValidateServiceBusQueueName(),
+				ConflictsWith: []string{"topic_name"},
+			},
+
+			"topic_name": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ValidateFunc:  azure.ValidateServiceBusTopicName(),
+				ConflictsWith: []string{"queue_name"},
+			},
+
+			"authorization_rule_name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      serviceBusNamespaceDefaultAuthorizationRule,
+				ValidateFunc: azure.ValidateServiceBusAuthorizationRuleName(),
+			},
+
+			"key": connectionStringKeySchema(),
+
+			"include_entity_path": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+
+			"connection_string": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+
+			"shared_access_key": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+		},
+	}
+}
+
+func dataSourceArmServiceBusConnectionStringRead(d *schema.ResourceData, meta interface{}) error {
+	namespacesClient := meta.(*ArmClient).serviceBusNamespacesClient
+	queuesClient := meta.(*ArmClient).serviceBusQueuesClient
+	topicsClient := meta.(*ArmClient).serviceBusTopicsClient
+	ctx := meta.(*ArmClient).StopContext
+
+	resourceGroup := d.Get("resource_group_name").(string)
+	namespaceName := d.Get("namespace_name").(string)
+	queueName := d.Get("queue_name").(string)
+	topicName := d.Get("topic_name").(string)
+	ruleName := d.Get("authorization_rule_name").(string)
+
+	var rule servicebus.SBAuthorizationRule
+	var keys servicebus.AccessKeys
+	var err error
+	switch {
+	case queueName != "":
+		rule, err = queuesClient.GetAuthorizationRule(ctx, resourceGroup, namespaceName, queueName, ruleName)
+		if err != nil {
+			if utils.ResponseWasNotFound(rule.Response) {
+				return fmt.Errorf("Error: Authorization Rule %q (ServiceBus Queue %q / Namespace %q / Resource Group %q) was not found", ruleName, queueName, namespaceName, resourceGroup)
+			}
+
+			return fmt.Errorf("Error retrieving Authorization Rule %q (ServiceBus Queue %q / Namespace %q / Resource Group %q): %+v", ruleName, queueName, namespaceName, resourceGroup, err)
+		}
+
+		keys, err = queuesClient.ListKeys(ctx, resourceGroup, namespaceName, queueName, ruleName)
+		if err != nil {
+			return fmt.Errorf("Error listing keys for Authorization Rule %q (ServiceBus Queue %q / Namespace %q / Resource Group %q): %+v", ruleName, queueName, namespaceName, resourceGroup, err)
+		}
+
+	case topicName != "":
+		rule, err = topicsClient.GetAuthorizationRule(ctx, resourceGroup, namespaceName, topicName, ruleName)
+		if err != nil {
+			if utils.ResponseWasNotFound(rule.Response) {
+				return fmt.Errorf("Error: Authorization Rule %q (ServiceBus Topic %q / Namespace %q / Resource Group %q) was not found", ruleName, topicName, namespaceName, resourceGroup)
+			}
+
+			return fmt.Errorf("Error retrieving Authorization Rule %q (ServiceBus Topic %q / Namespace %q / Resource Group %q): %+v", ruleName, topicName, namespaceName, resourceGroup, err)
+		}
+
+		keys, err = topicsClient.ListKeys(ctx, resourceGroup, namespaceName, topicName, ruleName)
+		if err != nil {
+			return fmt.Errorf("Error listing keys for Authorization Rule %q (ServiceBus Topic %q / Namespace %q / Resource Group %q): %+v", ruleName, topicName, namespaceName, resourceGroup, err)
+		}
+
+	default:
+		rule, err = namespacesClient.GetAuthorizationRule(ctx, resourceGroup, namespaceName, ruleName)
+		if err != nil {
+			if utils.ResponseWasNotFound(rule.Response) {
+				return fmt.Errorf("Error: Authorization Rule %q (ServiceBus Namespace %q / Resource Group %q) was not found", ruleName, namespaceName, resourceGroup)
+			}
+
+			return fmt.Errorf("Error retrieving Authorization Rule %q (ServiceBus Namespace %q / Resource Group %q): %+v", ruleName, namespaceName, resourceGroup, err)
+		}
+
+		keys, err = namespacesClient.ListKeys(ctx, resourceGroup, namespaceName, ruleName)
+		if err != nil {
+			return fmt.Errorf("Error listing keys for Authorization Rule %q (ServiceBus Namespace %q / Resource Group %q): %+v", ruleName, namespaceName, resourceGroup, err)
+		}
+	}
+
+	if rule.ID == nil {
+		return fmt.Errorf("Cannot read Authorization Rule %q (ServiceBus Namespace %q / Resource Group %q) ID", ruleName, namespaceName, resourceGroup)
+	}
+
+	d.SetId(*rule.ID)
+
+	connectionString := keys.PrimaryConnectionString
+	sharedAccessKey := keys.PrimaryKey
+	if d.Get("key").(string) == connectionStringKeySecondary {
+		connectionString = keys.SecondaryConnectionString
+		sharedAccessKey = keys.SecondaryKey
+	}
+
+	if connectionString != nil && !d.Get("include_entity_path").(bool) {
+		connectionString = utils.String(connectionStringWithoutEntityPath(*connectionString))
+	}
+
+	d.Set("connection_string", connectionString)
+	d.Set("shared_access_key", sharedAccessKey)
+
+	return nil
+}
@@ -0,0 +1,61 @@
+package azurerm
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccDataSourceAzureRMServiceBusConnectionString_basic(t *testing.T) {
+	ri := acctest.RandInt()
+	config := testAccDataSourceAzureRMServiceBusConnectionString_basic(ri, testLocation())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMServiceBusQueueAuthorizationRuleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.azurerm_servicebus_connection_string.namespace", "connection_string", "azurerm_servicebus_namespace.test", "default_primary_connection_string"),
+					resource.TestCheckResourceAttrPair("data.azurerm_servicebus_connection_string.queue", "connection_string", "azurerm_servicebus_queue_authorization_rule.test", "secondary_connection_string"),
+					resource.TestCheckResourceAttrPair("data.azurerm_servicebus_connection_string.queue", "shared_access_key", "azurerm_servicebus_queue_authorization_rule.test", "secondary_key"),
+					resource.TestMatchResourceAttr("data.azurerm_servicebus_connection_string.queue", "connection_string", regexp.MustCompile(";EntityPath=")),
+					testCheckAzureRMConnectionStringHasNoEntityPath("data.azurerm_servicebus_connection_string.noEntityPath"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceAzureRMServiceBusConnectionString_basic(rInt int, location string) string {
+	template := testAccAzureRMServiceBusQueueAuthorizationRule_base(rInt, location, true, true, false)
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_servicebus_connection_string" "namespace" {
+  namespace_name      = "${azurerm_servicebus_namespace.test.name}"
+  resource_group_name = "${azurerm_servicebus_namespace.test.resource_group_name}"
+}
+
+data "azurerm_servicebus_connection_string" "queue" {
+  namespace_name          = "${azurerm_servicebus_queue_authorization_rule.test.namespace_name}"
+  queue_name              = "${azurerm_servicebus_queue_authorization_rule.test.queue_name}"
+  authorization_rule_name = "${azurerm_servicebus_queue_authorization_rule.test.name}"
+  resource_group_name     = "${azurerm_servicebus_queue_authorization_rule.test.resource_group_name}"
+  key                     = "secondary"
+}
+
+data "azurerm_servicebus_connection_string" "noEntityPath" {
+  namespace_name          = "${azurerm_servicebus_queue_authorization_rule.test.namespace_name}"
+  queue_name              = "${azurerm_servicebus_queue_authorization_rule.test.queue_name}"
+  authorization_rule_name = "${azurerm_servicebus_queue_authorization_rule.test.name}"
+  resource_group_name     = "${azurerm_servicebus_queue_authorization_rule.test.resource_group_name}"
+  include_entity_path     = false
+}
+`, template)
+}
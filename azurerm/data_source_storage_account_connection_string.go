@@ -0,0 +1,100 @@
+package azurerm
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func dataSourceArmStorageAccountConnectionString() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceArmStorageAccountConnectionStringRead,
+
+		Schema: map[string]*schema.Schema{
+			"storage_account_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateArmStorageAccountName,
+			},
+
+			"resource_group_name": resourceGroupNameForDataSourceSchema(),
+
+			"key": connectionStringKeySchema(),
+
+			"connection_string": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+
+			"blob_connection_string": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+
+			"access_key": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+		},
+	}
+}
+
+func dataSourceArmStorageAccountConnectionStringRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).storageServiceClient
+	ctx := meta.(*ArmClient).StopContext
+	endpointSuffix := meta.(*ArmClient).environment.StorageEndpointSuffix
+
+	name := d.Get("storage_account_name").(string)
+	resourceGroup := d.Get("resource_group_name").(string)
+
+	resp, err := client.GetProperties(ctx, resourceGroup, name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			return fmt.Errorf("Error: Storage Account %q (Resource Group %q) was not found", name, resourceGroup)
+		}
+
+		return fmt.Errorf("Error retrieving Storage Account %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	keys, err := client.ListKeys(ctx, resourceGroup, name)
+	if err != nil {
+		return fmt.Errorf("Error listing keys for Storage Account %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	// the keys are returned as `key1` (primary) and `key2` (secondary)
+	keyName := "key1"
+	if d.Get("key").(string) == connectionStringKeySecondary {
+		keyName = "key2"
+	}
+
+	accessKey := ""
+	if keys.Keys != nil {
+		for _, key := range *keys.Keys {
+			if key.KeyName != nil && *key.KeyName == keyName && key.Value != nil {
+				accessKey = *key.Value
+			}
+		}
+	}
+	if accessKey == "" {
+		return fmt.Errorf("Error: Key %q was not found for Storage Account %q (Resource Group %q)", keyName, name, resourceGroup)
+	}
+
+	d.SetId(*resp.ID)
+
+	d.Set("connection_string", fmt.Sprintf("DefaultEndpointsProtocol=https;AccountName=%s;AccountKey=%s;EndpointSuffix=%s", name, accessKey, endpointSuffix))
+	d.Set("access_key", accessKey)
+
+	blobConnectionString := ""
+	if props := resp.AccountProperties; props != nil {
+		if endpoints := props.PrimaryEndpoints; endpoints != nil && endpoints.Blob != nil {
+			blobConnectionString = fmt.Sprintf("DefaultEndpointsProtocol=https;BlobEndpoint=%s;AccountName=%s;AccountKey=%s", *endpoints.Blob, name, accessKey)
+		}
+	}
+	d.Set("blob_connection_string", blobConnectionString)
+
+	return nil
+}
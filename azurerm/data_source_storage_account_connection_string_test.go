@@ -0,0 +1,51 @@
+package azurerm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccDataSourceAzureRMStorageAccountConnectionString_basic(t *testing.T) {
+	ri := acctest.RandInt()
+	rs := acctest.RandString(4)
+	config := testAccDataSourceAzureRMStorageAccountConnectionString_basic(ri, rs, testLocation())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMStorageAccountDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.azurerm_storage_account_connection_string.primary", "connection_string", "azurerm_storage_account.testsa", "primary_connection_string"),
+					resource.TestCheckResourceAttrPair("data.azurerm_storage_account_connection_string.primary", "blob_connection_string", "azurerm_storage_account.testsa", "primary_blob_connection_string"),
+					resource.TestCheckResourceAttrPair("data.azurerm_storage_account_connection_string.primary", "access_key", "azurerm_storage_account.testsa", "primary_access_key"),
+					resource.TestCheckResourceAttrPair("data.azurerm_storage_account_connection_string.secondary", "connection_string", "azurerm_storage_account.testsa", "secondary_connection_string"),
+					resource.TestCheckResourceAttrPair("data.azurerm_storage_account_connection_string.secondary", "access_key", "azurerm_storage_account.testsa", "secondary_access_key"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceAzureRMStorageAccountConnectionString_basic(rInt int, rString string, location string) string {
+	template := testAccAzureRMStorageAccount_basic(rInt, rString, location)
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_storage_account_connection_string" "primary" {
+  storage_account_name = "${azurerm_storage_account.testsa.name}"
+  resource_group_name  = "${azurerm_storage_account.testsa.resource_group_name}"
+}
+
+data "azurerm_storage_account_connection_string" "secondary" {
+  storage_account_name = "${azurerm_storage_account.testsa.name}"
+  resource_group_name  = "${azurerm_storage_account.testsa.resource_group_name}"
+  key                  = "secondary"
+}
+`, template)
+}
@@ -0,0 +1,129 @@
+package azurerm
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func dataSourceArmTrafficManagerEndpoint() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceArmTrafficManagerEndpointRead,
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+
+			"profile_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+
+			"resource_group_name": resourceGroupNameForDataSourceSchema(),
+
+			"type": {
+				Type:     schema.TypeString,
+				Required: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"azureEndpoints",
+					"nestedEndpoints",
+					"externalEndpoints",
+				}, false),
+			},
+
+			"target": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"target_resource_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"endpoint_status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"endpoint_monitor_status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"weight": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
+			"priority": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
+			"endpoint_location": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"min_child_endpoints": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
+			"geo_mappings": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func dataSourceArmTrafficManagerEndpointRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).trafficManagerEndpointsClient
+	ctx := meta.(*ArmClient).StopContext
+
+	name := d.Get("name").(string)
+	profileName := d.Get("profile_name").(string)
+	endpointType := d.Get("type").(string)
+	resourceGroup := d.Get("resource_group_name").(string)
+
+	resp, err := client.Get(ctx, resourceGroup, profileName, endpointType, name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			return fmt.Errorf("Error: Traffic Manager Endpoint %q (Profile %q / Resource Group %q) was not found", name, profileName, resourceGroup)
+		}
+		return fmt.Errorf("Error making Read request on Traffic Manager Endpoint %q (Profile %q / Resource Group %q): %+v", name, profileName, resourceGroup, err)
+	}
+
+	d.SetId(*resp.ID)
+
+	d.Set("name", resp.Name)
+	d.Set("profile_name", profileName)
+	d.Set("resource_group_name", resourceGroup)
+	d.Set("type", endpointType)
+
+	if props := resp.EndpointProperties; props != nil {
+		d.Set("target", props.Target)
+		d.Set("target_resource_id", props.TargetResourceID)
+		d.Set("endpoint_status", string(props.EndpointStatus))
+		d.Set("endpoint_monitor_status", string(props.EndpointMonitorStatus))
+		d.Set("weight", props.Weight)
+		d.Set("priority", props.Priority)
+		d.Set("endpoint_location", props.EndpointLocation)
+		d.Set("min_child_endpoints", props.MinChildEndpoints)
+
+		if err := d.Set("geo_mappings", utils.FlattenStringArray(props.GeoMapping)); err != nil {
+			return fmt.Errorf("Error setting `geo_mappings`: %+v", err)
+		}
+	}
+
+	return nil
+}
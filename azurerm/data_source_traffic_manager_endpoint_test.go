@@ -0,0 +1,47 @@
+package azurerm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccDataSourceAzureRMTrafficManagerEndpoint_basic(t *testing.T) {
+	dataSourceName := "data.azurerm_traffic_manager_endpoint.test"
+	ri := acctest.RandInt()
+	location := testLocation()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMTrafficManagerEndpointDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceAzureRMTrafficManagerEndpoint_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(dataSourceName, "id"),
+					resource.TestCheckResourceAttr(dataSourceName, "target", "terraform.io"),
+					resource.TestCheckResourceAttr(dataSourceName, "weight", "3"),
+					resource.TestCheckResourceAttr(dataSourceName, "endpoint_status", "Enabled"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "endpoint_monitor_status"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceAzureRMTrafficManagerEndpoint_basic(rInt int, location string) string {
+	template := testAccAzureRMTrafficManagerEndpoint_basic(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_traffic_manager_endpoint" "test" {
+  name                = "${azurerm_traffic_manager_endpoint.testExternal.name}"
+  profile_name        = "${azurerm_traffic_manager_profile.test.name}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  type                = "externalEndpoints"
+}
+`, template)
+}
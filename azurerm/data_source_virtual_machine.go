@@ -0,0 +1,286 @@
+package azurerm
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2018-06-01/compute"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func dataSourceArmVirtualMachine() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceArmVirtualMachineRead,
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"resource_group_name": resourceGroupNameForDataSourceSchema(),
+
+			"location": locationForDataSourceSchema(),
+
+			"zones": zonesSchemaComputed(),
+
+			"availability_set_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"vm_size": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"license_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"identity": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"principal_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"identity_ids": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+					},
+				},
+			},
+
+			"network_interface_ids": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+
+			"private_ip_address": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"private_ip_addresses": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+
+			"public_ip_address": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"public_ip_addresses": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+
+			"power_state": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"tags": tagsForDataSourceSchema(),
+		},
+	}
+}
+
+func dataSourceArmVirtualMachineRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).vmClient
+	ctx := meta.(*ArmClient).StopContext
+
+	name := d.Get("name").(string)
+	resourceGroup := d.Get("resource_group_name").(string)
+
+	resp, err := client.Get(ctx, resourceGroup, name, compute.InstanceView)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			return fmt.Errorf("Error: Virtual Machine %q (Resource Group %q) was not found", name, resourceGroup)
+		}
+
+		return fmt.Errorf("Error making Read request on Virtual Machine %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	d.SetId(*resp.ID)
+
+	d.Set("name", resp.Name)
+	d.Set("resource_group_name", resourceGroup)
+	if location := resp.Location; location != nil {
+		d.Set("location", azureRMNormalizeLocation(*location))
+	}
+	d.Set("zones", resp.Zones)
+
+	if err := d.Set("identity", flattenAzureRmVirtualMachineIdentity(resp.Identity)); err != nil {
+		return fmt.Errorf("Error setting `identity`: %+v", err)
+	}
+
+	networkInterfaceIds := make([]string, 0)
+	if props := resp.VirtualMachineProperties; props != nil {
+		if availabilitySet := props.AvailabilitySet; availabilitySet != nil {
+			d.Set("availability_set_id", availabilitySet.ID)
+		}
+
+		if profile := props.HardwareProfile; profile != nil {
+			d.Set("vm_size", string(profile.VMSize))
+		}
+
+		d.Set("license_type", props.LicenseType)
+
+		if profile := props.NetworkProfile; profile != nil && profile.NetworkInterfaces != nil {
+			for _, nic := range *profile.NetworkInterfaces {
+				if nic.ID != nil {
+					networkInterfaceIds = append(networkInterfaceIds, *nic.ID)
+				}
+			}
+		}
+
+		if instanceView := props.InstanceView; instanceView != nil {
+			d.Set("power_state", flattenAzureRmVirtualMachinePowerState(instanceView.Statuses))
+		}
+	}
+
+	if err := d.Set("network_interface_ids", networkInterfaceIds); err != nil {
+		return fmt.Errorf("Error setting `network_interface_ids`: %+v", err)
+	}
+
+	privateIPAddresses, publicIPAddresses, err := retrieveVirtualMachineIPAddresses(ctx, meta.(*ArmClient), networkInterfaceIds)
+	if err != nil {
+		return fmt.Errorf("Error retrieving IP Addresses for Virtual Machine %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	privateIPAddress := ""
+	if len(privateIPAddresses) > 0 {
+		privateIPAddress = privateIPAddresses[0]
+	}
+	d.Set("private_ip_address", privateIPAddress)
+	if err := d.Set("private_ip_addresses", privateIPAddresses); err != nil {
+		return fmt.Errorf("Error setting `private_ip_addresses`: %+v", err)
+	}
+
+	publicIPAddress := ""
+	if len(publicIPAddresses) > 0 {
+		publicIPAddress = publicIPAddresses[0]
+	}
+	d.Set("public_ip_address", publicIPAddress)
+	if err := d.Set("public_ip_addresses", publicIPAddresses); err != nil {
+		return fmt.Errorf("Error setting `public_ip_addresses`: %+v", err)
+	}
+
+	flattenAndSetTags(d, resp.Tags)
+
+	return nil
+}
+
+// retrieveVirtualMachineIPAddresses looks up the Private & Public IP Addresses assigned to the
+// specified Network Interfaces, with the Primary IP Configuration of each Network Interface first
+func retrieveVirtualMachineIPAddresses(ctx context.Context, client *ArmClient, networkInterfaceIds []string) ([]string, []string, error) {
+	privateIPAddresses := make([]string, 0)
+	publicIPAddresses := make([]string, 0)
+
+	for _, networkInterfaceId := range networkInterfaceIds {
+		id, err := parseAzureResourceID(networkInterfaceId)
+		if err != nil {
+			return nil, nil, err
+		}
+		nicName := id.Path["networkInterfaces"]
+
+		nic, err := client.ifaceClient.Get(ctx, id.ResourceGroup, nicName, "")
+		if err != nil {
+			return nil, nil, fmt.Errorf("Error retrieving Network Interface %q (Resource Group %q): %+v", nicName, id.ResourceGroup, err)
+		}
+
+		props := nic.InterfacePropertiesFormat
+		if props == nil || props.IPConfigurations == nil {
+			continue
+		}
+
+		for _, config := range *props.IPConfigurations {
+			configProps := config.InterfaceIPConfigurationPropertiesFormat
+			if configProps == nil {
+				continue
+			}
+
+			primary := configProps.Primary != nil && *configProps.Primary
+
+			if v := configProps.PrivateIPAddress; v != nil && *v != "" {
+				if primary {
+					privateIPAddresses = append([]string{*v}, privateIPAddresses...)
+				} else {
+					privateIPAddresses = append(privateIPAddresses, *v)
+				}
+			}
+
+			if configProps.PublicIPAddress == nil || configProps.PublicIPAddress.ID == nil {
+				continue
+			}
+
+			publicIPId, err := parseAzureResourceID(*configProps.PublicIPAddress.ID)
+			if err != nil {
+				return nil, nil, err
+			}
+			publicIPName := publicIPId.Path["publicIPAddresses"]
+
+			publicIP, err := client.publicIPClient.Get(ctx, publicIPId.ResourceGroup, publicIPName, "")
+			if err != nil {
+				return nil, nil, fmt.Errorf("Error retrieving Public IP Address %q (Resource Group %q): %+v", publicIPName, publicIPId.ResourceGroup, err)
+			}
+
+			if publicIPProps := publicIP.PublicIPAddressPropertiesFormat; publicIPProps != nil {
+				if v := publicIPProps.IPAddress; v != nil && *v != "" {
+					if primary {
+						publicIPAddresses = append([]string{*v}, publicIPAddresses...)
+					} else {
+						publicIPAddresses = append(publicIPAddresses, *v)
+					}
+				}
+			}
+		}
+	}
+
+	return privateIPAddresses, publicIPAddresses, nil
+}
+
+// flattenAzureRmVirtualMachinePowerState returns the Power State from the Instance View statuses
+// e.g. `PowerState/running` is returned as `running`
+func flattenAzureRmVirtualMachinePowerState(statuses *[]compute.InstanceViewStatus) string {
+	if statuses == nil {
+		return ""
+	}
+
+	for _, status := range *statuses {
+		if status.Code == nil {
+			continue
+		}
+
+		code := *status.Code
+		if strings.HasPrefix(strings.ToLower(code), "powerstate/") {
+			return code[len("PowerState/"):]
+		}
+	}
+
+	return ""
+}
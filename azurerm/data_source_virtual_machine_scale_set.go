@@ -0,0 +1,274 @@
+package azurerm
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2018-06-01/compute"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func dataSourceArmVirtualMachineScaleSet() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceArmVirtualMachineScaleSetRead,
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"resource_group_name": resourceGroupNameForDataSourceSchema(),
+
+			"location": locationForDataSourceSchema(),
+
+			"zones": zonesSchemaComputed(),
+
+			"sku": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"tier": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"capacity": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+					},
+				},
+			},
+
+			"upgrade_policy_mode": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"identity": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"principal_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"identity_ids": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+					},
+				},
+			},
+
+			"instances": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"instance_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"computer_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"zone": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"latest_model_applied": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"power_state": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"private_ip_address": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"public_ip_address": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+
+			"tags": tagsForDataSourceSchema(),
+		},
+	}
+}
+
+func dataSourceArmVirtualMachineScaleSetRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).vmScaleSetClient
+	vmsClient := meta.(*ArmClient).vmScaleSetVMsClient
+	ctx := meta.(*ArmClient).StopContext
+
+	name := d.Get("name").(string)
+	resourceGroup := d.Get("resource_group_name").(string)
+
+	resp, err := client.Get(ctx, resourceGroup, name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			return fmt.Errorf("Error: Virtual Machine Scale Set %q (Resource Group %q) was not found", name, resourceGroup)
+		}
+
+		return fmt.Errorf("Error making Read request on Virtual Machine Scale Set %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	d.SetId(*resp.ID)
+
+	d.Set("name", resp.Name)
+	d.Set("resource_group_name", resourceGroup)
+	if location := resp.Location; location != nil {
+		d.Set("location", azureRMNormalizeLocation(*location))
+	}
+	d.Set("zones", resp.Zones)
+
+	sku := make([]interface{}, 0)
+	if resp.Sku != nil {
+		sku = flattenAzureRmVirtualMachineScaleSetSku(resp.Sku)
+	}
+	if err := d.Set("sku", sku); err != nil {
+		return fmt.Errorf("Error setting `sku`: %+v", err)
+	}
+
+	if err := d.Set("identity", flattenAzureRmVirtualMachineScaleSetIdentity(resp.Identity)); err != nil {
+		return fmt.Errorf("Error setting `identity`: %+v", err)
+	}
+
+	if props := resp.VirtualMachineScaleSetProperties; props != nil {
+		if upgradePolicy := props.UpgradePolicy; upgradePolicy != nil {
+			d.Set("upgrade_policy_mode", string(upgradePolicy.Mode))
+		}
+	}
+
+	instances := make([]interface{}, 0)
+	iterator, err := vmsClient.ListComplete(ctx, resourceGroup, name, "", "", "instanceView")
+	if err != nil {
+		return fmt.Errorf("Error listing instances for Virtual Machine Scale Set %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+	for iterator.NotDone() {
+		instance, err := flattenAzureRmVirtualMachineScaleSetInstance(ctx, meta.(*ArmClient), resourceGroup, name, iterator.Value())
+		if err != nil {
+			return fmt.Errorf("Error flattening instance of Virtual Machine Scale Set %q (Resource Group %q): %+v", name, resourceGroup, err)
+		}
+		instances = append(instances, instance)
+
+		if err := iterator.Next(); err != nil {
+			return fmt.Errorf("Error listing instances for Virtual Machine Scale Set %q (Resource Group %q): %+v", name, resourceGroup, err)
+		}
+	}
+	if err := d.Set("instances", instances); err != nil {
+		return fmt.Errorf("Error setting `instances`: %+v", err)
+	}
+
+	flattenAndSetTags(d, resp.Tags)
+
+	return nil
+}
+
+func flattenAzureRmVirtualMachineScaleSetInstance(ctx context.Context, client *ArmClient, resourceGroup string, scaleSetName string, input compute.VirtualMachineScaleSetVM) (map[string]interface{}, error) {
+	output := make(map[string]interface{})
+
+	instanceId := ""
+	if input.InstanceID != nil {
+		instanceId = *input.InstanceID
+	}
+	output["instance_id"] = instanceId
+
+	if input.Name != nil {
+		output["name"] = *input.Name
+	}
+
+	zone := ""
+	if zones := input.Zones; zones != nil && len(*zones) > 0 {
+		zone = (*zones)[0]
+	}
+	output["zone"] = zone
+
+	if props := input.VirtualMachineScaleSetVMProperties; props != nil {
+		if props.LatestModelApplied != nil {
+			output["latest_model_applied"] = *props.LatestModelApplied
+		}
+
+		if profile := props.OsProfile; profile != nil && profile.ComputerName != nil {
+			output["computer_name"] = *profile.ComputerName
+		}
+
+		if instanceView := props.InstanceView; instanceView != nil {
+			output["power_state"] = flattenAzureRmVirtualMachinePowerState(instanceView.Statuses)
+		}
+	}
+
+	privateIPAddress := ""
+	publicIPAddress := ""
+
+	nics, err := client.ifaceClient.ListVirtualMachineScaleSetVMNetworkInterfacesComplete(ctx, resourceGroup, scaleSetName, instanceId)
+	if err != nil {
+		return nil, fmt.Errorf("Error listing Network Interfaces for instance %q: %+v", instanceId, err)
+	}
+	for nics.NotDone() {
+		nic := nics.Value()
+
+		if props := nic.InterfacePropertiesFormat; props != nil && props.Primary != nil && *props.Primary && props.IPConfigurations != nil {
+			for _, config := range *props.IPConfigurations {
+				configProps := config.InterfaceIPConfigurationPropertiesFormat
+				if configProps == nil || configProps.Primary == nil || !*configProps.Primary {
+					continue
+				}
+
+				if configProps.PrivateIPAddress != nil {
+					privateIPAddress = *configProps.PrivateIPAddress
+				}
+
+				if configProps.PublicIPAddress != nil && nic.Name != nil && config.Name != nil {
+					publicIPs, err := client.publicIPClient.ListVirtualMachineScaleSetVMPublicIPAddressesComplete(ctx, resourceGroup, scaleSetName, instanceId, *nic.Name, *config.Name)
+					if err != nil {
+						return nil, fmt.Errorf("Error listing Public IP Addresses for instance %q: %+v", instanceId, err)
+					}
+					for publicIPs.NotDone() {
+						if publicIPProps := publicIPs.Value().PublicIPAddressPropertiesFormat; publicIPProps != nil && publicIPProps.IPAddress != nil && publicIPAddress == "" {
+							publicIPAddress = *publicIPProps.IPAddress
+						}
+
+						if err := publicIPs.Next(); err != nil {
+							return nil, fmt.Errorf("Error listing Public IP Addresses for instance %q: %+v", instanceId, err)
+						}
+					}
+				}
+			}
+		}
+
+		if err := nics.Next(); err != nil {
+			return nil, fmt.Errorf("Error listing Network Interfaces for instance %q: %+v", instanceId, err)
+		}
+	}
+
+	output["private_ip_address"] = privateIPAddress
+	output["public_ip_address"] = publicIPAddress
+
+	return output, nil
+}
@@ -0,0 +1,48 @@
+package azurerm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccDataSourceAzureRMVirtualMachineScaleSet_basic(t *testing.T) {
+	dataSourceName := "data.azurerm_virtual_machine_scale_set.test"
+	ri := acctest.RandInt()
+	config := testAccDataSourceAzureRMVirtualMachineScaleSet_basic(ri, testLocation())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMVirtualMachineScaleSetDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(dataSourceName, "location"),
+					resource.TestCheckResourceAttr(dataSourceName, "upgrade_policy_mode", "Manual"),
+					resource.TestCheckResourceAttr(dataSourceName, "sku.0.name", "Standard_D1_v2"),
+					resource.TestCheckResourceAttr(dataSourceName, "sku.0.capacity", "2"),
+					resource.TestCheckResourceAttr(dataSourceName, "instances.#", "2"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "instances.0.instance_id"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "instances.0.private_ip_address"),
+					resource.TestCheckResourceAttr(dataSourceName, "instances.0.power_state", "running"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceAzureRMVirtualMachineScaleSet_basic(rInt int, location string) string {
+	resource := testAccAzureRMVirtualMachineScaleSet_basicLinux_managedDisk(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_virtual_machine_scale_set" "test" {
+  name                = "${azurerm_virtual_machine_scale_set.test.name}"
+  resource_group_name = "${azurerm_virtual_machine_scale_set.test.resource_group_name}"
+}
+`, resource)
+}
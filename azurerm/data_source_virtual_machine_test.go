@@ -0,0 +1,47 @@
+package azurerm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccDataSourceAzureRMVirtualMachine_basic(t *testing.T) {
+	dataSourceName := "data.azurerm_virtual_machine.test"
+	ri := acctest.RandInt()
+	config := testAccDataSourceAzureRMVirtualMachine_basic(ri, testLocation())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMVirtualMachineDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(dataSourceName, "location"),
+					resource.TestCheckResourceAttr(dataSourceName, "vm_size", "Standard_D1_v2"),
+					resource.TestCheckResourceAttr(dataSourceName, "network_interface_ids.#", "1"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "private_ip_address"),
+					resource.TestCheckResourceAttr(dataSourceName, "private_ip_addresses.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "power_state", "running"),
+					resource.TestCheckResourceAttr(dataSourceName, "tags.%", "2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceAzureRMVirtualMachine_basic(rInt int, location string) string {
+	resource := testAccAzureRMVirtualMachine_basicLinuxMachine_managedDisk_explicit(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_virtual_machine" "test" {
+  name                = "${azurerm_virtual_machine.test.name}"
+  resource_group_name = "${azurerm_virtual_machine.test.resource_group_name}"
+}
+`, resource)
+}
@@ -5,7 +5,7 @@ import (
 
 	"bytes"
 
-	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2018-04-01/network"
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2018-08-01/network"
 	"github.com/hashicorp/terraform/helper/hashcode"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/helper/validation"
@@ -137,8 +137,9 @@ func dataSourceArmVirtualNetworkGateway() *schema.Resource {
 						},
 
 						"radius_server_secret": {
-							Type:     schema.TypeString,
-							Computed: true,
+							Type:      schema.TypeString,
+							Computed:  true,
+							Sensitive: true,
 						},
 
 						"vpn_client_protocols": {
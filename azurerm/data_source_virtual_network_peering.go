@@ -0,0 +1,96 @@
+package azurerm
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func dataSourceArmVirtualNetworkPeering() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceArmVirtualNetworkPeeringRead,
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+
+			"virtual_network_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+
+			"resource_group_name": resourceGroupNameForDataSourceSchema(),
+
+			"remote_virtual_network_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"allow_virtual_network_access": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+
+			"allow_forwarded_traffic": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+
+			"allow_gateway_transit": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+
+			"use_remote_gateways": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+
+			"peering_state": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceArmVirtualNetworkPeeringRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).vnetPeeringsClient
+	ctx := meta.(*ArmClient).StopContext
+
+	name := d.Get("name").(string)
+	virtualNetworkName := d.Get("virtual_network_name").(string)
+	resourceGroup := d.Get("resource_group_name").(string)
+
+	resp, err := client.Get(ctx, resourceGroup, virtualNetworkName, name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			return fmt.Errorf("Error: Virtual Network Peering %q (Virtual Network %q / Resource Group %q) was not found", name, virtualNetworkName, resourceGroup)
+		}
+		return fmt.Errorf("Error making Read request on Virtual Network Peering %q (Virtual Network %q / Resource Group %q): %+v", name, virtualNetworkName, resourceGroup, err)
+	}
+	d.SetId(*resp.ID)
+
+	d.Set("name", name)
+	d.Set("resource_group_name", resourceGroup)
+	d.Set("virtual_network_name", virtualNetworkName)
+
+	if props := resp.VirtualNetworkPeeringPropertiesFormat; props != nil {
+		d.Set("allow_virtual_network_access", props.AllowVirtualNetworkAccess)
+		d.Set("allow_forwarded_traffic", props.AllowForwardedTraffic)
+		d.Set("allow_gateway_transit", props.AllowGatewayTransit)
+		d.Set("use_remote_gateways", props.UseRemoteGateways)
+		d.Set("peering_state", string(props.PeeringState))
+
+		if remote := props.RemoteVirtualNetwork; remote != nil {
+			d.Set("remote_virtual_network_id", remote.ID)
+		}
+	}
+
+	return nil
+}
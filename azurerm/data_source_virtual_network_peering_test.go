@@ -0,0 +1,43 @@
+package azurerm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccDataSourceAzureRMVirtualNetworkPeering_basic(t *testing.T) {
+	dataSourceName := "data.azurerm_virtual_network_peering.test"
+	ri := acctest.RandInt()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceAzureRMVirtualNetworkPeering_basic(ri, testLocation()),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(dataSourceName, "remote_virtual_network_id"),
+					resource.TestCheckResourceAttr(dataSourceName, "allow_virtual_network_access", "true"),
+					resource.TestCheckResourceAttr(dataSourceName, "allow_forwarded_traffic", "false"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "peering_state"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceAzureRMVirtualNetworkPeering_basic(rInt int, location string) string {
+	template := testAccAzureRMVirtualNetworkPeering_basic(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_virtual_network_peering" "test" {
+  name                 = "${azurerm_virtual_network_peering.test1.name}"
+  virtual_network_name = "${azurerm_virtual_network_peering.test1.virtual_network_name}"
+  resource_group_name  = "${azurerm_virtual_network_peering.test1.resource_group_name}"
+}
+`, template)
+}
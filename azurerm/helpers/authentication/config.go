@@ -23,6 +23,7 @@ type Config struct {
 	Environment               string
 	SkipCredentialsValidation bool
 	SkipProviderRegistration  bool
+	StorageUseAzureAD         bool
 
 	// Service Principal Auth
 	ClientSecret string
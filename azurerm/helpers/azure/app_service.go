@@ -9,6 +9,7 @@ import (
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/helper/validation"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/suppress"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
@@ -26,6 +27,18 @@ func SchemaAppServiceSiteConfig() *schema.Schema {
 					Default:  false,
 				},
 
+				"app_command_line": {
+					Type:     schema.TypeString,
+					Optional: true,
+				},
+
+				"auto_swap_slot_name": {
+					Type:     schema.TypeString,
+					Optional: true,
+				},
+
+				"cors": SchemaAppServiceCorsSettings(),
+
 				"default_documents": {
 					Type:     schema.TypeList,
 					Optional: true,
@@ -64,17 +77,35 @@ func SchemaAppServiceSiteConfig() *schema.Schema {
 								Optional: true,
 								Default:  "255.255.255.255",
 							},
+							"name": {
+								Type:         schema.TypeString,
+								Optional:     true,
+								Computed:     true,
+								ValidateFunc: validation.NoZeroValues,
+							},
+							"priority": {
+								Type:         schema.TypeInt,
+								Optional:     true,
+								Default:      65000,
+								ValidateFunc: validation.IntBetween(1, 2147483647),
+							},
+							"action": {
+								Type:     schema.TypeString,
+								Optional: true,
+								Default:  "Allow",
+								ValidateFunc: validation.StringInSlice([]string{
+									"Allow",
+									"Deny",
+								}, false),
+							},
 						},
 					},
 				},
 
 				"java_version": {
-					Type:     schema.TypeString,
-					Optional: true,
-					ValidateFunc: validation.StringInSlice([]string{
-						"1.7",
-						"1.8",
-					}, false),
+					Type:         schema.TypeString,
+					Optional:     true,
+					ValidateFunc: validate.AppServiceRuntimeVersion(),
 				},
 
 				"java_container": {
@@ -109,24 +140,23 @@ func SchemaAppServiceSiteConfig() *schema.Schema {
 					DiffSuppressFunc: suppress.CaseDifference,
 				},
 
+				"number_of_workers": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					Computed:     true,
+					ValidateFunc: validation.IntAtLeast(1),
+				},
+
 				"php_version": {
-					Type:     schema.TypeString,
-					Optional: true,
-					ValidateFunc: validation.StringInSlice([]string{
-						"5.5",
-						"5.6",
-						"7.0",
-						"7.1",
-					}, false),
+					Type:         schema.TypeString,
+					Optional:     true,
+					ValidateFunc: validate.AppServiceRuntimeVersion(),
 				},
 
 				"python_version": {
-					Type:     schema.TypeString,
-					Optional: true,
-					ValidateFunc: validation.StringInSlice([]string{
-						"2.7",
-						"3.4",
-					}, false),
+					Type:         schema.TypeString,
+					Optional:     true,
+					ValidateFunc: validate.AppServiceRuntimeVersion(),
 				},
 
 				"remote_debugging_enabled": {
@@ -206,6 +236,76 @@ func SchemaAppServiceSiteConfig() *schema.Schema {
 	}
 }
 
+func SchemaAppServiceCorsSettings() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		Computed: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"allowed_origins": {
+					Type:     schema.TypeSet,
+					Required: true,
+					Elem: &schema.Schema{
+						Type:         schema.TypeString,
+						ValidateFunc: validation.NoZeroValues,
+					},
+					Set: schema.HashString,
+				},
+			},
+		},
+	}
+}
+
+func ExpandAppServiceCorsSettings(input interface{}) web.CorsSettings {
+	settings := input.([]interface{})
+	corsSettings := web.CorsSettings{}
+
+	if len(settings) == 0 || settings[0] == nil {
+		return corsSettings
+	}
+
+	setting := settings[0].(map[string]interface{})
+
+	if v, ok := setting["allowed_origins"]; ok {
+		input := v.(*schema.Set).List()
+
+		allowedOrigins := make([]string, 0)
+		for _, param := range input {
+			allowedOrigins = append(allowedOrigins, param.(string))
+		}
+
+		corsSettings.AllowedOrigins = &allowedOrigins
+	}
+
+	return corsSettings
+}
+
+func FlattenAppServiceCorsSettings(input *web.CorsSettings) []interface{} {
+	results := make([]interface{}, 0)
+	if input == nil {
+		return results
+	}
+
+	allowedOrigins := make([]interface{}, 0)
+	if s := input.AllowedOrigins; s != nil {
+		for _, v := range *s {
+			allowedOrigins = append(allowedOrigins, v)
+		}
+	}
+
+	// the API returns an empty CORS object when no origins have been configured
+	if len(allowedOrigins) == 0 {
+		return results
+	}
+
+	result := make(map[string]interface{})
+	result["allowed_origins"] = schema.NewSet(schema.HashString, allowedOrigins)
+
+	return append(results, result)
+}
+
 func ExpandAppServiceSiteConfig(input interface{}) web.SiteConfig {
 	configs := input.([]interface{})
 	siteConfig := web.SiteConfig{}
@@ -220,6 +320,22 @@ func ExpandAppServiceSiteConfig(input interface{}) web.SiteConfig {
 		siteConfig.AlwaysOn = utils.Bool(v.(bool))
 	}
 
+	if v, ok := config["app_command_line"]; ok {
+		siteConfig.AppCommandLine = utils.String(v.(string))
+	}
+
+	if v, ok := config["auto_swap_slot_name"]; ok {
+		siteConfig.AutoSwapSlotName = utils.String(v.(string))
+	}
+
+	if v, ok := config["cors"]; ok {
+		corsSettings := v.([]interface{})
+		if len(corsSettings) > 0 {
+			expand := ExpandAppServiceCorsSettings(corsSettings)
+			siteConfig.Cors = &expand
+		}
+	}
+
 	if v, ok := config["default_documents"]; ok {
 		input := v.([]interface{})
 
@@ -274,10 +390,17 @@ func ExpandAppServiceSiteConfig(input interface{}) web.SiteConfig {
 				cidrAddress += "/32"
 			}
 
-			restrictions = append(restrictions, web.IPSecurityRestriction{
+			ipSecurityRestriction := web.IPSecurityRestriction{
 				IPAddress:  &cidrAddress,
 				SubnetMask: &restrictionMask,
-			})
+				Priority:   utils.Int32(int32(restriction["priority"].(int))),
+				Action:     utils.String(restriction["action"].(string)),
+			}
+			if name := restriction["name"].(string); name != "" {
+				ipSecurityRestriction.Name = utils.String(name)
+			}
+
+			restrictions = append(restrictions, ipSecurityRestriction)
 		}
 		siteConfig.IPSecurityRestrictions = &restrictions
 	}
@@ -290,6 +413,10 @@ func ExpandAppServiceSiteConfig(input interface{}) web.SiteConfig {
 		siteConfig.ManagedPipelineMode = web.ManagedPipelineMode(v.(string))
 	}
 
+	if v, ok := config["number_of_workers"]; ok && v.(int) > 0 {
+		siteConfig.NumberOfWorkers = utils.Int32(int32(v.(int)))
+	}
+
 	if v, ok := config["php_version"]; ok {
 		siteConfig.PhpVersion = utils.String(v.(string))
 	}
@@ -346,6 +473,16 @@ func FlattenAppServiceSiteConfig(input *web.SiteConfig) []interface{} {
 		result["always_on"] = *input.AlwaysOn
 	}
 
+	if input.AppCommandLine != nil {
+		result["app_command_line"] = *input.AppCommandLine
+	}
+
+	if input.AutoSwapSlotName != nil {
+		result["auto_swap_slot_name"] = *input.AutoSwapSlotName
+	}
+
+	result["cors"] = FlattenAppServiceCorsSettings(input.Cors)
+
 	documents := make([]string, 0)
 	if input.DefaultDocuments != nil {
 		for _, document := range *input.DefaultDocuments {
@@ -396,6 +533,23 @@ func FlattenAppServiceSiteConfig(input *web.SiteConfig) []interface{} {
 			if subnet := v.SubnetMask; subnet != nil {
 				result["subnet_mask"] = *subnet
 			}
+			if name := v.Name; name != nil {
+				result["name"] = *name
+			}
+
+			// these fields are optional in the API, so fall back to the defaults used when they're omitted
+			priority := 65000
+			if v.Priority != nil {
+				priority = int(*v.Priority)
+			}
+			result["priority"] = priority
+
+			action := "Allow"
+			if v.Action != nil && *v.Action != "" {
+				action = *v.Action
+			}
+			result["action"] = action
+
 			restrictions = append(restrictions, result)
 		}
 	}
@@ -403,6 +557,10 @@ func FlattenAppServiceSiteConfig(input *web.SiteConfig) []interface{} {
 
 	result["managed_pipeline_mode"] = string(input.ManagedPipelineMode)
 
+	if input.NumberOfWorkers != nil {
+		result["number_of_workers"] = int(*input.NumberOfWorkers)
+	}
+
 	if input.PhpVersion != nil {
 		result["php_version"] = *input.PhpVersion
 	}
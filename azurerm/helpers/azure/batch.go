@@ -0,0 +1,15 @@
+package azure
+
+import (
+	"regexp"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func ValidateBatchAccountName() schema.SchemaValidateFunc {
+	return validation.StringMatch(
+		regexp.MustCompile(`\A([a-z0-9]{3,24})\z`),
+		"Name can only consist of lowercase letters and numbers and must be between 3 and 24 characters long",
+	)
+}
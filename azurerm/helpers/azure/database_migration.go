@@ -0,0 +1,22 @@
+package azure
+
+import (
+	"regexp"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func ValidateDatabaseMigrationServiceName() schema.SchemaValidateFunc {
+	return validation.StringMatch(
+		regexp.MustCompile(`^[a-zA-Z0-9][a-zA-Z0-9_.-]{0,61}[a-zA-Z0-9_]$`),
+		"Name must be between 2 and 63 characters long, start with a letter or number, end with a letter, number or underscore and can only contain letters, numbers, underscores, periods and hyphens",
+	)
+}
+
+func ValidateDatabaseMigrationProjectName() schema.SchemaValidateFunc {
+	return validation.StringMatch(
+		regexp.MustCompile(`^[a-zA-Z0-9][a-zA-Z0-9_.-]{1,56}[a-zA-Z0-9_]$`),
+		"Name must be between 3 and 58 characters long, start with a letter or number, end with a letter, number or underscore and can only contain letters, numbers, underscores, periods and hyphens",
+	)
+}
@@ -3,12 +3,12 @@ package azure
 import (
 	"fmt"
 
-	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2018-04-01/network"
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2018-08-01/network"
 )
 
-// The API requires InternalPublicIPAddress to be set when for a CreateOrUpdate
-// operation, but Get operations return the property as PublicIPAddress
-// so we need to go through and copy the value to the correct property.
+// The API rejects the read-only properties returned from a Get operation
+// (e.g. the Private IP Address) when they're sent back in a CreateOrUpdate
+// operation, so we need to go through and copy only the settable properties.
 func FirewallFixIPConfiguration(input *[]network.AzureFirewallIPConfiguration) (*[]network.AzureFirewallIPConfiguration, error) {
 	if input == nil {
 		return nil, fmt.Errorf("`input` was nil")
@@ -30,7 +30,7 @@ func FirewallFixIPConfiguration(input *[]network.AzureFirewallIPConfiguration) (
 				Subnet: &network.SubResource{
 					ID: config.Subnet.ID,
 				},
-				InternalPublicIPAddress: &network.SubResource{
+				PublicIPAddress: &network.SubResource{
 					ID: config.PublicIPAddress.ID,
 				},
 			},
@@ -17,6 +17,16 @@ type KeyVaultChildID struct {
 
 func ParseKeyVaultChildID(id string) (*KeyVaultChildID, error) {
 	// example: https://tharvey-keyvault.vault.azure.net/type/bird/fdf067c93bbb4b22bff4d8b7a9a56217
+	return parseKeyVaultChildID(id, true)
+}
+
+// ParseKeyVaultChildIDVersionOptional parses a Key Vault Child ID which may omit the Version
+// e.g. https://tharvey-keyvault.vault.azure.net/keys/bird - in which case Version is empty
+func ParseKeyVaultChildIDVersionOptional(id string) (*KeyVaultChildID, error) {
+	return parseKeyVaultChildID(id, false)
+}
+
+func parseKeyVaultChildID(id string, requireVersion bool) (*KeyVaultChildID, error) {
 	idURL, err := url.ParseRequestURI(id)
 	if err != nil {
 		return nil, fmt.Errorf("Cannot parse Azure KeyVault Child Id: %s", err)
@@ -29,14 +39,21 @@ func ParseKeyVaultChildID(id string) (*KeyVaultChildID, error) {
 
 	components := strings.Split(path, "/")
 
-	if len(components) != 3 {
+	if requireVersion && len(components) != 3 {
 		return nil, fmt.Errorf("Azure KeyVault Child Id should have 3 segments, got %d: '%s'", len(components), path)
 	}
 
+	if !requireVersion && len(components) != 2 && len(components) != 3 {
+		return nil, fmt.Errorf("Azure KeyVault Child Id should have 2 or 3 segments, got %d: '%s'", len(components), path)
+	}
+
 	childId := KeyVaultChildID{
 		KeyVaultBaseUrl: fmt.Sprintf("%s://%s/", idURL.Scheme, idURL.Host),
 		Name:            components[1],
-		Version:         components[2],
+	}
+
+	if len(components) == 3 {
+		childId.Version = components[2]
 	}
 
 	return &childId, nil
@@ -73,3 +90,23 @@ func ValidateKeyVaultChildId(i interface{}, k string) (s []string, es []error) {
 
 	return s, es
 }
+
+func ValidateKeyVaultChildIdVersionOptional(i interface{}, k string) (s []string, es []error) {
+	if s, es = validation.NoZeroValues(i, k); len(es) > 0 {
+		return s, es
+	}
+
+	v, ok := i.(string)
+	if !ok {
+		es = append(es, fmt.Errorf("Expected %s to be a string!", k))
+		return s, es
+	}
+
+	_, err := ParseKeyVaultChildIDVersionOptional(v)
+	if err != nil {
+		es = append(es, fmt.Errorf("Error parsing Key Vault Child ID: %s", err))
+		return s, es
+	}
+
+	return s, es
+}
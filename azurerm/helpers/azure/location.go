@@ -0,0 +1,22 @@
+package azure
+
+import (
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// NormalizeLocation is a function which normalises human-readable region/location
+// names (e.g. "West US") to the values used and returned by the Azure API (e.g. "westus").
+// In state we track the API internal version as it is easier to go from the human form
+// to the canonical form than the other way around.
+func NormalizeLocation(location interface{}) string {
+	input := location.(string)
+	return strings.Replace(strings.ToLower(input), " ", "", -1)
+}
+
+// SuppressLocationDiff suppresses any differences between two locations which
+// only differ in casing and whitespace (e.g. "West Europe" and "westeurope")
+func SuppressLocationDiff(k, old, new string, d *schema.ResourceData) bool {
+	return NormalizeLocation(old) == NormalizeLocation(new)
+}
@@ -0,0 +1,61 @@
+package azure
+
+import "testing"
+
+func TestNormalizeLocation(t *testing.T) {
+	cases := []struct {
+		Input    string
+		Expected string
+	}{
+		{
+			Input:    "West US",
+			Expected: "westus",
+		},
+		{
+			Input:    "South East Asia",
+			Expected: "southeastasia",
+		},
+		{
+			Input:    "westeurope",
+			Expected: "westeurope",
+		},
+	}
+
+	for _, v := range cases {
+		actual := NormalizeLocation(v.Input)
+		if v.Expected != actual {
+			t.Fatalf("Expected %q but got %q", v.Expected, actual)
+		}
+	}
+}
+
+func TestSuppressLocationDiff(t *testing.T) {
+	cases := []struct {
+		Old      string
+		New      string
+		Suppress bool
+	}{
+		{
+			Old:      "westeurope",
+			New:      "West Europe",
+			Suppress: true,
+		},
+		{
+			Old:      "West Europe",
+			New:      "westeurope",
+			Suppress: true,
+		},
+		{
+			Old:      "westeurope",
+			New:      "northeurope",
+			Suppress: false,
+		},
+	}
+
+	for _, v := range cases {
+		actual := SuppressLocationDiff("location", v.Old, v.New, nil)
+		if v.Suppress != actual {
+			t.Fatalf("Expected %t for %q / %q but got %t", v.Suppress, v.Old, v.New, actual)
+		}
+	}
+}
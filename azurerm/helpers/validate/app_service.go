@@ -0,0 +1,18 @@
+package validate
+
+import (
+	"regexp"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+// AppServiceRuntimeVersion validates the format of a runtime version (e.g. `7.2`, `11` or `1.8.0_181`) rather than
+// checking it against a fixed list, since the supported runtimes change more often than the Provider is
+// released - the `azurerm_app_service_available_stacks` Data Source can be used to look up the current values.
+func AppServiceRuntimeVersion() schema.SchemaValidateFunc {
+	return validation.StringMatch(
+		regexp.MustCompile(`^[0-9]+(\.[0-9]+)*(_[0-9]+)?$`),
+		"The runtime version must be a version number such as `7.2`, `11` or `1.8.0_181`.",
+	)
+}
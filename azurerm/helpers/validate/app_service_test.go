@@ -0,0 +1,30 @@
+package validate
+
+import "testing"
+
+func TestAppServiceRuntimeVersion(t *testing.T) {
+	cases := []struct {
+		Value  string
+		Errors int
+	}{
+		{Value: "", Errors: 1},
+		{Value: "1.7", Errors: 0},
+		{Value: "1.8", Errors: 0},
+		{Value: "11", Errors: 0},
+		{Value: "3.6", Errors: 0},
+		{Value: "7.3", Errors: 0},
+		{Value: "1.8.0_181", Errors: 0},
+		{Value: "1.8_", Errors: 1},
+		{Value: "7.", Errors: 1},
+		{Value: ".7", Errors: 1},
+		{Value: "latest", Errors: 1},
+		{Value: "v7.2", Errors: 1},
+	}
+
+	for _, tc := range cases {
+		_, errors := AppServiceRuntimeVersion()(tc.Value, "php_version")
+		if len(errors) != tc.Errors {
+			t.Fatalf("Expected AppServiceRuntimeVersion to return %d error(s) not %d for %q", tc.Errors, len(errors), tc.Value)
+		}
+	}
+}
@@ -0,0 +1,26 @@
+package validate
+
+import (
+	"regexp"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func FrontDoorName() schema.SchemaValidateFunc {
+	return func(i interface{}, k string) (s []string, es []error) {
+		// Length should be between 5 and 64.
+		if s, es = validation.StringLenBetween(5, 64)(i, k); len(es) > 0 {
+			return s, es
+		}
+
+		// Naming rule.
+		regexStr := `^[a-zA-Z0-9]([-a-zA-Z0-9]*[a-zA-Z0-9])?$`
+		errMsg := "Front Door names can only include alphanumeric characters and hyphens, and must start and end with an alphanumeric character."
+		if s, es = validation.StringMatch(regexp.MustCompile(regexStr), errMsg)(i, k); len(es) > 0 {
+			return s, es
+		}
+
+		return s, es
+	}
+}
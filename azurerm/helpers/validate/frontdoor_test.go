@@ -0,0 +1,37 @@
+package validate
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestValidateFrontDoorName(t *testing.T) {
+	validNames := []string{
+		"valid",
+		"valid-name",
+		"V4lid-Name-01",
+		strings.Repeat("w", 64),
+	}
+	for _, v := range validNames {
+		_, errors := FrontDoorName()(v, "valid")
+		if len(errors) != 0 {
+			t.Fatalf("%q should be a valid Front Door Name: %q", v, errors)
+		}
+	}
+
+	invalidNames := []string{
+		"",
+		"abcd",
+		"-invalid",
+		"invalid-",
+		"in_valid",
+		"in valid",
+		strings.Repeat("w", 65),
+	}
+	for _, v := range invalidNames {
+		_, errors := FrontDoorName()(v, "invalid")
+		if len(errors) == 0 {
+			t.Fatalf("%q should be an invalid Front Door Name", v)
+		}
+	}
+}
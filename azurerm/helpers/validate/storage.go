@@ -0,0 +1,23 @@
+package validate
+
+import (
+	"fmt"
+	"regexp"
+)
+
+// StorageMetaData validates the keys of a MetaData map used by Blobs, Containers and Queues
+// - which must be valid C# identifiers and are stored in lower-case by the Storage API
+func StorageMetaData(i interface{}, k string) (_ []string, errors []error) {
+	v, ok := i.(map[string]interface{})
+	if !ok {
+		return nil, []error{fmt.Errorf("expected type of %q to be a map", k)}
+	}
+
+	for key := range v {
+		if !regexp.MustCompile(`^[a-z_][a-z0-9_]*$`).MatchString(key) {
+			errors = append(errors, fmt.Errorf("%q contains the key %q - MetaData keys must start with a lowercase letter or underscore and only contain lowercase letters, numbers and underscores", k, key))
+		}
+	}
+
+	return nil, errors
+}
@@ -0,0 +1,43 @@
+package validate
+
+import "testing"
+
+func TestStorageMetaData(t *testing.T) {
+	cases := []struct {
+		Input  map[string]interface{}
+		Errors int
+	}{
+		{
+			Input:  map[string]interface{}{},
+			Errors: 0,
+		},
+		{
+			Input: map[string]interface{}{
+				"hello": "world",
+				"_key2": "value",
+			},
+			Errors: 0,
+		},
+		{
+			Input: map[string]interface{}{
+				"Hello": "world",
+			},
+			Errors: 1,
+		},
+		{
+			Input: map[string]interface{}{
+				"1hello": "world",
+				"hel-lo": "world",
+			},
+			Errors: 2,
+		},
+	}
+
+	for _, tc := range cases {
+		_, errors := StorageMetaData(tc.Input, "metadata")
+
+		if len(errors) != tc.Errors {
+			t.Fatalf("Expected StorageMetaData to have %d errors for %+v, got %d", tc.Errors, tc.Input, len(errors))
+		}
+	}
+}
@@ -2,6 +2,7 @@ package validate
 
 import (
 	"fmt"
+	"regexp"
 	"time"
 
 	"github.com/Azure/go-autorest/autorest/date"
@@ -48,6 +49,22 @@ func RFC3339DateInFutureBy(d time.Duration) schema.SchemaValidateFunc {
 	}
 }
 
+// ISO8601Duration validates that the value is an ISO8601 duration such as `P30D` or `PT1H30M`
+func ISO8601Duration(i interface{}, k string) (_ []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return
+	}
+
+	matched := regexp.MustCompile(`^P(\d+Y)?(\d+M)?(\d+W)?(\d+D)?(T(\d+H)?(\d+M)?(\d+(\.\d+)?S)?)?$`).MatchString(v)
+	if !matched || v == "P" || v[len(v)-1:] == "T" {
+		errors = append(errors, fmt.Errorf("%q has the invalid ISO8601 duration format %q", k, v))
+	}
+
+	return
+}
+
 func DayOfTheWeek(ignoreCase bool) schema.SchemaValidateFunc {
 	return validation.StringInSlice([]string{
 		"Monday",
@@ -0,0 +1,26 @@
+package validate
+
+import (
+	"regexp"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func TimeSeriesInsightsName() schema.SchemaValidateFunc {
+	return func(i interface{}, k string) (s []string, es []error) {
+		// Length should be between 1 and 90.
+		if s, es = validation.StringLenBetween(1, 90)(i, k); len(es) > 0 {
+			return s, es
+		}
+
+		// Naming rule.
+		regexStr := `^[-\w\._\(\)]+$`
+		errMsg := "Time Series Insights names can only include alphanumeric characters, periods, underscores, hyphens and parentheses."
+		if s, es = validation.StringMatch(regexp.MustCompile(regexStr), errMsg)(i, k); len(es) > 0 {
+			return s, es
+		}
+
+		return s, es
+	}
+}
@@ -0,0 +1,36 @@
+package validate
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestValidateTimeSeriesInsightsName(t *testing.T) {
+	validNames := []string{
+		"a",
+		"valid-name",
+		"valid_name.01",
+		"(valid)",
+		strings.Repeat("w", 90),
+	}
+	for _, v := range validNames {
+		_, errors := TimeSeriesInsightsName()(v, "valid")
+		if len(errors) != 0 {
+			t.Fatalf("%q should be a valid Time Series Insights Name: %q", v, errors)
+		}
+	}
+
+	invalidNames := []string{
+		"",
+		"invalid!",
+		"in valid",
+		"invalid/name",
+		strings.Repeat("w", 91),
+	}
+	for _, v := range invalidNames {
+		_, errors := TimeSeriesInsightsName()(v, "invalid")
+		if len(errors) == 0 {
+			t.Fatalf("%q should be an invalid Time Series Insights Name", v)
+		}
+	}
+}
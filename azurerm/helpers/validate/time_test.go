@@ -47,6 +47,56 @@ func TestRFC3339Time(t *testing.T) {
 	}
 }
 
+func TestISO8601Duration(t *testing.T) {
+	cases := []struct {
+		Value  string
+		Errors int
+	}{
+		{
+			Value:  "",
+			Errors: 1,
+		},
+		{
+			Value:  "P",
+			Errors: 1,
+		},
+		{
+			Value:  "PT",
+			Errors: 1,
+		},
+		{
+			Value:  "30D",
+			Errors: 1,
+		},
+		{
+			Value:  "P1DT",
+			Errors: 1,
+		},
+		{
+			Value:  "P30D",
+			Errors: 0,
+		},
+		{
+			Value:  "PT1H30M",
+			Errors: 0,
+		},
+		{
+			Value:  "P1Y2M3DT4H5M6.5S",
+			Errors: 0,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.Value, func(t *testing.T) {
+			_, errors := ISO8601Duration(tc.Value, "test")
+
+			if len(errors) != tc.Errors {
+				t.Fatalf("Expected ISO8601Duration to have %d not %d errors for %q", tc.Errors, len(errors), tc.Value)
+			}
+		})
+	}
+}
+
 func TestRfc3339DateInFutureBy(t *testing.T) {
 	cases := []struct {
 		Name     string
@@ -30,6 +30,23 @@ func IntBetweenAndNot(min, max, not int) schema.SchemaValidateFunc {
 	}
 }
 
+func FloatBetween(min, max float64) schema.SchemaValidateFunc {
+	return func(i interface{}, k string) (_ []string, errors []error) {
+		v, ok := i.(float64)
+		if !ok {
+			errors = append(errors, fmt.Errorf("expected type of %q to be float", k))
+			return
+		}
+
+		if v < min || v > max {
+			errors = append(errors, fmt.Errorf("expected %s to be in the range (%f - %f), got %f", k, min, max, v))
+			return
+		}
+
+		return
+	}
+}
+
 func UrlIsHttpOrHttps() schema.SchemaValidateFunc {
 	return UrlWithScheme([]string{"http", "https"})
 }
@@ -2,6 +2,51 @@ package validate
 
 import "testing"
 
+func TestFloatBetween(t *testing.T) {
+	testCases := []struct {
+		Value           interface{}
+		ShouldHaveError bool
+	}{
+		{
+			Value:           0.9,
+			ShouldHaveError: true,
+		},
+		{
+			Value:           1.0,
+			ShouldHaveError: false,
+		},
+		{
+			Value:           1.3,
+			ShouldHaveError: false,
+		},
+		{
+			Value:           1.9,
+			ShouldHaveError: false,
+		},
+		{
+			Value:           2.0,
+			ShouldHaveError: true,
+		},
+		{
+			Value:           "1.3",
+			ShouldHaveError: true,
+		},
+	}
+
+	for _, v := range testCases {
+		_, errors := FloatBetween(1.0, 1.9)(v.Value, "field_name")
+
+		hasErrors := len(errors) > 0
+		if v.ShouldHaveError && !hasErrors {
+			t.Fatalf("Expected an error but didn't get one for %v", v.Value)
+		}
+
+		if !v.ShouldHaveError && hasErrors {
+			t.Fatalf("Expected %v to return no errors, but got some %+v", v.Value, errors)
+		}
+	}
+}
+
 func TestUrlWithScheme(t *testing.T) {
 	validSchemes := []string{"example"}
 	testCases := []struct {
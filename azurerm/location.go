@@ -1,9 +1,8 @@
 package azurerm
 
 import (
-	"strings"
-
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
 )
 
 func locationSchema() *schema.Schema {
@@ -36,13 +35,10 @@ func deprecatedLocationSchema() *schema.Schema {
 
 // azureRMNormalizeLocation is a function which normalises human-readable region/location
 // names (e.g. "West US") to the values used and returned by the Azure API (e.g. "westus").
-// In state we track the API internal version as it is easier to go from the human form
-// to the canonical form than the other way around.
 func azureRMNormalizeLocation(location interface{}) string {
-	input := location.(string)
-	return strings.Replace(strings.ToLower(input), " ", "", -1)
+	return azure.NormalizeLocation(location)
 }
 
 func azureRMSuppressLocationDiff(k, old, new string, d *schema.ResourceData) bool {
-	return azureRMNormalizeLocation(old) == azureRMNormalizeLocation(new)
+	return azure.SuppressLocationDiff(k, old, new, d)
 }
@@ -33,6 +33,7 @@ func Provider() terraform.ResourceProvider {
 			"client_secret": {
 				Type:        schema.TypeString,
 				Optional:    true,
+				Sensitive:   true,
 				DefaultFunc: schema.EnvDefaultFunc("ARM_CLIENT_SECRET", ""),
 			},
 
@@ -69,6 +70,12 @@ func Provider() terraform.ResourceProvider {
 				Optional:    true,
 				DefaultFunc: schema.EnvDefaultFunc("ARM_MSI_ENDPOINT", ""),
 			},
+
+			"storage_use_azuread": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("ARM_STORAGE_USE_AZUREAD", false),
+			},
 		},
 
 		DataSourcesMap: map[string]*schema.Resource{
@@ -77,23 +84,31 @@ func Provider() terraform.ResourceProvider {
 			"azurerm_api_management":                        dataSourceApiManagementService(),
 			"azurerm_application_security_group":            dataSourceArmApplicationSecurityGroup(),
 			"azurerm_app_service":                           dataSourceArmAppService(),
+			"azurerm_app_service_available_stacks":          dataSourceArmAppServiceAvailableStacks(),
 			"azurerm_app_service_plan":                      dataSourceAppServicePlan(),
+			"azurerm_backup_recovery_points":                dataSourceArmBackupRecoveryPoints(),
 			"azurerm_builtin_role_definition":               dataSourceArmBuiltInRoleDefinition(),
 			"azurerm_cdn_profile":                           dataSourceArmCdnProfile(),
 			"azurerm_client_config":                         dataSourceArmClientConfig(),
 			"azurerm_cosmosdb_account":                      dataSourceArmCosmosDBAccount(),
+			"azurerm_cosmosdb_account_connection_string":    dataSourceArmCosmosDBAccountConnectionString(),
 			"azurerm_container_registry":                    dataSourceArmContainerRegistry(),
+			"azurerm_container_registry_credentials":        dataSourceArmContainerRegistryCredentials(),
 			"azurerm_data_lake_store":                       dataSourceArmDataLakeStoreAccount(),
 			"azurerm_dev_test_lab":                          dataSourceArmDevTestLab(),
 			"azurerm_dns_zone":                              dataSourceArmDnsZone(),
+			"azurerm_eventhub_connection_string":            dataSourceArmEventHubConnectionString(),
 			"azurerm_eventhub_namespace":                    dataSourceEventHubNamespace(),
+			"azurerm_express_route_circuit_authorization":   dataSourceArmExpressRouteCircuitAuthorization(),
 			"azurerm_image":                                 dataSourceArmImage(),
 			"azurerm_key_vault":                             dataSourceArmKeyVault(),
 			"azurerm_key_vault_access_policy":               dataSourceArmKeyVaultAccessPolicy(),
 			"azurerm_key_vault_secret":                      dataSourceArmKeyVaultSecret(),
 			"azurerm_kubernetes_cluster":                    dataSourceArmKubernetesCluster(),
+			"azurerm_kubernetes_service_versions":           dataSourceArmKubernetesServiceVersions(),
 			"azurerm_log_analytics_workspace":               dataSourceLogAnalyticsWorkspace(),
 			"azurerm_logic_app_workflow":                    dataSourceArmLogicAppWorkflow(),
+			"azurerm_managed_api":                           dataSourceArmManagedApi(),
 			"azurerm_managed_disk":                          dataSourceArmManagedDisk(),
 			"azurerm_management_group":                      dataSourceArmManagementGroup(),
 			"azurerm_monitor_log_profile":                   dataSourceArmMonitorLogProfile(),
@@ -102,32 +117,44 @@ func Provider() terraform.ResourceProvider {
 			"azurerm_notification_hub":                      dataSourceNotificationHub(),
 			"azurerm_notification_hub_namespace":            dataSourceNotificationHubNamespace(),
 			"azurerm_platform_image":                        dataSourceArmPlatformImage(),
+			"azurerm_policy_assignment_compliance":          dataSourceArmPolicyAssignmentCompliance(),
 			"azurerm_public_ip":                             dataSourceArmPublicIP(),
 			"azurerm_public_ips":                            dataSourceArmPublicIPs(),
 			"azurerm_recovery_services_vault":               dataSourceArmRecoveryServicesVault(),
 			"azurerm_resource_group":                        dataSourceArmResourceGroup(),
+			"azurerm_resource_groups":                       dataSourceArmResourceGroups(),
 			"azurerm_role_definition":                       dataSourceArmRoleDefinition(),
 			"azurerm_route_table":                           dataSourceArmRouteTable(),
 			"azurerm_scheduler_job_collection":              dataSourceArmSchedulerJobCollection(),
+			"azurerm_servicebus_connection_string":          dataSourceArmServiceBusConnectionString(),
 			"azurerm_shared_image":                          dataSourceArmSharedImage(),
 			"azurerm_shared_image_gallery":                  dataSourceArmSharedImageGallery(),
 			"azurerm_shared_image_version":                  dataSourceArmSharedImageVersion(),
 			"azurerm_snapshot":                              dataSourceArmSnapshot(),
 			"azurerm_storage_account":                       dataSourceArmStorageAccount(),
+			"azurerm_storage_account_connection_string":     dataSourceArmStorageAccountConnectionString(),
 			"azurerm_storage_account_sas":                   dataSourceArmStorageAccountSharedAccessSignature(),
 			"azurerm_subnet":                                dataSourceArmSubnet(),
 			"azurerm_subscription":                          dataSourceArmSubscription(),
 			"azurerm_subscriptions":                         dataSourceArmSubscriptions(),
+			"azurerm_traffic_manager_endpoint":              dataSourceArmTrafficManagerEndpoint(),
 			"azurerm_traffic_manager_geographical_location": dataSourceArmTrafficManagerGeographicalLocation(),
+			"azurerm_virtual_machine":                       dataSourceArmVirtualMachine(),
+			"azurerm_virtual_machine_scale_set":             dataSourceArmVirtualMachineScaleSet(),
 			"azurerm_virtual_network":                       dataSourceArmVirtualNetwork(),
 			"azurerm_virtual_network_gateway":               dataSourceArmVirtualNetworkGateway(),
+			"azurerm_virtual_network_peering":               dataSourceArmVirtualNetworkPeering(),
 		},
 
 		ResourcesMap: map[string]*schema.Resource{
 			"azurerm_azuread_application":                                                    resourceArmActiveDirectoryApplication(),
 			"azurerm_azuread_service_principal":                                              resourceArmActiveDirectoryServicePrincipal(),
 			"azurerm_azuread_service_principal_password":                                     resourceArmActiveDirectoryServicePrincipalPassword(),
+			"azurerm_api_connection":                                                         resourceArmApiConnection(),
 			"azurerm_api_management":                                                         resourceArmApiManagementService(),
+			"azurerm_api_management_email_template":                                          resourceArmApiManagementEmailTemplate(),
+			"azurerm_api_management_notification_recipient_email":                            resourceArmApiManagementNotificationRecipientEmail(),
+			"azurerm_api_management_notification_recipient_user":                             resourceArmApiManagementNotificationRecipientUser(),
 			"azurerm_application_gateway":                                                    resourceArmApplicationGateway(),
 			"azurerm_application_insights":                                                   resourceArmApplicationInsights(),
 			"azurerm_application_security_group":                                             resourceArmApplicationSecurityGroup(),
@@ -136,6 +163,7 @@ func Provider() terraform.ResourceProvider {
 			"azurerm_app_service_active_slot":                                                resourceArmAppServiceActiveSlot(),
 			"azurerm_app_service_custom_hostname_binding":                                    resourceArmAppServiceCustomHostnameBinding(),
 			"azurerm_app_service_slot":                                                       resourceArmAppServiceSlot(),
+			"azurerm_app_service_virtual_network_swift_connection":                           resourceArmAppServiceVirtualNetworkSwiftConnection(),
 			"azurerm_automation_account":                                                     resourceArmAutomationAccount(),
 			"azurerm_automation_credential":                                                  resourceArmAutomationCredential(),
 			"azurerm_automation_dsc_configuration":                                           resourceArmAutomationDscConfiguration(),
@@ -143,8 +171,17 @@ func Provider() terraform.ResourceProvider {
 			"azurerm_automation_module":                                                      resourceArmAutomationModule(),
 			"azurerm_automation_runbook":                                                     resourceArmAutomationRunbook(),
 			"azurerm_automation_schedule":                                                    resourceArmAutomationSchedule(),
+			"azurerm_automation_software_update_configuration":                               resourceArmAutomationSoftwareUpdateConfiguration(),
 			"azurerm_autoscale_setting":                                                      resourceArmAutoScaleSetting(),
 			"azurerm_availability_set":                                                       resourceArmAvailabilitySet(),
+			"azurerm_backup_container_storage_account":                                       resourceArmBackupContainerStorageAccount(),
+			"azurerm_backup_container_vm_workload":                                           resourceArmBackupContainerVmWorkload(),
+			"azurerm_backup_policy_file_share":                                               resourceArmBackupPolicyFileShare(),
+			"azurerm_backup_policy_vm_workload":                                              resourceArmBackupPolicyVmWorkload(),
+			"azurerm_backup_protected_file_share":                                            resourceArmBackupProtectedFileShare(),
+			"azurerm_backup_protected_vm_workload_database":                                  resourceArmBackupProtectedVmWorkloadDatabase(),
+			"azurerm_backup_vm_restore":                                                      resourceArmBackupVmRestore(),
+			"azurerm_batch_account":                                                          resourceArmBatchAccount(),
 			"azurerm_cdn_endpoint":                                                           resourceArmCdnEndpoint(),
 			"azurerm_cdn_profile":                                                            resourceArmCdnProfile(),
 			"azurerm_cognitive_account":                                                      resourceArmCognitiveAccount(),
@@ -152,6 +189,8 @@ func Provider() terraform.ResourceProvider {
 			"azurerm_container_service":                                                      resourceArmContainerService(),
 			"azurerm_container_group":                                                        resourceArmContainerGroup(),
 			"azurerm_cosmosdb_account":                                                       resourceArmCosmosDBAccount(),
+			"azurerm_database_migration_project":                                             resourceArmDatabaseMigrationProject(),
+			"azurerm_database_migration_service":                                             resourceArmDatabaseMigrationService(),
 			"azurerm_databricks_workspace":                                                   resourceArmDatabricksWorkspace(),
 			"azurerm_data_lake_analytics_account":                                            resourceArmDataLakeAnalyticsAccount(),
 			"azurerm_data_lake_analytics_firewall_rule":                                      resourceArmDataLakeAnalyticsFirewallRule(),
@@ -184,9 +223,18 @@ func Provider() terraform.ResourceProvider {
 			"azurerm_express_route_circuit_authorization":                                    resourceArmExpressRouteCircuitAuthorization(),
 			"azurerm_express_route_circuit_peering":                                          resourceArmExpressRouteCircuitPeering(),
 			"azurerm_firewall":                                                               resourceArmFirewall(),
+			"azurerm_firewall_application_rule_collection":                                   resourceArmFirewallApplicationRuleCollection(),
+			"azurerm_firewall_nat_rule_collection":                                           resourceArmFirewallNatRuleCollection(),
 			"azurerm_firewall_network_rule_collection":                                       resourceArmFirewallNetworkRuleCollection(),
+			"azurerm_frontdoor":                                                              resourceArmFrontDoor(),
 			"azurerm_function_app":                                                           resourceArmFunctionApp(),
+			"azurerm_generic_resource":                                                       resourceArmGenericResource(),
 			"azurerm_image":                                                                  resourceArmImage(),
+			"azurerm_iot_time_series_insights_access_policy":                                 resourceArmIoTTimeSeriesInsightsAccessPolicy(),
+			"azurerm_iot_time_series_insights_event_source_eventhub":                         resourceArmIoTTimeSeriesInsightsEventSourceEventHub(),
+			"azurerm_iot_time_series_insights_event_source_iothub":                           resourceArmIoTTimeSeriesInsightsEventSourceIoTHub(),
+			"azurerm_iot_time_series_insights_standard_environment":                          resourceArmIoTTimeSeriesInsightsStandardEnvironment(),
+			"azurerm_iotcentral_application":                                                 resourceArmIotCentralApplication(),
 			"azurerm_iothub":                                                                 resourceArmIotHub(),
 			"azurerm_key_vault":                                                              resourceArmKeyVault(),
 			"azurerm_key_vault_access_policy":                                                resourceArmKeyVaultAccessPolicy(),
@@ -194,10 +242,12 @@ func Provider() terraform.ResourceProvider {
 			"azurerm_key_vault_key":                                                          resourceArmKeyVaultKey(),
 			"azurerm_key_vault_secret":                                                       resourceArmKeyVaultSecret(),
 			"azurerm_kubernetes_cluster":                                                     resourceArmKubernetesCluster(),
+			"azurerm_kubernetes_cluster_container_registry_association":                      resourceArmKubernetesClusterContainerRegistryAssociation(),
 			"azurerm_lb":                                                                     resourceArmLoadBalancer(),
 			"azurerm_lb_backend_address_pool":                                                resourceArmLoadBalancerBackendAddressPool(),
 			"azurerm_lb_nat_rule":                                                            resourceArmLoadBalancerNatRule(),
 			"azurerm_lb_nat_pool":                                                            resourceArmLoadBalancerNatPool(),
+			"azurerm_lb_outbound_rule":                                                       resourceArmLoadBalancerOutboundRule(),
 			"azurerm_lb_probe":                                                               resourceArmLoadBalancerProbe(),
 			"azurerm_lb_rule":                                                                resourceArmLoadBalancerRule(),
 			"azurerm_local_network_gateway":                                                  resourceArmLocalNetworkGateway(),
@@ -214,17 +264,23 @@ func Provider() terraform.ResourceProvider {
 			"azurerm_management_lock":                                                        resourceArmManagementLock(),
 			"azurerm_management_group":                                                       resourceArmManagementGroup(),
 			"azurerm_metric_alertrule":                                                       resourceArmMetricAlertRule(),
+			"azurerm_migrate_assessment":                                                     resourceArmMigrateAssessment(),
+			"azurerm_migrate_group":                                                          resourceArmMigrateGroup(),
+			"azurerm_migrate_project":                                                        resourceArmMigrateProject(),
 			"azurerm_monitor_action_group":                                                   resourceArmMonitorActionGroup(),
 			"azurerm_monitor_activity_log_alert":                                             resourceArmMonitorActivityLogAlert(),
 			"azurerm_monitor_log_profile":                                                    resourceArmMonitorLogProfile(),
 			"azurerm_monitor_metric_alert":                                                   resourceArmMonitorMetricAlert(),
+			"azurerm_mssql_server_transparent_data_encryption":                               resourceArmMsSqlServerTransparentDataEncryption(),
 			"azurerm_mysql_configuration":                                                    resourceArmMySQLConfiguration(),
 			"azurerm_mysql_database":                                                         resourceArmMySqlDatabase(),
 			"azurerm_mysql_firewall_rule":                                                    resourceArmMySqlFirewallRule(),
 			"azurerm_mysql_server":                                                           resourceArmMySqlServer(),
 			"azurerm_mysql_virtual_network_rule":                                             resourceArmMySqlVirtualNetworkRule(),
+			"azurerm_network_ddos_protection_plan":                                           resourceArmNetworkDDoSProtectionPlan(),
 			"azurerm_network_interface":                                                      resourceArmNetworkInterface(),
 			"azurerm_network_interface_application_gateway_backend_address_pool_association": resourceArmNetworkInterfaceApplicationGatewayBackendAddressPoolAssociation(),
+			"azurerm_network_interface_application_security_group_association":               resourceArmNetworkInterfaceApplicationSecurityGroupAssociation(),
 			"azurerm_network_interface_backend_address_pool_association":                     resourceArmNetworkInterfaceBackendAddressPoolAssociation(),
 			"azurerm_network_interface_nat_rule_association":                                 resourceArmNetworkInterfaceNatRuleAssociation(),
 			"azurerm_network_security_group":                                                 resourceArmNetworkSecurityGroup(),
@@ -252,6 +308,7 @@ func Provider() terraform.ResourceProvider {
 			"azurerm_role_assignment":                                                        resourceArmRoleAssignment(),
 			"azurerm_role_definition":                                                        resourceArmRoleDefinition(),
 			"azurerm_route":                                                                  resourceArmRoute(),
+			"azurerm_route_filter":                                                           resourceArmRouteFilter(),
 			"azurerm_route_table":                                                            resourceArmRouteTable(),
 			"azurerm_search_service":                                                         resourceArmSearchService(),
 			"azurerm_security_center_subscription_pricing":                                   resourceArmSecurityCenterSubscriptionPricing(),
@@ -284,13 +341,16 @@ func Provider() terraform.ResourceProvider {
 			"azurerm_storage_share":                                                          resourceArmStorageShare(),
 			"azurerm_storage_queue":                                                          resourceArmStorageQueue(),
 			"azurerm_storage_table":                                                          resourceArmStorageTable(),
+			"azurerm_storage_table_entity":                                                   resourceArmStorageTableEntity(),
 			"azurerm_subnet":                                                                 resourceArmSubnet(),
 			"azurerm_subnet_network_security_group_association":                              resourceArmSubnetNetworkSecurityGroupAssociation(),
 			"azurerm_subnet_route_table_association":                                         resourceArmSubnetRouteTableAssociation(),
+			"azurerm_subscription_template_deployment":                                       resourceArmSubscriptionTemplateDeployment(),
 			"azurerm_template_deployment":                                                    resourceArmTemplateDeployment(),
 			"azurerm_traffic_manager_endpoint":                                               resourceArmTrafficManagerEndpoint(),
 			"azurerm_traffic_manager_profile":                                                resourceArmTrafficManagerProfile(),
 			"azurerm_user_assigned_identity":                                                 resourceArmUserAssignedIdentity(),
+			"azurerm_virtual_hub":                                                            resourceArmVirtualHub(),
 			"azurerm_virtual_machine":                                                        resourceArmVirtualMachine(),
 			"azurerm_virtual_machine_data_disk_attachment":                                   resourceArmVirtualMachineDataDiskAttachment(),
 			"azurerm_virtual_machine_extension":                                              resourceArmVirtualMachineExtensions(),
@@ -299,6 +359,9 @@ func Provider() terraform.ResourceProvider {
 			"azurerm_virtual_network_gateway":                                                resourceArmVirtualNetworkGateway(),
 			"azurerm_virtual_network_gateway_connection":                                     resourceArmVirtualNetworkGatewayConnection(),
 			"azurerm_virtual_network_peering":                                                resourceArmVirtualNetworkPeering(),
+			"azurerm_virtual_wan":                                                            resourceArmVirtualWan(),
+			"azurerm_vpn_gateway":                                                            resourceArmVPNGateway(),
+			"azurerm_vpn_site":                                                               resourceArmVPNSite(),
 		},
 	}
 
@@ -319,6 +382,7 @@ func providerConfigure(p *schema.Provider) schema.ConfigureFunc {
 			MsiEndpoint:               d.Get("msi_endpoint").(string),
 			SkipCredentialsValidation: d.Get("skip_credentials_validation").(bool),
 			SkipProviderRegistration:  d.Get("skip_provider_registration").(bool),
+			StorageUseAzureAD:         d.Get("storage_use_azuread").(bool),
 		}
 
 		err := config.Validate()
@@ -19,6 +19,7 @@ func requiredResourceProviders() map[string]struct{} {
 		"Microsoft.ApiManagement":        {},
 		"Microsoft.Authorization":        {},
 		"Microsoft.Automation":           {},
+		"Microsoft.Batch":                {},
 		"Microsoft.Cache":                {},
 		"Microsoft.Cdn":                  {},
 		"Microsoft.CognitiveServices":    {},
@@ -29,6 +30,7 @@ func requiredResourceProviders() map[string]struct{} {
 		"Microsoft.Databricks":           {},
 		"Microsoft.DataLakeAnalytics":    {},
 		"Microsoft.DataLakeStore":        {},
+		"Microsoft.DataMigration":        {},
 		"Microsoft.DBforMySQL":           {},
 		"Microsoft.DBforPostgreSQL":      {},
 		"Microsoft.Devices":              {},
@@ -0,0 +1,171 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/Azure/azure-sdk-for-go/services/preview/web/mgmt/2015-08-preview/web"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmApiConnection() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmApiConnectionCreateUpdate,
+		Read:   resourceArmApiConnectionRead,
+		Update: resourceArmApiConnectionCreateUpdate,
+		Delete: resourceArmApiConnectionDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+
+			"resource_group_name": resourceGroupNameSchema(),
+
+			"managed_api_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: azure.ValidateResourceID,
+			},
+
+			"display_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			// the values of the parameters aren't returned by the API, since they're generally credentials
+			"parameter_values": {
+				Type:      schema.TypeMap,
+				Optional:  true,
+				Sensitive: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+
+			"tags": tagsSchema(),
+		},
+	}
+}
+
+func resourceArmApiConnectionCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).apiConnectionsClient
+	ctx := meta.(*ArmClient).StopContext
+
+	log.Printf("[INFO] preparing arguments for API Connection creation/update.")
+
+	name := d.Get("name").(string)
+	resourceGroup := d.Get("resource_group_name").(string)
+	managedApiId := d.Get("managed_api_id").(string)
+	tags := d.Get("tags").(map[string]interface{})
+
+	// the Connection must be in the same location as the Managed API it connects to
+	apiId, err := parseAzureResourceID(managedApiId)
+	if err != nil {
+		return err
+	}
+	location := apiId.Path["locations"]
+	if location == "" {
+		return fmt.Errorf("Error determining the location of the Managed API %q - expected the ID to be in the format `/subscriptions/{subscriptionId}/providers/Microsoft.Web/locations/{location}/managedApis/{name}`", managedApiId)
+	}
+
+	connection := web.Connection{
+		Location: utils.String(azureRMNormalizeLocation(location)),
+		ConnectionProperties: &web.ConnectionProperties{
+			API: &web.ExpandedParentAPIEntity{
+				ID: utils.String(managedApiId),
+			},
+			ParameterValues: d.Get("parameter_values").(map[string]interface{}),
+		},
+		Tags: expandTags(tags),
+	}
+
+	if v, ok := d.GetOk("display_name"); ok {
+		connection.ConnectionProperties.DisplayName = utils.String(v.(string))
+	}
+
+	if _, err := client.CreateOrUpdate(ctx, resourceGroup, name, connection); err != nil {
+		return fmt.Errorf("Error creating/updating API Connection %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	read, err := client.Get(ctx, resourceGroup, name)
+	if err != nil {
+		return fmt.Errorf("Error retrieving API Connection %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+	if read.ID == nil {
+		return fmt.Errorf("Cannot read API Connection %q (Resource Group %q) ID", name, resourceGroup)
+	}
+
+	d.SetId(*read.ID)
+
+	return resourceArmApiConnectionRead(d, meta)
+}
+
+func resourceArmApiConnectionRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).apiConnectionsClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	name := id.Path["connections"]
+
+	resp, err := client.Get(ctx, resourceGroup, name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] API Connection %q (Resource Group %q) was not found - removing from state", name, resourceGroup)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error retrieving API Connection %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	d.Set("name", resp.Name)
+	d.Set("resource_group_name", resourceGroup)
+
+	if props := resp.ConnectionProperties; props != nil {
+		d.Set("display_name", props.DisplayName)
+
+		if api := props.API; api != nil {
+			d.Set("managed_api_id", api.ID)
+		}
+	}
+
+	flattenAndSetTags(d, resp.Tags)
+
+	return nil
+}
+
+func resourceArmApiConnectionDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).apiConnectionsClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	name := id.Path["connections"]
+
+	resp, err := client.Delete(ctx, resourceGroup, name)
+	if err != nil {
+		if !utils.ResponseWasNotFound(resp) {
+			return fmt.Errorf("Error deleting API Connection %q (Resource Group %q): %+v", name, resourceGroup, err)
+		}
+	}
+
+	return nil
+}
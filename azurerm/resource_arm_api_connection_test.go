@@ -0,0 +1,187 @@
+package azurerm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func TestAccAzureRMApiConnection_basic(t *testing.T) {
+	resourceName := "azurerm_api_connection.test"
+	ri := acctest.RandInt()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMApiConnectionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMApiConnection_basic(ri, testLocation()),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMApiConnectionExists(resourceName),
+					resource.TestCheckResourceAttrSet(resourceName, "managed_api_id"),
+					resource.TestCheckResourceAttrSet(resourceName, "display_name"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"parameter_values"},
+			},
+		},
+	})
+}
+
+func TestAccAzureRMApiConnection_complete(t *testing.T) {
+	resourceName := "azurerm_api_connection.test"
+	ri := acctest.RandInt()
+	location := testLocation()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMApiConnectionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMApiConnection_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMApiConnectionExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				Config: testAccAzureRMApiConnection_complete(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMApiConnectionExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "display_name", "Example Service Bus"),
+					resource.TestCheckResourceAttr(resourceName, "parameter_values.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.environment", "Production"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"parameter_values"},
+			},
+		},
+	})
+}
+
+func testCheckAzureRMApiConnectionExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %q", resourceName)
+		}
+
+		name := rs.Primary.Attributes["name"]
+		resourceGroup, hasResourceGroup := rs.Primary.Attributes["resource_group_name"]
+		if !hasResourceGroup {
+			return fmt.Errorf("Bad: no resource group found in state for API Connection: %q", name)
+		}
+
+		client := testAccProvider.Meta().(*ArmClient).apiConnectionsClient
+		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+		resp, err := client.Get(ctx, resourceGroup, name)
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return fmt.Errorf("Bad: API Connection %q (Resource Group %q) does not exist", name, resourceGroup)
+			}
+
+			return fmt.Errorf("Bad: Get on apiConnectionsClient: %+v", err)
+		}
+
+		return nil
+	}
+}
+
+func testCheckAzureRMApiConnectionDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*ArmClient).apiConnectionsClient
+	ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_api_connection" {
+			continue
+		}
+
+		name := rs.Primary.Attributes["name"]
+		resourceGroup := rs.Primary.Attributes["resource_group_name"]
+
+		resp, err := client.Get(ctx, resourceGroup, name)
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return nil
+			}
+
+			return err
+		}
+
+		return fmt.Errorf("API Connection still exists:\n%#v", resp.ConnectionProperties)
+	}
+
+	return nil
+}
+
+func testAccAzureRMApiConnection_template(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_servicebus_namespace" "test" {
+  name                = "acctestsbn-%d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  sku                 = "Basic"
+}
+
+data "azurerm_managed_api" "test" {
+  name     = "servicebus"
+  location = "${azurerm_resource_group.test.location}"
+}
+`, rInt, location, rInt)
+}
+
+func testAccAzureRMApiConnection_basic(rInt int, location string) string {
+	template := testAccAzureRMApiConnection_template(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_api_connection" "test" {
+  name                = "acctestconn-%d"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  managed_api_id      = "${data.azurerm_managed_api.test.id}"
+}
+`, template, rInt)
+}
+
+func testAccAzureRMApiConnection_complete(rInt int, location string) string {
+	template := testAccAzureRMApiConnection_template(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_api_connection" "test" {
+  name                = "acctestconn-%d"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  managed_api_id      = "${data.azurerm_managed_api.test.id}"
+  display_name        = "Example Service Bus"
+
+  parameter_values {
+    connectionString = "${azurerm_servicebus_namespace.test.default_primary_connection_string}"
+  }
+
+  tags {
+    environment = "Production"
+  }
+}
+`, template, rInt)
+}
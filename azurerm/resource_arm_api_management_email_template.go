@@ -0,0 +1,185 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/Azure/azure-sdk-for-go/services/preview/apimanagement/mgmt/2018-06-01-preview/apimanagement"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+// Email Templates always exist within an API Management Service - creating this resource customises the template
+// and deleting it resets the template to the default provided by API Management
+func resourceArmApiManagementEmailTemplate() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmApiManagementEmailTemplateCreateUpdate,
+		Read:   resourceArmApiManagementEmailTemplateRead,
+		Update: resourceArmApiManagementEmailTemplateCreateUpdate,
+		Delete: resourceArmApiManagementEmailTemplateDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"resource_group_name": resourceGroupNameSchema(),
+
+			"api_management_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.ApiManagementServiceName,
+			},
+
+			"template_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(apimanagement.AccountClosedDeveloper),
+					string(apimanagement.ApplicationApprovedNotificationMessage),
+					string(apimanagement.ConfirmSignUpIdentityDefault),
+					string(apimanagement.EmailChangeIdentityDefault),
+					string(apimanagement.InviteUserNotificationMessage),
+					string(apimanagement.NewCommentNotificationMessage),
+					string(apimanagement.NewDeveloperNotificationMessage),
+					string(apimanagement.NewIssueNotificationMessage),
+					string(apimanagement.PasswordResetByAdminNotificationMessage),
+					string(apimanagement.PasswordResetIdentityDefault),
+					string(apimanagement.PurchaseDeveloperNotificationMessage),
+					string(apimanagement.QuotaLimitApproachingDeveloperNotificationMessage),
+					string(apimanagement.RejectDeveloperNotificationMessage),
+					string(apimanagement.RequestDeveloperNotificationMessage),
+				}, false),
+			},
+
+			"subject": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+
+			"body": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+
+			"title": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceArmApiManagementEmailTemplateCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).apiManagementEmailTemplateClient
+	ctx := meta.(*ArmClient).StopContext
+
+	log.Printf("[INFO] preparing arguments for API Management Email Template creation/update.")
+
+	resourceGroup := d.Get("resource_group_name").(string)
+	serviceName := d.Get("api_management_name").(string)
+	templateName := apimanagement.TemplateName(d.Get("template_name").(string))
+
+	properties := apimanagement.EmailTemplateUpdateParameterProperties{
+		Subject: utils.String(d.Get("subject").(string)),
+		Body:    utils.String(d.Get("body").(string)),
+	}
+	if v, ok := d.GetOk("title"); ok {
+		properties.Title = utils.String(v.(string))
+	}
+	if v, ok := d.GetOk("description"); ok {
+		properties.Description = utils.String(v.(string))
+	}
+
+	parameters := apimanagement.EmailTemplateUpdateParameters{
+		EmailTemplateUpdateParameterProperties: &properties,
+	}
+
+	if _, err := client.CreateOrUpdate(ctx, resourceGroup, serviceName, templateName, parameters, ""); err != nil {
+		return fmt.Errorf("Error creating/updating Email Template %q (API Management Service %q / Resource Group %q): %+v", templateName, serviceName, resourceGroup, err)
+	}
+
+	read, err := client.Get(ctx, resourceGroup, serviceName, templateName)
+	if err != nil {
+		return fmt.Errorf("Error retrieving Email Template %q (API Management Service %q / Resource Group %q): %+v", templateName, serviceName, resourceGroup, err)
+	}
+	if read.ID == nil {
+		return fmt.Errorf("Cannot read Email Template %q (API Management Service %q / Resource Group %q) ID", templateName, serviceName, resourceGroup)
+	}
+
+	d.SetId(*read.ID)
+
+	return resourceArmApiManagementEmailTemplateRead(d, meta)
+}
+
+func resourceArmApiManagementEmailTemplateRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).apiManagementEmailTemplateClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	serviceName := id.Path["service"]
+	templateName := apimanagement.TemplateName(id.Path["templates"])
+
+	resp, err := client.Get(ctx, resourceGroup, serviceName, templateName)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] Email Template %q (API Management Service %q / Resource Group %q) was not found - removing from state", templateName, serviceName, resourceGroup)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error retrieving Email Template %q (API Management Service %q / Resource Group %q): %+v", templateName, serviceName, resourceGroup, err)
+	}
+
+	d.Set("resource_group_name", resourceGroup)
+	d.Set("api_management_name", serviceName)
+	d.Set("template_name", string(templateName))
+
+	if props := resp.EmailTemplateContractProperties; props != nil {
+		d.Set("subject", props.Subject)
+		d.Set("body", props.Body)
+		d.Set("title", props.Title)
+		d.Set("description", props.Description)
+	}
+
+	return nil
+}
+
+func resourceArmApiManagementEmailTemplateDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).apiManagementEmailTemplateClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	serviceName := id.Path["service"]
+	templateName := apimanagement.TemplateName(id.Path["templates"])
+
+	log.Printf("[DEBUG] Resetting Email Template %q (API Management Service %q / Resource Group %q) to the default", templateName, serviceName, resourceGroup)
+
+	resp, err := client.Delete(ctx, resourceGroup, serviceName, templateName, "*")
+	if err != nil {
+		if !utils.ResponseWasNotFound(resp) {
+			return fmt.Errorf("Error resetting Email Template %q (API Management Service %q / Resource Group %q): %+v", templateName, serviceName, resourceGroup, err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,164 @@
+package azurerm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/services/preview/apimanagement/mgmt/2018-06-01-preview/apimanagement"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func TestAccAzureRMApiManagementEmailTemplate_basic(t *testing.T) {
+	resourceName := "azurerm_api_management_email_template.test"
+	ri := acctest.RandInt()
+	config := testAccAzureRMApiManagementEmailTemplate_basic(ri, testLocation())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMApiManagementEmailTemplateDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMApiManagementEmailTemplateExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "template_name", "accountClosedDeveloper"),
+					resource.TestCheckResourceAttr(resourceName, "subject", "Your account has been closed"),
+					resource.TestCheckResourceAttrSet(resourceName, "title"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAzureRMApiManagementEmailTemplate_update(t *testing.T) {
+	resourceName := "azurerm_api_management_email_template.test"
+	ri := acctest.RandInt()
+	location := testLocation()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMApiManagementEmailTemplateDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMApiManagementEmailTemplate_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMApiManagementEmailTemplateExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "subject", "Your account has been closed"),
+				),
+			},
+			{
+				Config: testAccAzureRMApiManagementEmailTemplate_complete(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMApiManagementEmailTemplateExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "subject", "Goodbye from $OrganizationName"),
+					resource.TestCheckResourceAttr(resourceName, "title", "Account Closed"),
+					resource.TestCheckResourceAttr(resourceName, "description", "Sent when a Developer closes their account"),
+				),
+			},
+		},
+	})
+}
+
+func testCheckAzureRMApiManagementEmailTemplateDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*ArmClient).apiManagementEmailTemplateClient
+	ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_api_management_email_template" {
+			continue
+		}
+
+		resourceGroup := rs.Primary.Attributes["resource_group_name"]
+		serviceName := rs.Primary.Attributes["api_management_name"]
+		templateName := apimanagement.TemplateName(rs.Primary.Attributes["template_name"])
+
+		resp, err := client.Get(ctx, resourceGroup, serviceName, templateName)
+		if err != nil {
+			// the API Management Service is removed alongside the Resource Group
+			if utils.ResponseWasNotFound(resp.Response) {
+				return nil
+			}
+
+			return err
+		}
+
+		if props := resp.EmailTemplateContractProperties; props != nil && props.IsDefault != nil && !*props.IsDefault {
+			return fmt.Errorf("Email Template %q (API Management Service %q / Resource Group %q) still has a custom value", templateName, serviceName, resourceGroup)
+		}
+	}
+
+	return nil
+}
+
+func testCheckAzureRMApiManagementEmailTemplateExists(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		// Ensure we have enough information in state to look up in API
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+
+		resourceGroup := rs.Primary.Attributes["resource_group_name"]
+		serviceName := rs.Primary.Attributes["api_management_name"]
+		templateName := apimanagement.TemplateName(rs.Primary.Attributes["template_name"])
+
+		client := testAccProvider.Meta().(*ArmClient).apiManagementEmailTemplateClient
+		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+		resp, err := client.Get(ctx, resourceGroup, serviceName, templateName)
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return fmt.Errorf("Bad: Email Template %q (API Management Service %q / Resource Group %q) does not exist", templateName, serviceName, resourceGroup)
+			}
+
+			return fmt.Errorf("Bad: Get on apiManagementEmailTemplateClient: %+v", err)
+		}
+
+		if props := resp.EmailTemplateContractProperties; props != nil && props.IsDefault != nil && *props.IsDefault {
+			return fmt.Errorf("Bad: Email Template %q (API Management Service %q / Resource Group %q) is still using the default value", templateName, serviceName, resourceGroup)
+		}
+
+		return nil
+	}
+}
+
+func testAccAzureRMApiManagementEmailTemplate_basic(rInt int, location string) string {
+	template := testAccAzureRMApiManagement_basic(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_api_management_email_template" "test" {
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  api_management_name = "${azurerm_api_management.test.name}"
+  template_name       = "accountClosedDeveloper"
+  subject             = "Your account has been closed"
+  body                = "<!DOCTYPE html><html><body><p>Dear $DevFirstName $DevLastName,</p><p>Your account has been closed.</p></body></html>"
+}
+`, template)
+}
+
+func testAccAzureRMApiManagementEmailTemplate_complete(rInt int, location string) string {
+	template := testAccAzureRMApiManagement_basic(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_api_management_email_template" "test" {
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  api_management_name = "${azurerm_api_management.test.name}"
+  template_name       = "accountClosedDeveloper"
+  subject             = "Goodbye from $OrganizationName"
+  body                = "<!DOCTYPE html><html><body><p>Dear $DevFirstName $DevLastName,</p><p>We're sorry to see you go.</p></body></html>"
+  title               = "Account Closed"
+  description         = "Sent when a Developer closes their account"
+}
+`, template)
+}
@@ -0,0 +1,139 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/Azure/azure-sdk-for-go/services/preview/apimanagement/mgmt/2018-06-01-preview/apimanagement"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmApiManagementNotificationRecipientEmail() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmApiManagementNotificationRecipientEmailCreate,
+		Read:   resourceArmApiManagementNotificationRecipientEmailRead,
+		Delete: resourceArmApiManagementNotificationRecipientEmailDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"resource_group_name": resourceGroupNameSchema(),
+
+			"api_management_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.ApiManagementServiceName,
+			},
+
+			"notification_type": apiManagementNotificationTypeSchema(),
+
+			"email": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+		},
+	}
+}
+
+func resourceArmApiManagementNotificationRecipientEmailCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).apiManagementNotificationRecipientEmailClient
+	ctx := meta.(*ArmClient).StopContext
+
+	log.Printf("[INFO] preparing arguments for API Management Notification Recipient Email creation.")
+
+	resourceGroup := d.Get("resource_group_name").(string)
+	serviceName := d.Get("api_management_name").(string)
+	notificationName := apimanagement.NotificationName(d.Get("notification_type").(string))
+	email := d.Get("email").(string)
+
+	resp, err := client.CreateOrUpdate(ctx, resourceGroup, serviceName, notificationName, email)
+	if err != nil {
+		return fmt.Errorf("Error creating Recipient Email %q (Notification %q / API Management Service %q / Resource Group %q): %+v", email, notificationName, serviceName, resourceGroup, err)
+	}
+	if resp.ID == nil {
+		return fmt.Errorf("Cannot read Recipient Email %q (Notification %q / API Management Service %q / Resource Group %q) ID", email, notificationName, serviceName, resourceGroup)
+	}
+
+	d.SetId(*resp.ID)
+
+	return resourceArmApiManagementNotificationRecipientEmailRead(d, meta)
+}
+
+func resourceArmApiManagementNotificationRecipientEmailRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).apiManagementNotificationRecipientEmailClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	serviceName := id.Path["service"]
+	notificationName := apimanagement.NotificationName(id.Path["notifications"])
+	email := id.Path["recipientEmails"]
+
+	// there's no Get for a single Recipient, however CheckEntityExists returns a 404 rather than an error
+	resp, err := client.CheckEntityExists(ctx, resourceGroup, serviceName, notificationName, email)
+	if err != nil {
+		return fmt.Errorf("Error retrieving Recipient Email %q (Notification %q / API Management Service %q / Resource Group %q): %+v", email, notificationName, serviceName, resourceGroup, err)
+	}
+	if utils.ResponseWasNotFound(resp) {
+		log.Printf("[DEBUG] Recipient Email %q (Notification %q / API Management Service %q / Resource Group %q) was not found - removing from state", email, notificationName, serviceName, resourceGroup)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("resource_group_name", resourceGroup)
+	d.Set("api_management_name", serviceName)
+	d.Set("notification_type", string(notificationName))
+	d.Set("email", email)
+
+	return nil
+}
+
+func resourceArmApiManagementNotificationRecipientEmailDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).apiManagementNotificationRecipientEmailClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	serviceName := id.Path["service"]
+	notificationName := apimanagement.NotificationName(id.Path["notifications"])
+	email := id.Path["recipientEmails"]
+
+	resp, err := client.Delete(ctx, resourceGroup, serviceName, notificationName, email)
+	if err != nil {
+		if !utils.ResponseWasNotFound(resp) {
+			return fmt.Errorf("Error deleting Recipient Email %q (Notification %q / API Management Service %q / Resource Group %q): %+v", email, notificationName, serviceName, resourceGroup, err)
+		}
+	}
+
+	return nil
+}
+
+func apiManagementNotificationTypeSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeString,
+		Required: true,
+		ForceNew: true,
+		ValidateFunc: validation.StringInSlice([]string{
+			string(apimanagement.AccountClosedPublisher),
+			string(apimanagement.BCC),
+			string(apimanagement.NewApplicationNotificationMessage),
+			string(apimanagement.NewIssuePublisherNotificationMessage),
+			string(apimanagement.PurchasePublisherNotificationMessage),
+			string(apimanagement.QuotaLimitApproachingPublisherNotificationMessage),
+			string(apimanagement.RequestPublisherNotificationMessage),
+		}, false),
+	}
+}
@@ -0,0 +1,108 @@
+package azurerm
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/services/preview/apimanagement/mgmt/2018-06-01-preview/apimanagement"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAzureRMApiManagementNotificationRecipientEmail_basic(t *testing.T) {
+	resourceName := "azurerm_api_management_notification_recipient_email.test"
+	ri := acctest.RandInt()
+	config := testAccAzureRMApiManagementNotificationRecipientEmail_basic(ri, testLocation())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMApiManagementNotificationRecipientEmailDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMApiManagementNotificationRecipientEmailExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "notification_type", "NewIssuePublisherNotificationMessage"),
+					resource.TestCheckResourceAttr(resourceName, "email", "foo@bar.com"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testCheckAzureRMApiManagementNotificationRecipientEmailDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*ArmClient).apiManagementNotificationRecipientEmailClient
+	ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_api_management_notification_recipient_email" {
+			continue
+		}
+
+		resourceGroup := rs.Primary.Attributes["resource_group_name"]
+		serviceName := rs.Primary.Attributes["api_management_name"]
+		notificationName := apimanagement.NotificationName(rs.Primary.Attributes["notification_type"])
+		email := rs.Primary.Attributes["email"]
+
+		resp, err := client.CheckEntityExists(ctx, resourceGroup, serviceName, notificationName, email)
+		if err != nil {
+			return err
+		}
+
+		if resp.StatusCode != http.StatusNotFound {
+			return fmt.Errorf("Recipient Email %q (Notification %q / API Management Service %q / Resource Group %q) still exists", email, notificationName, serviceName, resourceGroup)
+		}
+	}
+
+	return nil
+}
+
+func testCheckAzureRMApiManagementNotificationRecipientEmailExists(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		// Ensure we have enough information in state to look up in API
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+
+		resourceGroup := rs.Primary.Attributes["resource_group_name"]
+		serviceName := rs.Primary.Attributes["api_management_name"]
+		notificationName := apimanagement.NotificationName(rs.Primary.Attributes["notification_type"])
+		email := rs.Primary.Attributes["email"]
+
+		client := testAccProvider.Meta().(*ArmClient).apiManagementNotificationRecipientEmailClient
+		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+		resp, err := client.CheckEntityExists(ctx, resourceGroup, serviceName, notificationName, email)
+		if err != nil {
+			return fmt.Errorf("Bad: CheckEntityExists on apiManagementNotificationRecipientEmailClient: %+v", err)
+		}
+
+		if resp.StatusCode == http.StatusNotFound {
+			return fmt.Errorf("Bad: Recipient Email %q (Notification %q / API Management Service %q / Resource Group %q) does not exist", email, notificationName, serviceName, resourceGroup)
+		}
+
+		return nil
+	}
+}
+
+func testAccAzureRMApiManagementNotificationRecipientEmail_basic(rInt int, location string) string {
+	template := testAccAzureRMApiManagement_basic(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_api_management_notification_recipient_email" "test" {
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  api_management_name = "${azurerm_api_management.test.name}"
+  notification_type   = "NewIssuePublisherNotificationMessage"
+  email               = "foo@bar.com"
+}
+`, template)
+}
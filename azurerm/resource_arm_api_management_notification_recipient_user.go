@@ -0,0 +1,122 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/Azure/azure-sdk-for-go/services/preview/apimanagement/mgmt/2018-06-01-preview/apimanagement"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmApiManagementNotificationRecipientUser() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmApiManagementNotificationRecipientUserCreate,
+		Read:   resourceArmApiManagementNotificationRecipientUserRead,
+		Delete: resourceArmApiManagementNotificationRecipientUserDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"resource_group_name": resourceGroupNameSchema(),
+
+			"api_management_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.ApiManagementServiceName,
+			},
+
+			"notification_type": apiManagementNotificationTypeSchema(),
+
+			"user_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+		},
+	}
+}
+
+func resourceArmApiManagementNotificationRecipientUserCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).apiManagementNotificationRecipientUserClient
+	ctx := meta.(*ArmClient).StopContext
+
+	log.Printf("[INFO] preparing arguments for API Management Notification Recipient User creation.")
+
+	resourceGroup := d.Get("resource_group_name").(string)
+	serviceName := d.Get("api_management_name").(string)
+	notificationName := apimanagement.NotificationName(d.Get("notification_type").(string))
+	userId := d.Get("user_id").(string)
+
+	resp, err := client.CreateOrUpdate(ctx, resourceGroup, serviceName, notificationName, userId)
+	if err != nil {
+		return fmt.Errorf("Error creating Recipient User %q (Notification %q / API Management Service %q / Resource Group %q): %+v", userId, notificationName, serviceName, resourceGroup, err)
+	}
+	if resp.ID == nil {
+		return fmt.Errorf("Cannot read Recipient User %q (Notification %q / API Management Service %q / Resource Group %q) ID", userId, notificationName, serviceName, resourceGroup)
+	}
+
+	d.SetId(*resp.ID)
+
+	return resourceArmApiManagementNotificationRecipientUserRead(d, meta)
+}
+
+func resourceArmApiManagementNotificationRecipientUserRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).apiManagementNotificationRecipientUserClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	serviceName := id.Path["service"]
+	notificationName := apimanagement.NotificationName(id.Path["notifications"])
+	userId := id.Path["recipientUsers"]
+
+	// there's no Get for a single Recipient, however CheckEntityExists returns a 404 rather than an error
+	resp, err := client.CheckEntityExists(ctx, resourceGroup, serviceName, notificationName, userId)
+	if err != nil {
+		return fmt.Errorf("Error retrieving Recipient User %q (Notification %q / API Management Service %q / Resource Group %q): %+v", userId, notificationName, serviceName, resourceGroup, err)
+	}
+	if utils.ResponseWasNotFound(resp) {
+		log.Printf("[DEBUG] Recipient User %q (Notification %q / API Management Service %q / Resource Group %q) was not found - removing from state", userId, notificationName, serviceName, resourceGroup)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("resource_group_name", resourceGroup)
+	d.Set("api_management_name", serviceName)
+	d.Set("notification_type", string(notificationName))
+	d.Set("user_id", userId)
+
+	return nil
+}
+
+func resourceArmApiManagementNotificationRecipientUserDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).apiManagementNotificationRecipientUserClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	serviceName := id.Path["service"]
+	notificationName := apimanagement.NotificationName(id.Path["notifications"])
+	userId := id.Path["recipientUsers"]
+
+	resp, err := client.Delete(ctx, resourceGroup, serviceName, notificationName, userId)
+	if err != nil {
+		if !utils.ResponseWasNotFound(resp) {
+			return fmt.Errorf("Error deleting Recipient User %q (Notification %q / API Management Service %q / Resource Group %q): %+v", userId, notificationName, serviceName, resourceGroup, err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,108 @@
+package azurerm
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/services/preview/apimanagement/mgmt/2018-06-01-preview/apimanagement"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAzureRMApiManagementNotificationRecipientUser_basic(t *testing.T) {
+	resourceName := "azurerm_api_management_notification_recipient_user.test"
+	ri := acctest.RandInt()
+	config := testAccAzureRMApiManagementNotificationRecipientUser_basic(ri, testLocation())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMApiManagementNotificationRecipientUserDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMApiManagementNotificationRecipientUserExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "notification_type", "NewIssuePublisherNotificationMessage"),
+					resource.TestCheckResourceAttr(resourceName, "user_id", "1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testCheckAzureRMApiManagementNotificationRecipientUserDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*ArmClient).apiManagementNotificationRecipientUserClient
+	ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_api_management_notification_recipient_user" {
+			continue
+		}
+
+		resourceGroup := rs.Primary.Attributes["resource_group_name"]
+		serviceName := rs.Primary.Attributes["api_management_name"]
+		notificationName := apimanagement.NotificationName(rs.Primary.Attributes["notification_type"])
+		userId := rs.Primary.Attributes["user_id"]
+
+		resp, err := client.CheckEntityExists(ctx, resourceGroup, serviceName, notificationName, userId)
+		if err != nil {
+			return err
+		}
+
+		if resp.StatusCode != http.StatusNotFound {
+			return fmt.Errorf("Recipient User %q (Notification %q / API Management Service %q / Resource Group %q) still exists", userId, notificationName, serviceName, resourceGroup)
+		}
+	}
+
+	return nil
+}
+
+func testCheckAzureRMApiManagementNotificationRecipientUserExists(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		// Ensure we have enough information in state to look up in API
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+
+		resourceGroup := rs.Primary.Attributes["resource_group_name"]
+		serviceName := rs.Primary.Attributes["api_management_name"]
+		notificationName := apimanagement.NotificationName(rs.Primary.Attributes["notification_type"])
+		userId := rs.Primary.Attributes["user_id"]
+
+		client := testAccProvider.Meta().(*ArmClient).apiManagementNotificationRecipientUserClient
+		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+		resp, err := client.CheckEntityExists(ctx, resourceGroup, serviceName, notificationName, userId)
+		if err != nil {
+			return fmt.Errorf("Bad: CheckEntityExists on apiManagementNotificationRecipientUserClient: %+v", err)
+		}
+
+		if resp.StatusCode == http.StatusNotFound {
+			return fmt.Errorf("Bad: Recipient User %q (Notification %q / API Management Service %q / Resource Group %q) does not exist", userId, notificationName, serviceName, resourceGroup)
+		}
+
+		return nil
+	}
+}
+
+func testAccAzureRMApiManagementNotificationRecipientUser_basic(rInt int, location string) string {
+	template := testAccAzureRMApiManagement_basic(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_api_management_notification_recipient_user" "test" {
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  api_management_name = "${azurerm_api_management.test.name}"
+  notification_type   = "NewIssuePublisherNotificationMessage"
+  user_id             = "1"
+}
+`, template)
+}
@@ -4,11 +4,15 @@ import (
 	"fmt"
 	"log"
 	"regexp"
+	"time"
 
 	"github.com/Azure/azure-sdk-for-go/services/web/mgmt/2018-02-01/web"
+	"github.com/Azure/go-autorest/autorest/date"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/helper/validation"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/suppress"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
@@ -22,6 +26,8 @@ func resourceArmAppService() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		CustomizeDiff: appServiceSiteConfigCustomizeDiff,
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:         schema.TypeString,
@@ -69,6 +75,80 @@ func resourceArmAppService() *schema.Resource {
 
 			"site_config": azure.SchemaAppServiceSiteConfig(),
 
+			"backup": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.NoZeroValues,
+						},
+
+						"enabled": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  true,
+						},
+
+						// this is a SAS URL to the Storage Container, which contains a credential
+						"storage_account_url": {
+							Type:         schema.TypeString,
+							Required:     true,
+							Sensitive:    true,
+							ValidateFunc: validation.NoZeroValues,
+						},
+
+						"schedule": {
+							Type:     schema.TypeList,
+							Required: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"frequency_interval": {
+										Type:         schema.TypeInt,
+										Required:     true,
+										ValidateFunc: validation.IntBetween(1, 1000),
+									},
+
+									"frequency_unit": {
+										Type:     schema.TypeString,
+										Required: true,
+										ValidateFunc: validation.StringInSlice([]string{
+											string(web.Day),
+											string(web.Hour),
+										}, false),
+									},
+
+									"keep_at_least_one_backup": {
+										Type:     schema.TypeBool,
+										Optional: true,
+										Default:  false,
+									},
+
+									"retention_period_in_days": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										Default:      30,
+										ValidateFunc: validation.IntBetween(0, 9999999),
+									},
+
+									"start_time": {
+										Type:             schema.TypeString,
+										Optional:         true,
+										Computed:         true,
+										ValidateFunc:     validate.RFC3339Time,
+										DiffSuppressFunc: suppress.RFC3339Time,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+
 			"client_affinity_enabled": {
 				Type:     schema.TypeBool,
 				Optional: true,
@@ -306,6 +386,24 @@ func resourceArmAppServiceUpdate(d *schema.ResourceData, meta interface{}) error
 		}
 	}
 
+	if d.HasChange("backup") {
+		backup, err := expandAppServiceBackup(d.Get("backup").([]interface{}))
+		if err != nil {
+			return fmt.Errorf("Error expanding `backup` for App Service %q: %+v", name, err)
+		}
+
+		if backup == nil {
+			resp, err := client.DeleteBackupConfiguration(ctx, resGroup, name)
+			if err != nil && !utils.ResponseWasNotFound(resp) {
+				return fmt.Errorf("Error removing Backup Configuration for App Service %q: %+v", name, err)
+			}
+		} else {
+			if _, err := client.UpdateBackupConfiguration(ctx, resGroup, name, *backup); err != nil {
+				return fmt.Errorf("Error updating Backup Configuration for App Service %q: %+v", name, err)
+			}
+		}
+	}
+
 	if d.HasChange("client_affinity_enabled") {
 
 		affinity := d.Get("client_affinity_enabled").(bool)
@@ -411,6 +509,11 @@ func resourceArmAppServiceRead(d *schema.ResourceData, meta interface{}) error {
 		return fmt.Errorf("Error making Read request on AzureRM App Service ConnectionStrings %q: %+v", name, err)
 	}
 
+	backupResp, err := client.GetBackupConfiguration(ctx, resGroup, name)
+	if err != nil && !utils.ResponseWasNotFound(backupResp.Response) {
+		return fmt.Errorf("Error making Read request on AzureRM App Service Backup Configuration %q: %+v", name, err)
+	}
+
 	scmResp, err := client.GetSourceControl(ctx, resGroup, name)
 	if err != nil {
 		return fmt.Errorf("Error making Read request on AzureRM App Service Source Control %q: %+v", name, err)
@@ -456,6 +559,11 @@ func resourceArmAppServiceRead(d *schema.ResourceData, meta interface{}) error {
 		return err
 	}
 
+	backup := flattenAppServiceBackup(backupResp.BackupRequestProperties)
+	if err := d.Set("backup", backup); err != nil {
+		return err
+	}
+
 	scm := flattenAppServiceSourceControl(scmResp.SiteSourceControlProperties)
 	if err := d.Set("source_control", scm); err != nil {
 		return err
@@ -501,6 +609,82 @@ func resourceArmAppServiceDelete(d *schema.ResourceData, meta interface{}) error
 	return nil
 }
 
+func expandAppServiceBackup(input []interface{}) (*web.BackupRequest, error) {
+	if len(input) == 0 || input[0] == nil {
+		return nil, nil
+	}
+
+	backup := input[0].(map[string]interface{})
+	schedules := backup["schedule"].([]interface{})
+	schedule := schedules[0].(map[string]interface{})
+
+	backupSchedule := web.BackupSchedule{
+		FrequencyInterval:     utils.Int32(int32(schedule["frequency_interval"].(int))),
+		FrequencyUnit:         web.FrequencyUnit(schedule["frequency_unit"].(string)),
+		KeepAtLeastOneBackup:  utils.Bool(schedule["keep_at_least_one_backup"].(bool)),
+		RetentionPeriodInDays: utils.Int32(int32(schedule["retention_period_in_days"].(int))),
+	}
+
+	if v := schedule["start_time"].(string); v != "" {
+		startTime, err := time.Parse(time.RFC3339, v)
+		if err != nil {
+			return nil, fmt.Errorf("Error parsing `start_time` %q: %+v", v, err)
+		}
+		backupSchedule.StartTime = &date.Time{Time: startTime}
+	}
+
+	return &web.BackupRequest{
+		BackupRequestProperties: &web.BackupRequestProperties{
+			BackupName:        utils.String(backup["name"].(string)),
+			Enabled:           utils.Bool(backup["enabled"].(bool)),
+			StorageAccountURL: utils.String(backup["storage_account_url"].(string)),
+			BackupSchedule:    &backupSchedule,
+		},
+	}, nil
+}
+
+func flattenAppServiceBackup(input *web.BackupRequestProperties) []interface{} {
+	results := make([]interface{}, 0)
+	if input == nil {
+		return results
+	}
+
+	result := make(map[string]interface{})
+	if input.BackupName != nil {
+		result["name"] = *input.BackupName
+	}
+	if input.Enabled != nil {
+		result["enabled"] = *input.Enabled
+	}
+	if input.StorageAccountURL != nil {
+		result["storage_account_url"] = *input.StorageAccountURL
+	}
+
+	schedules := make([]interface{}, 0)
+	if v := input.BackupSchedule; v != nil {
+		schedule := make(map[string]interface{})
+
+		if v.FrequencyInterval != nil {
+			schedule["frequency_interval"] = int(*v.FrequencyInterval)
+		}
+		schedule["frequency_unit"] = string(v.FrequencyUnit)
+		if v.KeepAtLeastOneBackup != nil {
+			schedule["keep_at_least_one_backup"] = *v.KeepAtLeastOneBackup
+		}
+		if v.RetentionPeriodInDays != nil {
+			schedule["retention_period_in_days"] = int(*v.RetentionPeriodInDays)
+		}
+		if v.StartTime != nil {
+			schedule["start_time"] = v.StartTime.Format(time.RFC3339)
+		}
+
+		schedules = append(schedules, schedule)
+	}
+	result["schedule"] = schedules
+
+	return append(results, result)
+}
+
 func flattenAppServiceSourceControl(input *web.SiteSourceControlProperties) []interface{} {
 	results := make([]interface{}, 0)
 	result := make(map[string]interface{})
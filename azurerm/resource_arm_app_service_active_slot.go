@@ -53,9 +53,9 @@ func resourceArmAppServiceActiveSlotCreate(d *schema.ResourceData, meta interfac
 		return fmt.Errorf("Error making Read request on AzureRM App Service %q: %+v", appServiceName, err)
 	}
 
-	_, err = client.Get(ctx, resGroup, targetSlot)
+	slotResp, err := client.GetSlot(ctx, resGroup, appServiceName, targetSlot)
 	if err != nil {
-		if utils.ResponseWasNotFound(resp.Response) {
+		if utils.ResponseWasNotFound(slotResp.Response) {
 			return fmt.Errorf("[DEBUG] App Service Target Active Slot %q/%q (resource group %q) was not found.", appServiceName, targetSlot, resGroup)
 		}
 		return fmt.Errorf("Error making Read request on AzureRM App Service Slot %q/%q: %+v", appServiceName, targetSlot, err)
@@ -102,7 +102,14 @@ func resourceArmAppServiceActiveSlotRead(d *schema.ResourceData, meta interface{
 
 	d.Set("app_service_name", resp.Name)
 	d.Set("resource_group_name", resp.ResourceGroup)
-	d.Set("app_service_slot_name", resp.SiteProperties.SlotSwapStatus.SourceSlotName)
+
+	// the Slot which was most recently swapped into Production is the one which is currently live
+	if props := resp.SiteProperties; props != nil {
+		if status := props.SlotSwapStatus; status != nil && status.SourceSlotName != nil {
+			d.Set("app_service_slot_name", status.SourceSlotName)
+		}
+	}
+
 	return nil
 }
 
@@ -1,10 +1,11 @@
 package azurerm
 
 import (
+	"encoding/base64"
 	"fmt"
-	"testing"
-
+	"io/ioutil"
 	"os"
+	"testing"
 
 	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
@@ -19,6 +20,7 @@ func TestAccAzureRMAppServiceCustomHostnameBinding(t *testing.T) {
 		"basic": {
 			"basic":    testAccAzureRMAppServiceCustomHostnameBinding_basic,
 			"multiple": testAccAzureRMAppServiceCustomHostnameBinding_multiple,
+			"ssl":      testAccAzureRMAppServiceCustomHostnameBinding_ssl,
 		},
 	}
 
@@ -112,6 +114,66 @@ func testAccAzureRMAppServiceCustomHostnameBinding_multiple(t *testing.T) {
 	})
 }
 
+func testAccAzureRMAppServiceCustomHostnameBinding_ssl(t *testing.T) {
+	appServiceEnvVariable := "ARM_TEST_APP_SERVICE"
+	appServiceEnv := os.Getenv(appServiceEnvVariable)
+	if appServiceEnv == "" {
+		t.Skipf("Skipping as %q is not specified", appServiceEnvVariable)
+	}
+
+	domainEnvVariable := "ARM_TEST_DOMAIN"
+	domainEnv := os.Getenv(domainEnvVariable)
+	if domainEnv == "" {
+		t.Skipf("Skipping as %q is not specified", domainEnvVariable)
+	}
+
+	// a PFX Certificate valid for the domain above is required, along with its password and thumbprint
+	certificatePathEnvVariable := "ARM_TEST_CERTIFICATE_PATH"
+	certificatePathEnv := os.Getenv(certificatePathEnvVariable)
+	if certificatePathEnv == "" {
+		t.Skipf("Skipping as %q is not specified", certificatePathEnvVariable)
+	}
+
+	certificatePasswordEnv := os.Getenv("ARM_TEST_CERTIFICATE_PASSWORD")
+
+	certificateThumbprintEnvVariable := "ARM_TEST_CERTIFICATE_THUMBPRINT"
+	certificateThumbprintEnv := os.Getenv(certificateThumbprintEnvVariable)
+	if certificateThumbprintEnv == "" {
+		t.Skipf("Skipping as %q is not specified", certificateThumbprintEnvVariable)
+	}
+
+	certificate, err := ioutil.ReadFile(certificatePathEnv)
+	if err != nil {
+		t.Fatalf("Error reading Certificate %q: %+v", certificatePathEnv, err)
+	}
+
+	resourceName := "azurerm_app_service_custom_hostname_binding.test"
+	ri := acctest.RandInt()
+	location := testLocation()
+	config := testAccAzureRMAppServiceCustomHostnameBinding_sslConfig(ri, location, appServiceEnv, domainEnv, base64.StdEncoding.EncodeToString(certificate), certificatePasswordEnv, certificateThumbprintEnv)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMAppServiceCustomHostnameBindingDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMAppServiceCustomHostnameBindingExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "ssl_state", "SniEnabled"),
+					resource.TestCheckResourceAttr(resourceName, "thumbprint", certificateThumbprintEnv),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
 func testCheckAzureRMAppServiceCustomHostnameBindingDestroy(s *terraform.State) error {
 	client := testAccProvider.Meta().(*ArmClient).appServicesClient
 
@@ -212,3 +274,56 @@ resource "azurerm_app_service_custom_hostname_binding" "test2" {
 }
 `, template, altDomain)
 }
+
+func testAccAzureRMAppServiceCustomHostnameBinding_sslConfig(rInt int, location, appServiceName, domain, certificate, password, thumbprint string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_app_service_plan" "test" {
+  name                = "acctestASP-%[1]d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+
+  sku {
+    tier = "Standard"
+    size = "S1"
+  }
+}
+
+resource "azurerm_app_service" "test" {
+  name                = "%[3]s"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  app_service_plan_id = "${azurerm_app_service_plan.test.id}"
+}
+
+resource "azurerm_generic_resource" "certificate" {
+  name      = "acctestcert-%[1]d"
+  parent_id = "${azurerm_resource_group.test.id}"
+  type      = "Microsoft.Web/certificates@2018-02-01"
+  location  = "${azurerm_resource_group.test.location}"
+
+  body = <<BODY
+{
+  "properties": {
+    "pfxBlob": "%[5]s",
+    "password": "%[6]s",
+    "serverFarmId": "${azurerm_app_service_plan.test.id}"
+  }
+}
+BODY
+}
+
+resource "azurerm_app_service_custom_hostname_binding" "test" {
+  hostname            = "%[4]s"
+  app_service_name    = "${azurerm_app_service.test.name}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  ssl_state           = "SniEnabled"
+  thumbprint          = "%[7]s"
+  depends_on          = ["azurerm_generic_resource.certificate"]
+}
+`, rInt, location, appServiceName, domain, certificate, password, thumbprint)
+}
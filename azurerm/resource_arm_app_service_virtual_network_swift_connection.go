@@ -0,0 +1,148 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/Azure/azure-sdk-for-go/services/web/mgmt/2018-02-01/web"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmAppServiceVirtualNetworkSwiftConnection() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmAppServiceVirtualNetworkSwiftConnectionCreateUpdate,
+		Read:   resourceArmAppServiceVirtualNetworkSwiftConnectionRead,
+		Update: resourceArmAppServiceVirtualNetworkSwiftConnectionCreateUpdate,
+		Delete: resourceArmAppServiceVirtualNetworkSwiftConnectionDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"app_service_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: azure.ValidateResourceID,
+			},
+
+			"subnet_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: azure.ValidateResourceID,
+			},
+		},
+	}
+}
+
+func resourceArmAppServiceVirtualNetworkSwiftConnectionCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).appServicesClient
+	ctx := meta.(*ArmClient).StopContext
+
+	log.Printf("[INFO] preparing arguments for App Service Virtual Network Swift Connection creation/update.")
+
+	appServiceId := d.Get("app_service_id").(string)
+	id, err := parseAzureResourceID(appServiceId)
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	name := id.Path["sites"]
+
+	appService, err := client.Get(ctx, resourceGroup, name)
+	if err != nil {
+		if utils.ResponseWasNotFound(appService.Response) {
+			return fmt.Errorf("App Service %q (Resource Group %q) was not found", name, resourceGroup)
+		}
+		return fmt.Errorf("Error retrieving App Service %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	connectionEnvelope := web.SwiftVirtualNetwork{
+		SwiftVirtualNetworkProperties: &web.SwiftVirtualNetworkProperties{
+			SubnetResourceID: utils.String(d.Get("subnet_id").(string)),
+		},
+	}
+	if _, err := client.CreateOrUpdateSwiftVirtualNetworkConnection(ctx, resourceGroup, name, connectionEnvelope); err != nil {
+		return fmt.Errorf("Error creating/updating Virtual Network Swift Connection for App Service %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	read, err := client.GetSwiftVirtualNetworkConnection(ctx, resourceGroup, name)
+	if err != nil {
+		return fmt.Errorf("Error retrieving Virtual Network Swift Connection for App Service %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+	if read.ID == nil {
+		return fmt.Errorf("Cannot read Virtual Network Swift Connection for App Service %q (Resource Group %q) ID", name, resourceGroup)
+	}
+
+	d.SetId(*read.ID)
+
+	return resourceArmAppServiceVirtualNetworkSwiftConnectionRead(d, meta)
+}
+
+func resourceArmAppServiceVirtualNetworkSwiftConnectionRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).appServicesClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	name := id.Path["sites"]
+
+	appService, err := client.Get(ctx, resourceGroup, name)
+	if err != nil {
+		if utils.ResponseWasNotFound(appService.Response) {
+			log.Printf("[DEBUG] App Service %q (Resource Group %q) was not found - removing from state", name, resourceGroup)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error retrieving App Service %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	resp, err := client.GetSwiftVirtualNetworkConnection(ctx, resourceGroup, name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] Virtual Network Swift Connection for App Service %q (Resource Group %q) was not found - removing from state", name, resourceGroup)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error retrieving Virtual Network Swift Connection for App Service %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	// the API returns an empty Swift Connection rather than a 404 once it's been removed
+	props := resp.SwiftVirtualNetworkProperties
+	if props == nil || props.SubnetResourceID == nil || *props.SubnetResourceID == "" {
+		log.Printf("[DEBUG] Virtual Network Swift Connection for App Service %q (Resource Group %q) was not found - removing from state", name, resourceGroup)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("app_service_id", appService.ID)
+	d.Set("subnet_id", props.SubnetResourceID)
+
+	return nil
+}
+
+func resourceArmAppServiceVirtualNetworkSwiftConnectionDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).appServicesClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	name := id.Path["sites"]
+
+	resp, err := client.DeleteSwiftVirtualNetwork(ctx, resourceGroup, name)
+	if err != nil {
+		if !utils.ResponseWasNotFound(resp) {
+			return fmt.Errorf("Error deleting Virtual Network Swift Connection for App Service %q (Resource Group %q): %+v", name, resourceGroup, err)
+		}
+	}
+
+	return nil
+}
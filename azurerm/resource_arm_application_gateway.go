@@ -6,7 +6,7 @@ import (
 	"log"
 	"strings"
 
-	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2018-04-01/network"
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2018-08-01/network"
 	"github.com/hashicorp/terraform/helper/hashcode"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/helper/validation"
@@ -43,6 +43,8 @@ func resourceArmApplicationGateway() *schema.Resource {
 				ForceNew: true,
 			},
 
+			"zones": zonesSchema(),
+
 			"sku": {
 				Type:     schema.TypeSet,
 				Required: true,
@@ -78,13 +80,28 @@ func resourceArmApplicationGateway() *schema.Resource {
 
 						"capacity": {
 							Type:         schema.TypeInt,
-							Required:     true,
+							Optional:     true,
 							ValidateFunc: validation.IntBetween(1, 10),
 						},
 					},
 				},
 			},
 
+			"autoscale_configuration": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"min_capacity": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntBetween(0, 100),
+						},
+					},
+				},
+			},
+
 			"disabled_ssl_protocols": {
 				Type:     schema.TypeList,
 				Optional: true,
@@ -729,6 +746,7 @@ func resourceArmApplicationGatewayCreateUpdate(d *schema.ResourceData, meta inte
 
 	properties := network.ApplicationGatewayPropertiesFormat{}
 	properties.Sku = expandApplicationGatewaySku(d)
+	properties.AutoscaleConfiguration = expandApplicationGatewayAutoscaleConfiguration(d)
 	properties.SslPolicy = expandApplicationGatewaySslPolicy(d)
 	properties.GatewayIPConfigurations = expandApplicationGatewayIPConfigurations(d)
 	properties.FrontendPorts = expandApplicationGatewayFrontendPorts(d)
@@ -746,10 +764,22 @@ func resourceArmApplicationGatewayCreateUpdate(d *schema.ResourceData, meta inte
 		properties.WebApplicationFirewallConfiguration = expandApplicationGatewayWafConfig(d)
 	}
 
+	if properties.Sku.Capacity == nil && properties.AutoscaleConfiguration == nil {
+		return fmt.Errorf("Either `capacity` within the `sku` block or an `autoscale_configuration` block must be specified for ApplicationGateway %q (Resource Group %q)", name, resGroup)
+	}
+
+	if properties.AutoscaleConfiguration != nil {
+		tier := properties.Sku.Tier
+		if !strings.EqualFold(string(tier), string(network.ApplicationGatewayTierStandardV2)) && !strings.EqualFold(string(tier), string(network.ApplicationGatewayTierWAFV2)) {
+			return fmt.Errorf("`autoscale_configuration` is only supported for the `Standard_v2` and `WAF_v2` tiers (ApplicationGateway %q / Resource Group %q)", name, resGroup)
+		}
+	}
+
 	gateway := network.ApplicationGateway{
 		Name:     utils.String(name),
 		Location: utils.String(location),
 		Tags:     expandTags(tags),
+		Zones:    expandZones(d.Get("zones").([]interface{})),
 		ApplicationGatewayPropertiesFormat: &properties,
 	}
 
@@ -798,7 +828,11 @@ func resourceArmApplicationGatewayRead(d *schema.ResourceData, meta interface{})
 		d.Set("location", azureRMNormalizeLocation(*location))
 	}
 
+	d.Set("zones", applicationGateway.Zones)
 	d.Set("sku", schema.NewSet(hashApplicationGatewaySku, flattenApplicationGatewaySku(applicationGateway.ApplicationGatewayPropertiesFormat.Sku)))
+	if err := d.Set("autoscale_configuration", flattenApplicationGatewayAutoscaleConfiguration(applicationGateway.ApplicationGatewayPropertiesFormat.AutoscaleConfiguration)); err != nil {
+		return fmt.Errorf("Error setting `autoscale_configuration`: %+v", err)
+	}
 	d.Set("disabled_ssl_protocols", flattenApplicationGatewaySslPolicy(applicationGateway.ApplicationGatewayPropertiesFormat.SslPolicy))
 	d.Set("gateway_ip_configuration", flattenApplicationGatewayIPConfigurations(applicationGateway.ApplicationGatewayPropertiesFormat.GatewayIPConfigurations))
 	d.Set("frontend_port", flattenApplicationGatewayFrontendPorts(applicationGateway.ApplicationGatewayPropertiesFormat.FrontendPorts))
@@ -906,12 +940,30 @@ func expandApplicationGatewaySku(d *schema.ResourceData) *network.ApplicationGat
 
 	name := sku["name"].(string)
 	tier := sku["tier"].(string)
-	capacity := int32(sku["capacity"].(int))
 
-	return &network.ApplicationGatewaySku{
-		Name:     network.ApplicationGatewaySkuName(name),
-		Tier:     network.ApplicationGatewayTier(tier),
-		Capacity: &capacity,
+	result := network.ApplicationGatewaySku{
+		Name: network.ApplicationGatewaySkuName(name),
+		Tier: network.ApplicationGatewayTier(tier),
+	}
+
+	// capacity is managed by Azure when autoscaling is enabled
+	if capacity := sku["capacity"].(int); capacity > 0 {
+		result.Capacity = utils.Int32(int32(capacity))
+	}
+
+	return &result
+}
+
+func expandApplicationGatewayAutoscaleConfiguration(d *schema.ResourceData) *network.ApplicationGatewayAutoscaleConfiguration {
+	configs := d.Get("autoscale_configuration").([]interface{})
+	if len(configs) == 0 || configs[0] == nil {
+		return nil
+	}
+
+	config := configs[0].(map[string]interface{})
+
+	return &network.ApplicationGatewayAutoscaleConfiguration{
+		MinCapacity: utils.Int32(int32(config["min_capacity"].(int))),
 	}
 }
 
@@ -1393,7 +1445,26 @@ func flattenApplicationGatewaySku(sku *network.ApplicationGatewaySku) []interfac
 
 	result["name"] = string(sku.Name)
 	result["tier"] = string(sku.Tier)
-	result["capacity"] = int(*sku.Capacity)
+
+	capacity := 0
+	if sku.Capacity != nil {
+		capacity = int(*sku.Capacity)
+	}
+	result["capacity"] = capacity
+
+	return []interface{}{result}
+}
+
+func flattenApplicationGatewayAutoscaleConfiguration(input *network.ApplicationGatewayAutoscaleConfiguration) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+
+	result := make(map[string]interface{})
+
+	if input.MinCapacity != nil {
+		result["min_capacity"] = int(*input.MinCapacity)
+	}
 
 	return []interface{}{result}
 }
@@ -50,6 +50,15 @@ func testSweepApplicationGateways(region string) error {
 			continue
 		}
 
+		inUse, err := sweeperResourceGroupInUse(ctx, armClient, resourceGroup)
+		if err != nil {
+			return err
+		}
+
+		if inUse {
+			continue
+		}
+
 		log.Printf("Deleting Application Gateway %q (Resource Group %q)", name, resourceGroup)
 		future, err := client.Delete(ctx, resourceGroup, name)
 		if err != nil {
@@ -229,6 +238,57 @@ func TestAccAzureRMApplicationGateway_probeResponseMatch(t *testing.T) {
 	})
 }
 
+func TestAccAzureRMApplicationGateway_autoscaleConfiguration(t *testing.T) {
+	resourceName := "azurerm_application_gateway.test"
+	ri := acctest.RandInt()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMApplicationGatewayDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMApplicationGateway_autoscaleConfiguration(ri, testLocation()),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMApplicationGatewayExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "autoscale_configuration.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "autoscale_configuration.0.min_capacity", "2"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAzureRMApplicationGateway_zones(t *testing.T) {
+	resourceName := "azurerm_application_gateway.test"
+	ri := acctest.RandInt()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMApplicationGatewayDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMApplicationGateway_zones(ri, testLocation()),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMApplicationGatewayExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "zones.#", "2"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
 func testCheckAzureRMApplicationGatewayExists(name string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[name]
@@ -1570,3 +1630,126 @@ resource "azurerm_application_gateway" "test" {
 }
 `, rInt, location, rInt, rInt, rInt, rInt)
 }
+
+func testAccAzureRMApplicationGateway_autoscaleConfiguration(rInt int, location string) string {
+	template := testAccAzureRMApplicationGateway_v2Template(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_application_gateway" "test" {
+  name                = "acctestgw-%d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+
+  sku {
+    name = "Standard_v2"
+    tier = "Standard_v2"
+  }
+
+  autoscale_configuration {
+    min_capacity = 2
+  }
+
+%s
+}
+`, template, rInt, testAccAzureRMApplicationGateway_v2Blocks())
+}
+
+func testAccAzureRMApplicationGateway_zones(rInt int, location string) string {
+	template := testAccAzureRMApplicationGateway_v2Template(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_application_gateway" "test" {
+  name                = "acctestgw-%d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  zones               = ["1", "2"]
+
+  sku {
+    name     = "Standard_v2"
+    tier     = "Standard_v2"
+    capacity = 2
+  }
+
+%s
+}
+`, template, rInt, testAccAzureRMApplicationGateway_v2Blocks())
+}
+
+func testAccAzureRMApplicationGateway_v2Template(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_virtual_network" "test" {
+  name                = "acctest-vnet-%d"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  address_space       = ["10.254.0.0/16"]
+  location            = "${azurerm_resource_group.test.location}"
+}
+
+resource "azurerm_subnet" "test" {
+  name                 = "subnet-%d"
+  resource_group_name  = "${azurerm_resource_group.test.name}"
+  virtual_network_name = "${azurerm_virtual_network.test.name}"
+  address_prefix       = "10.254.0.0/24"
+}
+
+resource "azurerm_public_ip" "test" {
+  name                         = "acctest-pubip-%d"
+  location                     = "${azurerm_resource_group.test.location}"
+  resource_group_name          = "${azurerm_resource_group.test.name}"
+  sku                          = "Standard"
+  public_ip_address_allocation = "Static"
+}
+`, rInt, location, rInt, rInt, rInt)
+}
+
+func testAccAzureRMApplicationGateway_v2Blocks() string {
+	return `
+  gateway_ip_configuration {
+    name      = "gw-ip-config1"
+    subnet_id = "${azurerm_subnet.test.id}"
+  }
+
+  frontend_ip_configuration {
+    name                 = "ip-config-public"
+    public_ip_address_id = "${azurerm_public_ip.test.id}"
+  }
+
+  frontend_port {
+    name = "port-8080"
+    port = 8080
+  }
+
+  backend_address_pool {
+    name = "pool-1"
+  }
+
+  backend_http_settings {
+    name                  = "backend-http-1"
+    port                  = 8010
+    protocol              = "Http"
+    cookie_based_affinity = "Disabled"
+    request_timeout       = 30
+  }
+
+  http_listener {
+    name                           = "listener-1"
+    frontend_ip_configuration_name = "ip-config-public"
+    frontend_port_name             = "port-8080"
+    protocol                       = "Http"
+  }
+
+  request_routing_rule {
+    name                       = "rule-basic-1"
+    rule_type                  = "Basic"
+    http_listener_name         = "listener-1"
+    backend_address_pool_name  = "pool-1"
+    backend_http_settings_name = "backend-http-1"
+  }
+`
+}
@@ -0,0 +1,639 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	automationPreview "github.com/Azure/azure-sdk-for-go/services/preview/automation/mgmt/2018-06-preview/automation"
+	"github.com/Azure/go-autorest/autorest/date"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/set"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/suppress"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmAutomationSoftwareUpdateConfiguration() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmAutomationSoftwareUpdateConfigurationCreateUpdate,
+		Read:   resourceArmAutomationSoftwareUpdateConfigurationRead,
+		Update: resourceArmAutomationSoftwareUpdateConfigurationCreateUpdate,
+		Delete: resourceArmAutomationSoftwareUpdateConfigurationDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+
+			"resource_group_name": resourceGroupNameSchema(),
+
+			"automation_account_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+
+			"operating_system": {
+				Type:     schema.TypeString,
+				Required: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(automationPreview.Windows),
+					string(automationPreview.Linux),
+				}, false),
+			},
+
+			"windows": {
+				Type:          schema.TypeList,
+				Optional:      true,
+				MaxItems:      1,
+				ConflictsWith: []string{"linux"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"classifications": {
+							Type:     schema.TypeSet,
+							Required: true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+								ValidateFunc: validation.StringInSlice([]string{
+									string(automationPreview.WindowsUpdateClassesCritical),
+									string(automationPreview.WindowsUpdateClassesDefinition),
+									string(automationPreview.WindowsUpdateClassesFeaturePack),
+									string(automationPreview.WindowsUpdateClassesSecurity),
+									string(automationPreview.WindowsUpdateClassesServicePack),
+									string(automationPreview.WindowsUpdateClassesTools),
+									string(automationPreview.WindowsUpdateClassesUnclassified),
+									string(automationPreview.WindowsUpdateClassesUpdateRollup),
+									string(automationPreview.WindowsUpdateClassesUpdates),
+								}, false),
+							},
+							Set: schema.HashString,
+						},
+
+						"included_knowledge_base_numbers": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validation.NoZeroValues,
+							},
+							Set: schema.HashString,
+						},
+
+						"excluded_knowledge_base_numbers": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validation.NoZeroValues,
+							},
+							Set: schema.HashString,
+						},
+
+						"reboot_setting": automationSoftwareUpdateConfigurationRebootSettingSchema(),
+					},
+				},
+			},
+
+			"linux": {
+				Type:          schema.TypeList,
+				Optional:      true,
+				MaxItems:      1,
+				ConflictsWith: []string{"windows"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"classifications": {
+							Type:     schema.TypeSet,
+							Required: true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+								ValidateFunc: validation.StringInSlice([]string{
+									string(automationPreview.Critical),
+									string(automationPreview.Other),
+									string(automationPreview.Security),
+									string(automationPreview.Unclassified),
+								}, false),
+							},
+							Set: schema.HashString,
+						},
+
+						"included_packages": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validation.NoZeroValues,
+							},
+							Set: schema.HashString,
+						},
+
+						"excluded_packages": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validation.NoZeroValues,
+							},
+							Set: schema.HashString,
+						},
+
+						"reboot_setting": automationSoftwareUpdateConfigurationRebootSettingSchema(),
+					},
+				},
+			},
+
+			// the maintenance window in which updates can be installed
+			"duration": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "PT2H",
+				ValidateFunc: validateIso8601Duration(),
+			},
+
+			"virtual_machine_ids": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: azure.ValidateResourceID,
+				},
+				Set: schema.HashString,
+			},
+
+			"non_azure_computer_names": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.NoZeroValues,
+				},
+				Set: schema.HashString,
+			},
+
+			"schedule": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"frequency": {
+							Type:             schema.TypeString,
+							Required:         true,
+							DiffSuppressFunc: suppress.CaseDifference,
+							ValidateFunc: validation.StringInSlice([]string{
+								string(automationPreview.Day),
+								string(automationPreview.Hour),
+								string(automationPreview.Month),
+								string(automationPreview.OneTime),
+								string(automationPreview.Week),
+							}, true),
+						},
+
+						//ignored when frequency is `OneTime`
+						"interval": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.IntBetween(1, 100),
+						},
+
+						"start_time": {
+							Type:             schema.TypeString,
+							Optional:         true,
+							Computed:         true,
+							DiffSuppressFunc: suppress.RFC3339Time,
+							ValidateFunc:     validate.RFC3339DateInFutureBy(time.Duration(5) * time.Minute),
+							//defaults to now + 7 minutes in create function if not set
+						},
+
+						"expiry_time": {
+							Type:             schema.TypeString,
+							Optional:         true,
+							Computed:         true,
+							DiffSuppressFunc: suppress.RFC3339Time,
+							ValidateFunc:     validate.RFC3339Time,
+						},
+
+						"time_zone": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  "UTC",
+						},
+
+						"is_enabled": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  true,
+						},
+
+						"description": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+
+						"week_days": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+								ValidateFunc: validation.StringInSlice([]string{
+									string(automationPreview.Monday),
+									string(automationPreview.Tuesday),
+									string(automationPreview.Wednesday),
+									string(automationPreview.Thursday),
+									string(automationPreview.Friday),
+									string(automationPreview.Saturday),
+									string(automationPreview.Sunday),
+								}, true),
+							},
+							Set: set.HashStringIgnoreCase,
+						},
+
+						"month_days": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem: &schema.Schema{
+								Type:         schema.TypeInt,
+								ValidateFunc: validate.IntBetweenAndNot(-1, 31, 0),
+							},
+							Set: set.HashInt,
+						},
+					},
+				},
+			},
+		},
+
+		CustomizeDiff: func(diff *schema.ResourceDiff, v interface{}) error {
+			operatingSystem := diff.Get("operating_system").(string)
+
+			_, hasWindows := diff.GetOk("windows")
+			if operatingSystem == string(automationPreview.Windows) && !hasWindows {
+				return fmt.Errorf("A `windows` block must be specified when `operating_system` is `Windows`")
+			}
+
+			_, hasLinux := diff.GetOk("linux")
+			if operatingSystem == string(automationPreview.Linux) && !hasLinux {
+				return fmt.Errorf("A `linux` block must be specified when `operating_system` is `Linux`")
+			}
+
+			schedules := diff.Get("schedule").([]interface{})
+			if len(schedules) == 0 || schedules[0] == nil {
+				return nil
+			}
+			schedule := schedules[0].(map[string]interface{})
+			frequency := strings.ToLower(schedule["frequency"].(string))
+
+			if weekDays := schedule["week_days"].(*schema.Set); weekDays.Len() > 0 && frequency != "week" {
+				return fmt.Errorf("`week_days` can only be set when the schedule `frequency` is `Week`")
+			}
+
+			if monthDays := schedule["month_days"].(*schema.Set); monthDays.Len() > 0 && frequency != "month" {
+				return fmt.Errorf("`month_days` can only be set when the schedule `frequency` is `Month`")
+			}
+
+			return nil
+		},
+	}
+}
+
+func automationSoftwareUpdateConfigurationRebootSettingSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeString,
+		Optional: true,
+		Default:  "IfRequired",
+		ValidateFunc: validation.StringInSlice([]string{
+			"IfRequired",
+			"Never",
+			"Always",
+			"RebootOnly",
+		}, false),
+	}
+}
+
+func resourceArmAutomationSoftwareUpdateConfigurationCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).automationSoftwareUpdateConfigClient
+	ctx := meta.(*ArmClient).StopContext
+
+	log.Printf("[INFO] preparing arguments for AzureRM Automation Software Update Configuration creation.")
+
+	name := d.Get("name").(string)
+	resGroup := d.Get("resource_group_name").(string)
+	accountName := d.Get("automation_account_name").(string)
+
+	updateConfiguration := automationPreview.UpdateConfiguration{
+		OperatingSystem:       automationPreview.OperatingSystemType(d.Get("operating_system").(string)),
+		Windows:               expandArmAutomationSoftwareUpdateConfigurationWindows(d.Get("windows").([]interface{})),
+		Linux:                 expandArmAutomationSoftwareUpdateConfigurationLinux(d.Get("linux").([]interface{})),
+		Duration:              utils.String(d.Get("duration").(string)),
+		AzureVirtualMachines:  utils.ExpandStringArray(d.Get("virtual_machine_ids").(*schema.Set).List()),
+		NonAzureComputerNames: utils.ExpandStringArray(d.Get("non_azure_computer_names").(*schema.Set).List()),
+	}
+
+	parameters := automationPreview.SoftwareUpdateConfiguration{
+		SoftwareUpdateConfigurationProperties: &automationPreview.SoftwareUpdateConfigurationProperties{
+			UpdateConfiguration: &updateConfiguration,
+			ScheduleInfo:        expandArmAutomationSoftwareUpdateConfigurationSchedule(d.Get("schedule").([]interface{}), d.Id() != ""),
+		},
+	}
+
+	if _, err := client.Create(ctx, resGroup, accountName, name, parameters, ""); err != nil {
+		return fmt.Errorf("Error creating/updating Automation Software Update Configuration %q (Account %q / Resource Group %q): %+v", name, accountName, resGroup, err)
+	}
+
+	read, err := client.GetByName(ctx, resGroup, accountName, name, "")
+	if err != nil {
+		return fmt.Errorf("Error retrieving Automation Software Update Configuration %q (Account %q / Resource Group %q): %+v", name, accountName, resGroup, err)
+	}
+
+	if read.ID == nil {
+		return fmt.Errorf("Cannot read Automation Software Update Configuration %q (Account %q / Resource Group %q) ID", name, accountName, resGroup)
+	}
+
+	d.SetId(*read.ID)
+
+	return resourceArmAutomationSoftwareUpdateConfigurationRead(d, meta)
+}
+
+func resourceArmAutomationSoftwareUpdateConfigurationRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).automationSoftwareUpdateConfigClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	name := id.Path["softwareUpdateConfigurations"]
+	resGroup := id.ResourceGroup
+	accountName := id.Path["automationAccounts"]
+
+	resp, err := client.GetByName(ctx, resGroup, accountName, name, "")
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] Automation Software Update Configuration %q was not found in Account %q / Resource Group %q - removing from state", name, accountName, resGroup)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("Error retrieving Automation Software Update Configuration %q (Account %q / Resource Group %q): %+v", name, accountName, resGroup, err)
+	}
+
+	d.Set("name", resp.Name)
+	d.Set("resource_group_name", resGroup)
+	d.Set("automation_account_name", accountName)
+
+	if props := resp.SoftwareUpdateConfigurationProperties; props != nil {
+		if config := props.UpdateConfiguration; config != nil {
+			d.Set("operating_system", string(config.OperatingSystem))
+			d.Set("duration", config.Duration)
+
+			if err := d.Set("windows", flattenArmAutomationSoftwareUpdateConfigurationWindows(config.Windows)); err != nil {
+				return fmt.Errorf("Error setting `windows`: %+v", err)
+			}
+			if err := d.Set("linux", flattenArmAutomationSoftwareUpdateConfigurationLinux(config.Linux)); err != nil {
+				return fmt.Errorf("Error setting `linux`: %+v", err)
+			}
+			if err := d.Set("virtual_machine_ids", utils.FlattenStringArray(config.AzureVirtualMachines)); err != nil {
+				return fmt.Errorf("Error setting `virtual_machine_ids`: %+v", err)
+			}
+			if err := d.Set("non_azure_computer_names", utils.FlattenStringArray(config.NonAzureComputerNames)); err != nil {
+				return fmt.Errorf("Error setting `non_azure_computer_names`: %+v", err)
+			}
+		}
+
+		if err := d.Set("schedule", flattenArmAutomationSoftwareUpdateConfigurationSchedule(props.ScheduleInfo)); err != nil {
+			return fmt.Errorf("Error setting `schedule`: %+v", err)
+		}
+	}
+
+	return nil
+}
+
+func resourceArmAutomationSoftwareUpdateConfigurationDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).automationSoftwareUpdateConfigClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	name := id.Path["softwareUpdateConfigurations"]
+	resGroup := id.ResourceGroup
+	accountName := id.Path["automationAccounts"]
+
+	resp, err := client.Delete(ctx, resGroup, accountName, name, "")
+	if err != nil {
+		if !utils.ResponseWasNotFound(resp) {
+			return fmt.Errorf("Error deleting Automation Software Update Configuration %q (Account %q / Resource Group %q): %+v", name, accountName, resGroup, err)
+		}
+	}
+
+	return nil
+}
+
+// the API expects the update classifications as a single comma separated string
+func expandArmAutomationSoftwareUpdateConfigurationClassifications(input *schema.Set) string {
+	classifications := make([]string, 0)
+	for _, v := range input.List() {
+		classifications = append(classifications, v.(string))
+	}
+	return strings.Join(classifications, ", ")
+}
+
+func flattenArmAutomationSoftwareUpdateConfigurationClassifications(input string) *schema.Set {
+	output := &schema.Set{F: schema.HashString}
+	for _, v := range strings.Split(input, ",") {
+		if classification := strings.TrimSpace(v); classification != "" {
+			output.Add(classification)
+		}
+	}
+	return output
+}
+
+func expandArmAutomationSoftwareUpdateConfigurationWindows(input []interface{}) *automationPreview.WindowsProperties {
+	if len(input) == 0 || input[0] == nil {
+		return nil
+	}
+	v := input[0].(map[string]interface{})
+
+	classifications := expandArmAutomationSoftwareUpdateConfigurationClassifications(v["classifications"].(*schema.Set))
+	return &automationPreview.WindowsProperties{
+		IncludedUpdateClassifications: automationPreview.WindowsUpdateClasses(classifications),
+		IncludedKbNumbers:             utils.ExpandStringArray(v["included_knowledge_base_numbers"].(*schema.Set).List()),
+		ExcludedKbNumbers:             utils.ExpandStringArray(v["excluded_knowledge_base_numbers"].(*schema.Set).List()),
+		RebootSetting:                 utils.String(v["reboot_setting"].(string)),
+	}
+}
+
+func flattenArmAutomationSoftwareUpdateConfigurationWindows(input *automationPreview.WindowsProperties) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+
+	rebootSetting := ""
+	if input.RebootSetting != nil {
+		rebootSetting = *input.RebootSetting
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"classifications":                 flattenArmAutomationSoftwareUpdateConfigurationClassifications(string(input.IncludedUpdateClassifications)),
+			"included_knowledge_base_numbers": schema.NewSet(schema.HashString, utils.FlattenStringArray(input.IncludedKbNumbers)),
+			"excluded_knowledge_base_numbers": schema.NewSet(schema.HashString, utils.FlattenStringArray(input.ExcludedKbNumbers)),
+			"reboot_setting":                  rebootSetting,
+		},
+	}
+}
+
+func expandArmAutomationSoftwareUpdateConfigurationLinux(input []interface{}) *automationPreview.LinuxProperties {
+	if len(input) == 0 || input[0] == nil {
+		return nil
+	}
+	v := input[0].(map[string]interface{})
+
+	classifications := expandArmAutomationSoftwareUpdateConfigurationClassifications(v["classifications"].(*schema.Set))
+	return &automationPreview.LinuxProperties{
+		IncludedPackageClassifications: automationPreview.LinuxUpdateClasses(classifications),
+		IncludedPackageNameMasks:       utils.ExpandStringArray(v["included_packages"].(*schema.Set).List()),
+		ExcludedPackageNameMasks:       utils.ExpandStringArray(v["excluded_packages"].(*schema.Set).List()),
+		RebootSetting:                  utils.String(v["reboot_setting"].(string)),
+	}
+}
+
+func flattenArmAutomationSoftwareUpdateConfigurationLinux(input *automationPreview.LinuxProperties) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+
+	rebootSetting := ""
+	if input.RebootSetting != nil {
+		rebootSetting = *input.RebootSetting
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"classifications":   flattenArmAutomationSoftwareUpdateConfigurationClassifications(string(input.IncludedPackageClassifications)),
+			"included_packages": schema.NewSet(schema.HashString, utils.FlattenStringArray(input.IncludedPackageNameMasks)),
+			"excluded_packages": schema.NewSet(schema.HashString, utils.FlattenStringArray(input.ExcludedPackageNameMasks)),
+			"reboot_setting":    rebootSetting,
+		},
+	}
+}
+
+func expandArmAutomationSoftwareUpdateConfigurationSchedule(input []interface{}, isUpdate bool) *automationPreview.ScheduleProperties {
+	if len(input) == 0 || input[0] == nil {
+		return nil
+	}
+	v := input[0].(map[string]interface{})
+
+	schedule := automationPreview.ScheduleProperties{
+		Frequency:   automationPreview.ScheduleFrequency(v["frequency"].(string)),
+		TimeZone:    utils.String(v["time_zone"].(string)),
+		IsEnabled:   utils.Bool(v["is_enabled"].(bool)),
+		Description: utils.String(v["description"].(string)),
+	}
+
+	//start time can default to now + 7 (5 could be invalid by the time the API is called)
+	if startTime := v["start_time"].(string); startTime != "" {
+		t, _ := time.Parse(time.RFC3339, startTime) //should be validated by the schema
+		schedule.StartTime = &date.Time{Time: t}
+	} else {
+		schedule.StartTime = &date.Time{Time: time.Now().Add(time.Duration(7) * time.Minute)}
+	}
+
+	if expiryTime := v["expiry_time"].(string); expiryTime != "" {
+		t, _ := time.Parse(time.RFC3339, expiryTime) //should be validated by the schema
+		schedule.ExpiryTime = &date.Time{Time: t}
+	}
+
+	//only pay attention to interval if frequency is not OneTime, and default it to 1 if not set
+	if schedule.Frequency != automationPreview.OneTime {
+		interval := v["interval"].(int)
+		if interval == 0 {
+			interval = 1
+		}
+		schedule.Interval = utils.Int32(int32(interval))
+	}
+
+	//only pay attention to the advanced schedule fields if frequency is either Week or Month
+	if schedule.Frequency == automationPreview.Week || schedule.Frequency == automationPreview.Month {
+		advanced := automationPreview.AdvancedSchedule{}
+
+		// as with the Automation Schedule the API rejects an empty `weekDays` on create, but requires it on update to clear the value
+		if weekDays := v["week_days"].(*schema.Set); weekDays.Len() > 0 {
+			advanced.WeekDays = utils.ExpandStringArray(weekDays.List())
+		} else if isUpdate {
+			advanced.WeekDays = &[]string{}
+		}
+
+		if monthDays := v["month_days"].(*schema.Set); monthDays.Len() > 0 {
+			advanced.MonthDays = set.ToSliceInt32P(monthDays)
+		} else if isUpdate {
+			advanced.MonthDays = &[]int32{}
+		}
+
+		schedule.AdvancedSchedule = &advanced
+	}
+
+	return &schedule
+}
+
+func flattenArmAutomationSoftwareUpdateConfigurationSchedule(input *automationPreview.ScheduleProperties) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+
+	output := map[string]interface{}{
+		"frequency": string(input.Frequency),
+	}
+
+	if v := input.Interval; v != nil {
+		output["interval"] = int(*v)
+	}
+	if v := input.StartTime; v != nil {
+		output["start_time"] = v.Format(time.RFC3339)
+	}
+	if v := input.ExpiryTime; v != nil {
+		output["expiry_time"] = v.Format(time.RFC3339)
+	}
+	if v := input.TimeZone; v != nil {
+		output["time_zone"] = *v
+	}
+	if v := input.IsEnabled; v != nil {
+		output["is_enabled"] = *v
+	}
+	if v := input.Description; v != nil {
+		output["description"] = *v
+	}
+
+	weekDays := schema.NewSet(set.HashStringIgnoreCase, []interface{}{})
+	monthDays := schema.NewSet(set.HashInt, []interface{}{})
+	if advanced := input.AdvancedSchedule; advanced != nil {
+		if advanced.WeekDays != nil {
+			for _, v := range *advanced.WeekDays {
+				weekDays.Add(v)
+			}
+		}
+		if advanced.MonthDays != nil {
+			for _, v := range *advanced.MonthDays {
+				monthDays.Add(int(v))
+			}
+		}
+	}
+	output["week_days"] = weekDays
+	output["month_days"] = monthDays
+
+	return []interface{}{output}
+}
@@ -0,0 +1,339 @@
+package azurerm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func TestAutomationSoftwareUpdateConfigurationClassifications(t *testing.T) {
+	testData := []struct {
+		Input    string
+		Expected []string
+	}{
+		{
+			Input:    "",
+			Expected: []string{},
+		},
+		{
+			Input:    "Critical",
+			Expected: []string{"Critical"},
+		},
+		{
+			Input:    "Critical, Security",
+			Expected: []string{"Critical", "Security"},
+		},
+		{
+			Input:    "Critical,Security,UpdateRollup",
+			Expected: []string{"Critical", "Security", "UpdateRollup"},
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+
+		actual := flattenArmAutomationSoftwareUpdateConfigurationClassifications(v.Input)
+		if actual.Len() != len(v.Expected) {
+			t.Fatalf("Expected %d classifications but got %d for %q", len(v.Expected), actual.Len(), v.Input)
+		}
+
+		for _, expected := range v.Expected {
+			if !actual.Contains(expected) {
+				t.Fatalf("Expected %q to contain %q", v.Input, expected)
+			}
+		}
+
+		roundTripped := flattenArmAutomationSoftwareUpdateConfigurationClassifications(expandArmAutomationSoftwareUpdateConfigurationClassifications(actual))
+		if !roundTripped.Equal(actual) {
+			t.Fatalf("Expected %q to round trip but got %+v", v.Input, roundTripped.List())
+		}
+	}
+}
+
+func TestAccAzureRMAutomationSoftwareUpdateConfiguration_windows(t *testing.T) {
+	resourceName := "azurerm_automation_software_update_configuration.test"
+	ri := acctest.RandInt()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMAutomationSoftwareUpdateConfigurationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMAutomationSoftwareUpdateConfiguration_windows(ri, testLocation()),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMAutomationSoftwareUpdateConfigurationExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "operating_system", "Windows"),
+					resource.TestCheckResourceAttr(resourceName, "windows.0.classifications.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "windows.0.reboot_setting", "IfRequired"),
+					resource.TestCheckResourceAttr(resourceName, "duration", "PT2H"),
+					resource.TestCheckResourceAttr(resourceName, "schedule.0.frequency", "Week"),
+					resource.TestCheckResourceAttr(resourceName, "schedule.0.week_days.#", "1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAzureRMAutomationSoftwareUpdateConfiguration_windowsUpdate(t *testing.T) {
+	resourceName := "azurerm_automation_software_update_configuration.test"
+	ri := acctest.RandInt()
+	location := testLocation()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMAutomationSoftwareUpdateConfigurationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMAutomationSoftwareUpdateConfiguration_windows(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMAutomationSoftwareUpdateConfigurationExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "windows.0.classifications.#", "2"),
+				),
+			},
+			{
+				Config: testAccAzureRMAutomationSoftwareUpdateConfiguration_windowsComplete(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMAutomationSoftwareUpdateConfigurationExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "windows.0.classifications.#", "3"),
+					resource.TestCheckResourceAttr(resourceName, "windows.0.included_knowledge_base_numbers.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "windows.0.excluded_knowledge_base_numbers.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "windows.0.reboot_setting", "Never"),
+					resource.TestCheckResourceAttr(resourceName, "duration", "PT3H30M"),
+					resource.TestCheckResourceAttr(resourceName, "schedule.0.frequency", "Month"),
+					resource.TestCheckResourceAttr(resourceName, "schedule.0.month_days.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "schedule.0.is_enabled", "false"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMAutomationSoftwareUpdateConfiguration_linux(t *testing.T) {
+	resourceName := "azurerm_automation_software_update_configuration.test"
+	ri := acctest.RandInt()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMAutomationSoftwareUpdateConfigurationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMAutomationSoftwareUpdateConfiguration_linux(ri, testLocation()),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMAutomationSoftwareUpdateConfigurationExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "operating_system", "Linux"),
+					resource.TestCheckResourceAttr(resourceName, "linux.0.classifications.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "linux.0.excluded_packages.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "linux.0.reboot_setting", "Always"),
+					resource.TestCheckResourceAttr(resourceName, "schedule.0.frequency", "Day"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testCheckAzureRMAutomationSoftwareUpdateConfigurationDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*ArmClient).automationSoftwareUpdateConfigClient
+	ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_automation_software_update_configuration" {
+			continue
+		}
+
+		name := rs.Primary.Attributes["name"]
+		accName := rs.Primary.Attributes["automation_account_name"]
+		resourceGroup := rs.Primary.Attributes["resource_group_name"]
+
+		resp, err := conn.GetByName(ctx, resourceGroup, accName, name, "")
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return nil
+			}
+
+			return err
+		}
+
+		return fmt.Errorf("Automation Software Update Configuration still exists:\n%#v", resp)
+	}
+
+	return nil
+}
+
+func testCheckAzureRMAutomationSoftwareUpdateConfigurationExists(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := testAccProvider.Meta().(*ArmClient).automationSoftwareUpdateConfigClient
+		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+		// Ensure we have enough information in state to look up in API
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+
+		configName := rs.Primary.Attributes["name"]
+		accName := rs.Primary.Attributes["automation_account_name"]
+		resourceGroup := rs.Primary.Attributes["resource_group_name"]
+
+		resp, err := conn.GetByName(ctx, resourceGroup, accName, configName, "")
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return fmt.Errorf("Bad: Automation Software Update Configuration %q (Account %q / Resource Group %q) does not exist", configName, accName, resourceGroup)
+			}
+
+			return fmt.Errorf("Bad: Get on automationSoftwareUpdateConfigClient: %+v", err)
+		}
+
+		return nil
+	}
+}
+
+// Update Management requires the Automation Account to be linked to a Log Analytics Workspace with the Updates solution
+func testAccAzureRMAutomationSoftwareUpdateConfiguration_prerequisites(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_automation_account" "test" {
+  name                = "acctestAA-%d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+
+  sku {
+    name = "Basic"
+  }
+}
+
+resource "azurerm_log_analytics_workspace" "test" {
+  name                = "acctestLAW-%d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  sku                 = "PerGB2018"
+}
+
+resource "azurerm_log_analytics_workspace_linked_service" "test" {
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  workspace_name      = "${azurerm_log_analytics_workspace.test.name}"
+
+  linked_service_properties {
+    resource_id = "${azurerm_automation_account.test.id}"
+  }
+}
+
+resource "azurerm_log_analytics_solution" "test" {
+  solution_name         = "Updates"
+  location              = "${azurerm_resource_group.test.location}"
+  resource_group_name   = "${azurerm_resource_group.test.name}"
+  workspace_resource_id = "${azurerm_log_analytics_workspace.test.id}"
+  workspace_name        = "${azurerm_log_analytics_workspace.test.name}"
+
+  plan {
+    publisher = "Microsoft"
+    product   = "OMSGallery/Updates"
+  }
+}
+`, rInt, location, rInt, rInt)
+}
+
+func testAccAzureRMAutomationSoftwareUpdateConfiguration_windows(rInt int, location string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_automation_software_update_configuration" "test" {
+  name                     = "acctestSUC-%d"
+  resource_group_name      = "${azurerm_resource_group.test.name}"
+  automation_account_name  = "${azurerm_automation_account.test.name}"
+  operating_system         = "Windows"
+  non_azure_computer_names = ["acctest-computer"]
+
+  windows {
+    classifications = ["Critical", "Security"]
+  }
+
+  schedule {
+    frequency = "Week"
+    week_days = ["Sunday"]
+  }
+
+  depends_on = ["azurerm_log_analytics_workspace_linked_service.test", "azurerm_log_analytics_solution.test"]
+}
+`, testAccAzureRMAutomationSoftwareUpdateConfiguration_prerequisites(rInt, location), rInt)
+}
+
+func testAccAzureRMAutomationSoftwareUpdateConfiguration_windowsComplete(rInt int, location string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_automation_software_update_configuration" "test" {
+  name                     = "acctestSUC-%d"
+  resource_group_name      = "${azurerm_resource_group.test.name}"
+  automation_account_name  = "${azurerm_automation_account.test.name}"
+  operating_system         = "Windows"
+  duration                 = "PT3H30M"
+  non_azure_computer_names = ["acctest-computer"]
+
+  windows {
+    classifications                 = ["Critical", "Security", "UpdateRollup"]
+    included_knowledge_base_numbers = ["KB4462917"]
+    excluded_knowledge_base_numbers = ["KB4457131", "KB4458842"]
+    reboot_setting                  = "Never"
+  }
+
+  schedule {
+    frequency   = "Month"
+    interval    = 1
+    month_days  = [1, 15]
+    time_zone   = "Pacific Standard Time"
+    is_enabled  = false
+    description = "acctest"
+  }
+
+  depends_on = ["azurerm_log_analytics_workspace_linked_service.test", "azurerm_log_analytics_solution.test"]
+}
+`, testAccAzureRMAutomationSoftwareUpdateConfiguration_prerequisites(rInt, location), rInt)
+}
+
+func testAccAzureRMAutomationSoftwareUpdateConfiguration_linux(rInt int, location string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_automation_software_update_configuration" "test" {
+  name                     = "acctestSUC-%d"
+  resource_group_name      = "${azurerm_resource_group.test.name}"
+  automation_account_name  = "${azurerm_automation_account.test.name}"
+  operating_system         = "Linux"
+  non_azure_computer_names = ["acctest-computer"]
+
+  linux {
+    classifications   = ["Critical", "Security"]
+    excluded_packages = ["kernel*"]
+    reboot_setting    = "Always"
+  }
+
+  schedule {
+    frequency = "Day"
+    interval  = 2
+  }
+
+  depends_on = ["azurerm_log_analytics_workspace_linked_service.test", "azurerm_log_analytics_solution.test"]
+}
+`, testAccAzureRMAutomationSoftwareUpdateConfiguration_prerequisites(rInt, location), rInt)
+}
@@ -0,0 +1,217 @@
+package azurerm
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/recoveryservices/mgmt/2017-07-01/backup"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmBackupContainerStorageAccount() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmBackupContainerStorageAccountCreate,
+		Read:   resourceArmBackupContainerStorageAccountRead,
+		Delete: resourceArmBackupContainerStorageAccountDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+
+			"resource_group_name": resourceGroupNameSchema(),
+
+			"recovery_vault_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringMatch(
+					regexp.MustCompile("^[a-zA-Z][-a-zA-Z0-9]{1,49}$"),
+					"Recovery Service Vault name must be 2 - 50 characters long, start with a letter, contain only letters, numbers and hyphens.",
+				),
+			},
+
+			"storage_account_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: azure.ValidateResourceID,
+			},
+		},
+	}
+}
+
+func resourceArmBackupContainerStorageAccountCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).recoveryServicesProtectionContainersClient
+	ctx := meta.(*ArmClient).StopContext
+
+	resourceGroup := d.Get("resource_group_name").(string)
+	vaultName := d.Get("recovery_vault_name").(string)
+	storageAccountId := d.Get("storage_account_id").(string)
+
+	containerName, err := backupContainerStorageAccountName(storageAccountId)
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Registering Backup Container %q (Vault %q / Resource Group %q)", containerName, vaultName, resourceGroup)
+
+	parameters := backup.ProtectionContainerResource{
+		Properties: &backup.AzureStorageContainer{
+			SourceResourceID:     utils.String(storageAccountId),
+			FriendlyName:         utils.String(containerName),
+			BackupManagementType: backup.ManagementTypeAzureStorage,
+			ContainerType:        backup.ContainerTypeStorageContainer1,
+		},
+	}
+
+	if _, err := client.Register(ctx, vaultName, resourceGroup, "Azure", containerName, parameters); err != nil {
+		return fmt.Errorf("Error registering Backup Container %q (Vault %q / Resource Group %q): %+v", containerName, vaultName, resourceGroup, err)
+	}
+
+	resp, err := resourceArmBackupContainerStorageAccountWaitForState(client, ctx, true, vaultName, resourceGroup, containerName)
+	if err != nil {
+		return err
+	}
+
+	id := strings.Replace(*resp.ID, "Subscriptions", "subscriptions", 1)
+	d.SetId(id)
+
+	return resourceArmBackupContainerStorageAccountRead(d, meta)
+}
+
+func resourceArmBackupContainerStorageAccountRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).recoveryServicesProtectionContainersClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	containerName := id.Path["protectionContainers"]
+	vaultName := id.Path["vaults"]
+	resourceGroup := id.ResourceGroup
+
+	log.Printf("[DEBUG] Reading Backup Container %q (Vault %q / Resource Group %q)", containerName, vaultName, resourceGroup)
+
+	resp, err := client.Get(ctx, vaultName, resourceGroup, "Azure", containerName)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("Error making Read request on Backup Container %q (Vault %q / Resource Group %q): %+v", containerName, vaultName, resourceGroup, err)
+	}
+
+	d.Set("resource_group_name", resourceGroup)
+	d.Set("recovery_vault_name", vaultName)
+
+	if properties := resp.Properties; properties != nil {
+		if container, ok := properties.AsAzureStorageContainer(); ok && container != nil {
+			d.Set("storage_account_id", container.SourceResourceID)
+		}
+	}
+
+	return nil
+}
+
+func resourceArmBackupContainerStorageAccountDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).recoveryServicesProtectionContainersClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	containerName := id.Path["protectionContainers"]
+	vaultName := id.Path["vaults"]
+	resourceGroup := id.ResourceGroup
+
+	log.Printf("[DEBUG] Unregistering Backup Container %q (Vault %q / Resource Group %q)", containerName, vaultName, resourceGroup)
+
+	resp, err := client.Unregister(ctx, vaultName, resourceGroup, "Azure", containerName)
+	if err != nil {
+		if !utils.ResponseWasNotFound(resp) {
+			return fmt.Errorf("Error unregistering Backup Container %q (Vault %q / Resource Group %q): %+v", containerName, vaultName, resourceGroup, err)
+		}
+	}
+
+	if _, err := resourceArmBackupContainerStorageAccountWaitForState(client, ctx, false, vaultName, resourceGroup, containerName); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// backupContainerStorageAccountName returns the name Azure Backup assigns to the container of a Storage Account
+func backupContainerStorageAccountName(storageAccountId string) (string, error) {
+	id, err := azure.ParseAzureResourceID(storageAccountId)
+	if err != nil {
+		return "", fmt.Errorf("Unable to parse `storage_account_id` %q: %+v", storageAccountId, err)
+	}
+
+	accountName, ok := id.Path["storageAccounts"]
+	if !ok {
+		return "", fmt.Errorf("Parsed `storage_account_id` %q doesn't contain `storageAccounts`", storageAccountId)
+	}
+
+	return fmt.Sprintf("StorageContainer;storage;%s;%s", id.ResourceGroup, accountName), nil
+}
+
+func resourceArmBackupContainerStorageAccountWaitForState(client backup.ProtectionContainersClient, ctx context.Context, found bool, vaultName, resourceGroup, containerName string) (backup.ProtectionContainerResource, error) {
+	state := &resource.StateChangeConf{
+		Timeout:    30 * time.Minute,
+		MinTimeout: 30 * time.Second,
+		Delay:      10 * time.Second,
+		Refresh: func() (interface{}, string, error) {
+
+			resp, err := client.Get(ctx, vaultName, resourceGroup, "Azure", containerName)
+			if err != nil {
+				if utils.ResponseWasNotFound(resp.Response) {
+					return resp, "NotFound", nil
+				}
+
+				return resp, "Error", fmt.Errorf("Error making Read request on Backup Container %q (Vault %q / Resource Group %q): %+v", containerName, vaultName, resourceGroup, err)
+			}
+
+			// the container is returned whilst the registration is still in progress
+			if properties := resp.Properties; properties != nil {
+				if container, ok := properties.AsAzureStorageContainer(); ok && container != nil {
+					if status := container.RegistrationStatus; status != nil && !strings.EqualFold(*status, "Registered") {
+						return resp, "Registering", nil
+					}
+				}
+			}
+
+			return resp, "Found", nil
+		},
+	}
+
+	if found {
+		state.Pending = []string{"NotFound", "Registering"}
+		state.Target = []string{"Found"}
+	} else {
+		state.Pending = []string{"Found", "Registering"}
+		state.Target = []string{"NotFound"}
+	}
+
+	resp, err := state.WaitForState()
+	if err != nil {
+		return resp.(backup.ProtectionContainerResource), fmt.Errorf("Error waiting for the Backup Container %q to be %t (Vault %q / Resource Group %q): %+v", containerName, found, vaultName, resourceGroup, err)
+	}
+
+	return resp.(backup.ProtectionContainerResource), nil
+}
@@ -0,0 +1,188 @@
+package azurerm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func TestBackupContainerStorageAccountName(t *testing.T) {
+	cases := []struct {
+		Input    string
+		Expected string
+		Error    bool
+	}{
+		{
+			Input: "",
+			Error: true,
+		},
+		{
+			Input: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Compute/virtualMachines/machine1",
+			Error: true,
+		},
+		{
+			Input:    "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Storage/storageAccounts/account1",
+			Expected: "StorageContainer;storage;group1;account1",
+		},
+	}
+
+	for _, tc := range cases {
+		actual, err := backupContainerStorageAccountName(tc.Input)
+		if err != nil {
+			if tc.Error {
+				continue
+			}
+
+			t.Fatalf("Expected no error for %q but got: %+v", tc.Input, err)
+		}
+
+		if tc.Error {
+			t.Fatalf("Expected an error for %q but didn't get one", tc.Input)
+		}
+
+		if actual != tc.Expected {
+			t.Fatalf("Expected %q but got %q for %q", tc.Expected, actual, tc.Input)
+		}
+	}
+}
+
+func TestAccAzureRMBackupContainerStorageAccount_basic(t *testing.T) {
+	resourceName := "azurerm_backup_container_storage_account.test"
+	ri := acctest.RandInt()
+	rs := acctest.RandString(4)
+	location := testLocation()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMBackupContainerStorageAccountDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMBackupContainerStorageAccount_basic(ri, rs, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMBackupContainerStorageAccountExists(resourceName),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{ //vault cannot be deleted unless we unregister all containers
+				Config: testAccAzureRMBackupContainerStorageAccount_template(ri, rs, location),
+				Check:  resource.ComposeTestCheckFunc(),
+			},
+		},
+	})
+}
+
+func testCheckAzureRMBackupContainerStorageAccountDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*ArmClient).recoveryServicesProtectionContainersClient
+	ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_backup_container_storage_account" {
+			continue
+		}
+
+		id, err := parseAzureResourceID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		containerName := id.Path["protectionContainers"]
+		vaultName := id.Path["vaults"]
+		resourceGroup := id.ResourceGroup
+
+		resp, err := client.Get(ctx, vaultName, resourceGroup, "Azure", containerName)
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return nil
+			}
+
+			return err
+		}
+
+		return fmt.Errorf("Backup Container %q (Vault %q / Resource Group %q) still exists:\n%#v", containerName, vaultName, resourceGroup, resp)
+	}
+
+	return nil
+}
+
+func testCheckAzureRMBackupContainerStorageAccountExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		id, err := parseAzureResourceID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		containerName := id.Path["protectionContainers"]
+		vaultName := id.Path["vaults"]
+		resourceGroup := id.ResourceGroup
+
+		client := testAccProvider.Meta().(*ArmClient).recoveryServicesProtectionContainersClient
+		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+		resp, err := client.Get(ctx, vaultName, resourceGroup, "Azure", containerName)
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return fmt.Errorf("Bad: Backup Container %q (Vault %q / Resource Group %q) does not exist", containerName, vaultName, resourceGroup)
+			}
+
+			return fmt.Errorf("Bad: Get on recoveryServicesProtectionContainersClient: %+v", err)
+		}
+
+		return nil
+	}
+}
+
+func testAccAzureRMBackupContainerStorageAccount_template(rInt int, rString string, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%[1]d"
+  location = "%[3]s"
+}
+
+resource "azurerm_storage_account" "test" {
+  name                     = "acctest%[2]s%[1]d"
+  location                 = "${azurerm_resource_group.test.location}"
+  resource_group_name      = "${azurerm_resource_group.test.name}"
+  account_tier             = "Standard"
+  account_replication_type = "LRS"
+}
+
+resource "azurerm_storage_share" "test" {
+  name                 = "acctest-ss-%[1]d"
+  resource_group_name  = "${azurerm_resource_group.test.name}"
+  storage_account_name = "${azurerm_storage_account.test.name}"
+}
+
+resource "azurerm_recovery_services_vault" "test" {
+  name                = "acctest-%[1]d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  sku                 = "Standard"
+}
+`, rInt, rString, location)
+}
+
+func testAccAzureRMBackupContainerStorageAccount_basic(rInt int, rString string, location string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_backup_container_storage_account" "test" {
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  recovery_vault_name = "${azurerm_recovery_services_vault.test.name}"
+  storage_account_id  = "${azurerm_storage_account.test.id}"
+}
+`, testAccAzureRMBackupContainerStorageAccount_template(rInt, rString, location))
+}
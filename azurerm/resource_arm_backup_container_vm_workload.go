@@ -0,0 +1,229 @@
+package azurerm
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/recoveryservices/mgmt/2017-07-01/backup"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmBackupContainerVmWorkload() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmBackupContainerVmWorkloadCreate,
+		Read:   resourceArmBackupContainerVmWorkloadRead,
+		Delete: resourceArmBackupContainerVmWorkloadDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+
+			"resource_group_name": resourceGroupNameSchema(),
+
+			"recovery_vault_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringMatch(
+					regexp.MustCompile("^[a-zA-Z][-a-zA-Z0-9]{1,49}$"),
+					"Recovery Service Vault name must be 2 - 50 characters long, start with a letter, contain only letters, numbers and hyphens.",
+				),
+			},
+
+			"source_vm_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: azure.ValidateResourceID,
+			},
+
+			"workload_type": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(backup.WorkloadTypeSQLDataBase),
+					string(backup.WorkloadTypeSAPHanaDatabase),
+				}, false),
+			},
+		},
+	}
+}
+
+func resourceArmBackupContainerVmWorkloadCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).recoveryServicesProtectionContainersClient
+	ctx := meta.(*ArmClient).StopContext
+
+	resourceGroup := d.Get("resource_group_name").(string)
+	vaultName := d.Get("recovery_vault_name").(string)
+	vmId := d.Get("source_vm_id").(string)
+	workloadType := d.Get("workload_type").(string)
+
+	containerName, err := backupContainerVmWorkloadName(vmId)
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Registering Backup Container %q (Vault %q / Resource Group %q)", containerName, vaultName, resourceGroup)
+
+	parameters := backup.ProtectionContainerResource{
+		Properties: &backup.AzureVMAppContainerProtectionContainer{
+			SourceResourceID:     utils.String(vmId),
+			WorkloadType:         backup.WorkloadType(workloadType),
+			BackupManagementType: backup.ManagementTypeAzureWorkload,
+			ContainerType:        backup.ContainerTypeVMAppContainer1,
+		},
+	}
+
+	if _, err := client.Register(ctx, vaultName, resourceGroup, "Azure", containerName, parameters); err != nil {
+		return fmt.Errorf("Error registering Backup Container %q (Vault %q / Resource Group %q): %+v", containerName, vaultName, resourceGroup, err)
+	}
+
+	resp, err := resourceArmBackupContainerVmWorkloadWaitForState(client, ctx, true, vaultName, resourceGroup, containerName)
+	if err != nil {
+		return err
+	}
+
+	id := strings.Replace(*resp.ID, "Subscriptions", "subscriptions", 1)
+	d.SetId(id)
+
+	return resourceArmBackupContainerVmWorkloadRead(d, meta)
+}
+
+func resourceArmBackupContainerVmWorkloadRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).recoveryServicesProtectionContainersClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	containerName := id.Path["protectionContainers"]
+	vaultName := id.Path["vaults"]
+	resourceGroup := id.ResourceGroup
+
+	log.Printf("[DEBUG] Reading Backup Container %q (Vault %q / Resource Group %q)", containerName, vaultName, resourceGroup)
+
+	resp, err := client.Get(ctx, vaultName, resourceGroup, "Azure", containerName)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("Error making Read request on Backup Container %q (Vault %q / Resource Group %q): %+v", containerName, vaultName, resourceGroup, err)
+	}
+
+	d.Set("resource_group_name", resourceGroup)
+	d.Set("recovery_vault_name", vaultName)
+
+	if properties := resp.Properties; properties != nil {
+		if container, ok := properties.AsAzureVMAppContainerProtectionContainer(); ok && container != nil {
+			d.Set("source_vm_id", container.SourceResourceID)
+			d.Set("workload_type", string(container.WorkloadType))
+		}
+	}
+
+	return nil
+}
+
+func resourceArmBackupContainerVmWorkloadDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).recoveryServicesProtectionContainersClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	containerName := id.Path["protectionContainers"]
+	vaultName := id.Path["vaults"]
+	resourceGroup := id.ResourceGroup
+
+	log.Printf("[DEBUG] Unregistering Backup Container %q (Vault %q / Resource Group %q)", containerName, vaultName, resourceGroup)
+
+	resp, err := client.Unregister(ctx, vaultName, resourceGroup, "Azure", containerName)
+	if err != nil {
+		if !utils.ResponseWasNotFound(resp) {
+			return fmt.Errorf("Error unregistering Backup Container %q (Vault %q / Resource Group %q): %+v", containerName, vaultName, resourceGroup, err)
+		}
+	}
+
+	if _, err := resourceArmBackupContainerVmWorkloadWaitForState(client, ctx, false, vaultName, resourceGroup, containerName); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// backupContainerVmWorkloadName returns the name Azure Backup assigns to the workload container of a Virtual Machine
+func backupContainerVmWorkloadName(vmId string) (string, error) {
+	id, err := azure.ParseAzureResourceID(vmId)
+	if err != nil {
+		return "", fmt.Errorf("Unable to parse `source_vm_id` %q: %+v", vmId, err)
+	}
+
+	vmName, ok := id.Path["virtualMachines"]
+	if !ok {
+		return "", fmt.Errorf("Parsed `source_vm_id` %q doesn't contain `virtualMachines`", vmId)
+	}
+
+	return fmt.Sprintf("VMAppContainer;compute;%s;%s", id.ResourceGroup, vmName), nil
+}
+
+func resourceArmBackupContainerVmWorkloadWaitForState(client backup.ProtectionContainersClient, ctx context.Context, found bool, vaultName, resourceGroup, containerName string) (backup.ProtectionContainerResource, error) {
+	state := &resource.StateChangeConf{
+		Timeout:    30 * time.Minute,
+		MinTimeout: 30 * time.Second,
+		Delay:      10 * time.Second,
+		Refresh: func() (interface{}, string, error) {
+
+			resp, err := client.Get(ctx, vaultName, resourceGroup, "Azure", containerName)
+			if err != nil {
+				if utils.ResponseWasNotFound(resp.Response) {
+					return resp, "NotFound", nil
+				}
+
+				return resp, "Error", fmt.Errorf("Error making Read request on Backup Container %q (Vault %q / Resource Group %q): %+v", containerName, vaultName, resourceGroup, err)
+			}
+
+			// the container is returned whilst the registration is still in progress
+			if properties := resp.Properties; properties != nil {
+				if container, ok := properties.AsAzureVMAppContainerProtectionContainer(); ok && container != nil {
+					if status := container.RegistrationStatus; status != nil && !strings.EqualFold(*status, "Registered") {
+						return resp, "Registering", nil
+					}
+				}
+			}
+
+			return resp, "Found", nil
+		},
+	}
+
+	if found {
+		state.Pending = []string{"NotFound", "Registering"}
+		state.Target = []string{"Found"}
+	} else {
+		state.Pending = []string{"Found", "Registering"}
+		state.Target = []string{"NotFound"}
+	}
+
+	resp, err := state.WaitForState()
+	if err != nil {
+		return resp.(backup.ProtectionContainerResource), fmt.Errorf("Error waiting for the Backup Container %q to be %t (Vault %q / Resource Group %q): %+v", containerName, found, vaultName, resourceGroup, err)
+	}
+
+	return resp.(backup.ProtectionContainerResource), nil
+}
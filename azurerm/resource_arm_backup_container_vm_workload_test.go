@@ -0,0 +1,236 @@
+package azurerm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func TestBackupContainerVmWorkloadName(t *testing.T) {
+	cases := []struct {
+		Input    string
+		Expected string
+		Error    bool
+	}{
+		{
+			Input: "",
+			Error: true,
+		},
+		{
+			Input: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Network/virtualNetworks/network1",
+			Error: true,
+		},
+		{
+			Input:    "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Compute/virtualMachines/machine1",
+			Expected: "VMAppContainer;compute;group1;machine1",
+		},
+	}
+
+	for _, tc := range cases {
+		actual, err := backupContainerVmWorkloadName(tc.Input)
+		if err != nil {
+			if tc.Error {
+				continue
+			}
+
+			t.Fatalf("Expected no error for %q but got: %+v", tc.Input, err)
+		}
+
+		if tc.Error {
+			t.Fatalf("Expected an error for %q but didn't get one", tc.Input)
+		}
+
+		if actual != tc.Expected {
+			t.Fatalf("Expected %q but got %q for %q", tc.Expected, actual, tc.Input)
+		}
+	}
+}
+
+func TestAccAzureRMBackupContainerVmWorkload_basic(t *testing.T) {
+	resourceName := "azurerm_backup_container_vm_workload.test"
+	ri := acctest.RandInt()
+	location := testLocation()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMBackupContainerVmWorkloadDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMBackupContainerVmWorkload_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMBackupContainerVmWorkloadExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "workload_type", "SQLDataBase"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{ //vault cannot be deleted unless we unregister all containers
+				Config: testAccAzureRMBackupContainerVmWorkload_template(ri, location),
+				Check:  resource.ComposeTestCheckFunc(),
+			},
+		},
+	})
+}
+
+func testCheckAzureRMBackupContainerVmWorkloadDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*ArmClient).recoveryServicesProtectionContainersClient
+	ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_backup_container_vm_workload" {
+			continue
+		}
+
+		id, err := parseAzureResourceID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		containerName := id.Path["protectionContainers"]
+		vaultName := id.Path["vaults"]
+		resourceGroup := id.ResourceGroup
+
+		resp, err := client.Get(ctx, vaultName, resourceGroup, "Azure", containerName)
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return nil
+			}
+
+			return err
+		}
+
+		return fmt.Errorf("Backup Container %q (Vault %q / Resource Group %q) still exists:\n%#v", containerName, vaultName, resourceGroup, resp)
+	}
+
+	return nil
+}
+
+func testCheckAzureRMBackupContainerVmWorkloadExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		id, err := parseAzureResourceID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		containerName := id.Path["protectionContainers"]
+		vaultName := id.Path["vaults"]
+		resourceGroup := id.ResourceGroup
+
+		client := testAccProvider.Meta().(*ArmClient).recoveryServicesProtectionContainersClient
+		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+		resp, err := client.Get(ctx, vaultName, resourceGroup, "Azure", containerName)
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return fmt.Errorf("Bad: Backup Container %q (Vault %q / Resource Group %q) does not exist", containerName, vaultName, resourceGroup)
+			}
+
+			return fmt.Errorf("Bad: Get on recoveryServicesProtectionContainersClient: %+v", err)
+		}
+
+		return nil
+	}
+}
+
+// testAccAzureRMBackupContainerVmWorkload_template provisions a Virtual Machine from a SQL Server Marketplace image,
+// which ships with the SQL IaaS Agent that Azure Backup uses to discover the databases
+func testAccAzureRMBackupContainerVmWorkload_template(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_virtual_network" "test" {
+  name                = "acctestvn-%[1]d"
+  location            = "${azurerm_resource_group.test.location}"
+  address_space       = ["10.0.0.0/16"]
+  resource_group_name = "${azurerm_resource_group.test.name}"
+}
+
+resource "azurerm_subnet" "test" {
+  name                 = "acctestsub-%[1]d"
+  virtual_network_name = "${azurerm_virtual_network.test.name}"
+  resource_group_name  = "${azurerm_resource_group.test.name}"
+  address_prefix       = "10.0.10.0/24"
+}
+
+resource "azurerm_network_interface" "test" {
+  name                = "acctestnic-%[1]d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+
+  ip_configuration {
+    name                          = "acctestipconfig"
+    subnet_id                     = "${azurerm_subnet.test.id}"
+    private_ip_address_allocation = "Dynamic"
+  }
+}
+
+resource "azurerm_virtual_machine" "test" {
+  name                          = "acctestvm-%[1]d"
+  location                      = "${azurerm_resource_group.test.location}"
+  resource_group_name           = "${azurerm_resource_group.test.name}"
+  vm_size                       = "Standard_DS2_v2"
+  network_interface_ids         = ["${azurerm_network_interface.test.id}"]
+  delete_os_disk_on_termination = true
+
+  storage_image_reference {
+    publisher = "MicrosoftSQLServer"
+    offer     = "SQL2017-WS2016"
+    sku       = "SQLDEV"
+    version   = "latest"
+  }
+
+  storage_os_disk {
+    name              = "acctestosd-%[1]d"
+    managed_disk_type = "Premium_LRS"
+    caching           = "ReadWrite"
+    create_option     = "FromImage"
+  }
+
+  os_profile {
+    computer_name  = "acctestvm"
+    admin_username = "testadmin"
+    admin_password = "Password1234!"
+  }
+
+  os_profile_windows_config {
+    provision_vm_agent = true
+  }
+}
+
+resource "azurerm_recovery_services_vault" "test" {
+  name                = "acctest-%[1]d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  sku                 = "Standard"
+}
+`, rInt, location)
+}
+
+func testAccAzureRMBackupContainerVmWorkload_basic(rInt int, location string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_backup_container_vm_workload" "test" {
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  recovery_vault_name = "${azurerm_recovery_services_vault.test.name}"
+  source_vm_id        = "${azurerm_virtual_machine.test.id}"
+  workload_type       = "SQLDataBase"
+}
+`, testAccAzureRMBackupContainerVmWorkload_template(rInt, location))
+}
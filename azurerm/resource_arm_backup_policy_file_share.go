@@ -0,0 +1,306 @@
+package azurerm
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/recoveryservices/mgmt/2017-07-01/backup"
+
+	"github.com/Azure/go-autorest/autorest/date"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/suppress"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmBackupPolicyFileShare() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmBackupPolicyFileShareCreateUpdate,
+		Read:   resourceArmBackupPolicyFileShareRead,
+		Update: resourceArmBackupPolicyFileShareCreateUpdate,
+		Delete: resourceArmBackupPolicyFileShareDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringMatch(
+					regexp.MustCompile("^[a-zA-Z][-_!a-zA-Z0-9]{2,149}$"),
+					"Backup Policy name must be 3 - 150 characters long, start with a letter, contain only letters and numbers.",
+				),
+			},
+
+			"resource_group_name": resourceGroupNameSchema(),
+
+			"recovery_vault_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringMatch(
+					regexp.MustCompile("^[a-zA-Z][-a-zA-Z0-9]{1,49}$"),
+					"Recovery Service Vault name must be 2 - 50 characters long, start with a letter, contain only letters, numbers and hyphens.",
+				),
+			},
+
+			"timezone": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "UTC",
+				ValidateFunc: validation.NoZeroValues,
+			},
+
+			"backup": {
+				Type:     schema.TypeList,
+				MaxItems: 1,
+				Required: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+
+						"frequency": {
+							Type:             schema.TypeString,
+							Required:         true,
+							DiffSuppressFunc: suppress.CaseDifference,
+							ValidateFunc: validation.StringInSlice([]string{
+								string(backup.ScheduleRunTypeDaily),
+							}, true),
+						},
+
+						"time": {
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringMatch(
+								regexp.MustCompile("^([01][0-9]|[2][0-3]):([03][0])$"), //time must be on the hour or half past
+								"Time of day must match the format HH:mm where HH is 00-23 and mm is 00 or 30",
+							),
+						},
+					},
+				},
+			},
+
+			"retention_daily": {
+				Type:     schema.TypeList,
+				MaxItems: 1,
+				Required: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"count": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntBetween(1, 180),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceArmBackupPolicyFileShareCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).recoveryServicesWorkloadPoliciesClient
+	ctx := meta.(*ArmClient).StopContext
+
+	policyName := d.Get("name").(string)
+	resourceGroup := d.Get("resource_group_name").(string)
+	vaultName := d.Get("recovery_vault_name").(string)
+
+	log.Printf("[DEBUG] Creating/updating Backup Policy %q (Vault %q / Resource Group %q)", policyName, vaultName, resourceGroup)
+
+	//the time is shared between the backup schedule and the retention
+	timeOfDay := d.Get("backup.0.time").(string)
+	dateOfDay, err := time.Parse(time.RFC3339, fmt.Sprintf("2018-07-30T%s:00Z", timeOfDay))
+	if err != nil {
+		return fmt.Errorf("Error generating time from %q for Backup Policy %q (Vault %q / Resource Group %q): %+v", timeOfDay, policyName, vaultName, resourceGroup, err)
+	}
+	times := []date.Time{{Time: dateOfDay}}
+
+	policy := backup.ProtectionPolicyResource{
+		Properties: &backup.AzureFileShareProtectionPolicy{
+			BackupManagementType: backup.BackupManagementTypeAzureStorage,
+			WorkLoadType:         utils.String(string(backup.WorkloadTypeAzureFileShare)),
+			TimeZone:             utils.String(d.Get("timezone").(string)),
+			SchedulePolicy: &backup.SimpleSchedulePolicy{
+				SchedulePolicyType:   backup.SchedulePolicyTypeSimpleSchedulePolicy,
+				ScheduleRunFrequency: backup.ScheduleRunType(d.Get("backup.0.frequency").(string)),
+				ScheduleRunTimes:     &times,
+			},
+			RetentionPolicy: &backup.LongTermRetentionPolicy{
+				RetentionPolicyType: backup.RetentionPolicyTypeLongTermRetentionPolicy,
+				DailySchedule: &backup.DailyRetentionSchedule{
+					RetentionTimes: &times,
+					RetentionDuration: &backup.RetentionDuration{
+						Count:        utils.Int32(int32(d.Get("retention_daily.0.count").(int))),
+						DurationType: backup.RetentionDurationTypeDays,
+					},
+				},
+			},
+		},
+	}
+
+	if _, err := client.CreateOrUpdate(ctx, vaultName, resourceGroup, policyName, policy); err != nil {
+		return fmt.Errorf("Error creating/updating Backup Policy %q (Vault %q / Resource Group %q): %+v", policyName, vaultName, resourceGroup, err)
+	}
+
+	resp, err := resourceArmBackupPolicyFileShareWaitForState(client, ctx, true, vaultName, resourceGroup, policyName)
+	if err != nil {
+		return err
+	}
+
+	id := strings.Replace(*resp.ID, "Subscriptions", "subscriptions", 1)
+	d.SetId(id)
+
+	return resourceArmBackupPolicyFileShareRead(d, meta)
+}
+
+func resourceArmBackupPolicyFileShareRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).recoveryServicesWorkloadPoliciesClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	policyName := id.Path["backupPolicies"]
+	vaultName := id.Path["vaults"]
+	resourceGroup := id.ResourceGroup
+
+	log.Printf("[DEBUG] Reading Backup Policy %q (Vault %q / Resource Group %q)", policyName, vaultName, resourceGroup)
+
+	resp, err := client.Get(ctx, vaultName, resourceGroup, policyName)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("Error making Read request on Backup Policy %q (Vault %q / Resource Group %q): %+v", policyName, vaultName, resourceGroup, err)
+	}
+
+	d.Set("name", policyName)
+	d.Set("resource_group_name", resourceGroup)
+	d.Set("recovery_vault_name", vaultName)
+
+	if properties := resp.Properties; properties != nil {
+		if policy, ok := properties.AsAzureFileShareProtectionPolicy(); ok && policy != nil {
+			d.Set("timezone", policy.TimeZone)
+
+			if schedule, ok := policy.SchedulePolicy.AsSimpleSchedulePolicy(); ok && schedule != nil {
+				if err := d.Set("backup", flattenArmBackupPolicyFileShareSchedule(schedule)); err != nil {
+					return fmt.Errorf("Error setting `backup`: %+v", err)
+				}
+			}
+
+			if retention, ok := policy.RetentionPolicy.AsLongTermRetentionPolicy(); ok && retention != nil {
+				if err := d.Set("retention_daily", flattenArmBackupPolicyFileShareRetentionDaily(retention.DailySchedule)); err != nil {
+					return fmt.Errorf("Error setting `retention_daily`: %+v", err)
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+func resourceArmBackupPolicyFileShareDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).recoveryServicesWorkloadPoliciesClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	policyName := id.Path["backupPolicies"]
+	vaultName := id.Path["vaults"]
+	resourceGroup := id.ResourceGroup
+
+	log.Printf("[DEBUG] Deleting Backup Policy %q (Vault %q / Resource Group %q)", policyName, vaultName, resourceGroup)
+
+	resp, err := client.Delete(ctx, vaultName, resourceGroup, policyName)
+	if err != nil {
+		if !utils.ResponseWasNotFound(resp) {
+			return fmt.Errorf("Error issuing delete request for Backup Policy %q (Vault %q / Resource Group %q): %+v", policyName, vaultName, resourceGroup, err)
+		}
+	}
+
+	if _, err := resourceArmBackupPolicyFileShareWaitForState(client, ctx, false, vaultName, resourceGroup, policyName); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func flattenArmBackupPolicyFileShareSchedule(schedule *backup.SimpleSchedulePolicy) []interface{} {
+	block := map[string]interface{}{}
+
+	block["frequency"] = string(schedule.ScheduleRunFrequency)
+
+	if times := schedule.ScheduleRunTimes; times != nil && len(*times) > 0 {
+		block["time"] = (*times)[0].Format("15:04")
+	}
+
+	return []interface{}{block}
+}
+
+func flattenArmBackupPolicyFileShareRetentionDaily(daily *backup.DailyRetentionSchedule) []interface{} {
+	if daily == nil {
+		return []interface{}{}
+	}
+
+	block := map[string]interface{}{}
+
+	if duration := daily.RetentionDuration; duration != nil {
+		if v := duration.Count; v != nil {
+			block["count"] = int(*v)
+		}
+	}
+
+	return []interface{}{block}
+}
+
+func resourceArmBackupPolicyFileShareWaitForState(client backup.ProtectionPoliciesClient, ctx context.Context, found bool, vaultName, resourceGroup, policyName string) (backup.ProtectionPolicyResource, error) {
+	state := &resource.StateChangeConf{
+		Timeout:    30 * time.Minute,
+		MinTimeout: 30 * time.Second,
+		Delay:      10 * time.Second,
+		Refresh: func() (interface{}, string, error) {
+
+			resp, err := client.Get(ctx, vaultName, resourceGroup, policyName)
+			if err != nil {
+				if utils.ResponseWasNotFound(resp.Response) {
+					return resp, "NotFound", nil
+				}
+
+				return resp, "Error", fmt.Errorf("Error making Read request on Backup Policy %q (Vault %q / Resource Group %q): %+v", policyName, vaultName, resourceGroup, err)
+			}
+
+			return resp, "Found", nil
+		},
+	}
+
+	if found {
+		state.Pending = []string{"NotFound"}
+		state.Target = []string{"Found"}
+	} else {
+		state.Pending = []string{"Found"}
+		state.Target = []string{"NotFound"}
+	}
+
+	resp, err := state.WaitForState()
+	if err != nil {
+		return resp.(backup.ProtectionPolicyResource), fmt.Errorf("Error waiting for the Backup Policy %q to be %t (Vault %q / Resource Group %q): %+v", policyName, found, vaultName, resourceGroup, err)
+	}
+
+	return resp.(backup.ProtectionPolicyResource), nil
+}
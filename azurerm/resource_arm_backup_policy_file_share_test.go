@@ -0,0 +1,172 @@
+package azurerm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func TestAccAzureRMBackupPolicyFileShare_basic(t *testing.T) {
+	resourceName := "azurerm_backup_policy_file_share.test"
+	ri := acctest.RandInt()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMBackupPolicyFileShareDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMBackupPolicyFileShare_basic(ri, testLocation()),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMBackupPolicyFileShareExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "timezone", "UTC"),
+					resource.TestCheckResourceAttr(resourceName, "backup.0.frequency", "Daily"),
+					resource.TestCheckResourceAttr(resourceName, "backup.0.time", "23:00"),
+					resource.TestCheckResourceAttr(resourceName, "retention_daily.0.count", "10"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAzureRMBackupPolicyFileShare_update(t *testing.T) {
+	resourceName := "azurerm_backup_policy_file_share.test"
+	ri := acctest.RandInt()
+	location := testLocation()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMBackupPolicyFileShareDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMBackupPolicyFileShare_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMBackupPolicyFileShareExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "retention_daily.0.count", "10"),
+				),
+			},
+			{
+				Config: testAccAzureRMBackupPolicyFileShare_updated(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMBackupPolicyFileShareExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "timezone", "Pacific Standard Time"),
+					resource.TestCheckResourceAttr(resourceName, "backup.0.time", "11:30"),
+					resource.TestCheckResourceAttr(resourceName, "retention_daily.0.count", "30"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testCheckAzureRMBackupPolicyFileShareDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*ArmClient).recoveryServicesWorkloadPoliciesClient
+	ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_backup_policy_file_share" {
+			continue
+		}
+
+		resourceGroup := rs.Primary.Attributes["resource_group_name"]
+		vaultName := rs.Primary.Attributes["recovery_vault_name"]
+		policyName := rs.Primary.Attributes["name"]
+
+		resp, err := client.Get(ctx, vaultName, resourceGroup, policyName)
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return nil
+			}
+
+			return err
+		}
+
+		return fmt.Errorf("Backup Policy %q (Vault %q / Resource Group %q) still exists:\n%#v", policyName, vaultName, resourceGroup, resp)
+	}
+
+	return nil
+}
+
+func testCheckAzureRMBackupPolicyFileShareExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		resourceGroup := rs.Primary.Attributes["resource_group_name"]
+		vaultName := rs.Primary.Attributes["recovery_vault_name"]
+		policyName := rs.Primary.Attributes["name"]
+
+		client := testAccProvider.Meta().(*ArmClient).recoveryServicesWorkloadPoliciesClient
+		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+		resp, err := client.Get(ctx, vaultName, resourceGroup, policyName)
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return fmt.Errorf("Bad: Backup Policy %q (Vault %q / Resource Group %q) does not exist", policyName, vaultName, resourceGroup)
+			}
+
+			return fmt.Errorf("Bad: Get on recoveryServicesWorkloadPoliciesClient: %+v", err)
+		}
+
+		return nil
+	}
+}
+
+func testAccAzureRMBackupPolicyFileShare_basic(rInt int, location string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_backup_policy_file_share" "test" {
+  name                = "acctest-%d"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  recovery_vault_name = "${azurerm_recovery_services_vault.test.name}"
+
+  backup {
+    frequency = "Daily"
+    time      = "23:00"
+  }
+
+  retention_daily {
+    count = 10
+  }
+}
+`, testAccAzureRMBackupPolicyVmWorkload_template(rInt, location), rInt)
+}
+
+func testAccAzureRMBackupPolicyFileShare_updated(rInt int, location string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_backup_policy_file_share" "test" {
+  name                = "acctest-%d"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  recovery_vault_name = "${azurerm_recovery_services_vault.test.name}"
+  timezone            = "Pacific Standard Time"
+
+  backup {
+    frequency = "Daily"
+    time      = "11:30"
+  }
+
+  retention_daily {
+    count = 30
+  }
+}
+`, testAccAzureRMBackupPolicyVmWorkload_template(rInt, location), rInt)
+}
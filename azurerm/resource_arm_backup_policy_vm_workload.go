@@ -0,0 +1,686 @@
+package azurerm
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/recoveryservices/mgmt/2017-07-01/backup"
+
+	"github.com/Azure/go-autorest/autorest/date"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/set"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/suppress"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+const (
+	backupPolicyVmWorkloadPolicyTypeFull         = "Full"
+	backupPolicyVmWorkloadPolicyTypeDifferential = "Differential"
+	backupPolicyVmWorkloadPolicyTypeLog          = "Log"
+)
+
+func resourceArmBackupPolicyVmWorkload() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmBackupPolicyVmWorkloadCreateUpdate,
+		Read:   resourceArmBackupPolicyVmWorkloadRead,
+		Update: resourceArmBackupPolicyVmWorkloadCreateUpdate,
+		Delete: resourceArmBackupPolicyVmWorkloadDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringMatch(
+					regexp.MustCompile("^[a-zA-Z][-_!a-zA-Z0-9]{2,149}$"),
+					"Backup Policy name must be 3 - 150 characters long, start with a letter, contain only letters and numbers.",
+				),
+			},
+
+			"resource_group_name": resourceGroupNameSchema(),
+
+			"recovery_vault_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringMatch(
+					regexp.MustCompile("^[a-zA-Z][-a-zA-Z0-9]{1,49}$"),
+					"Recovery Service Vault name must be 2 - 50 characters long, start with a letter, contain only letters, numbers and hyphens.",
+				),
+			},
+
+			"workload_type": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(backup.WorkloadTypeSQLDataBase),
+					string(backup.WorkloadTypeSAPHanaDatabase),
+				}, false),
+			},
+
+			"settings": {
+				Type:     schema.TypeList,
+				MaxItems: 1,
+				Required: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"time_zone": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.NoZeroValues,
+						},
+
+						"compression_enabled": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+					},
+				},
+			},
+
+			"protection_policy": {
+				Type:     schema.TypeSet,
+				Required: true,
+				MinItems: 1,
+				MaxItems: 3,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"policy_type": {
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								backupPolicyVmWorkloadPolicyTypeFull,
+								backupPolicyVmWorkloadPolicyTypeDifferential,
+								backupPolicyVmWorkloadPolicyTypeLog,
+							}, false),
+						},
+
+						"backup": {
+							Type:     schema.TypeList,
+							MaxItems: 1,
+							Required: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									// Full & Differential
+									"frequency": {
+										Type:             schema.TypeString,
+										Optional:         true,
+										DiffSuppressFunc: suppress.CaseDifference,
+										ValidateFunc: validation.StringInSlice([]string{
+											string(backup.ScheduleRunTypeDaily),
+											string(backup.ScheduleRunTypeWeekly),
+										}, true),
+									},
+
+									"time": {
+										Type:     schema.TypeString,
+										Optional: true,
+										ValidateFunc: validation.StringMatch(
+											regexp.MustCompile("^([01][0-9]|[2][0-3]):([03][0])$"), //time must be on the hour or half past
+											"Time of day must match the format HH:mm where HH is 00-23 and mm is 00 or 30",
+										),
+									},
+
+									"weekdays": {
+										Type:     schema.TypeSet,
+										Optional: true,
+										Set:      set.HashStringIgnoreCase,
+										Elem: &schema.Schema{
+											Type:             schema.TypeString,
+											DiffSuppressFunc: suppress.CaseDifference,
+											ValidateFunc:     validate.DayOfTheWeek(true),
+										},
+									},
+
+									// Log
+									"frequency_in_minutes": {
+										Type:     schema.TypeInt,
+										Optional: true,
+										ValidateFunc: validateIntInSlice([]int{
+											15,
+											30,
+											60,
+											120,
+											240,
+											480,
+											720,
+											1440,
+										}),
+									},
+								},
+							},
+						},
+
+						"retention_daily": {
+							Type:     schema.TypeList,
+							MaxItems: 1,
+							Optional: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"count": {
+										Type:         schema.TypeInt,
+										Required:     true,
+										ValidateFunc: validation.IntBetween(7, 9999),
+									},
+								},
+							},
+						},
+
+						"retention_weekly": {
+							Type:     schema.TypeList,
+							MaxItems: 1,
+							Optional: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"count": {
+										Type:         schema.TypeInt,
+										Required:     true,
+										ValidateFunc: validation.IntBetween(1, 5163),
+									},
+
+									"weekdays": {
+										Type:     schema.TypeSet,
+										Required: true,
+										Set:      set.HashStringIgnoreCase,
+										Elem: &schema.Schema{
+											Type:             schema.TypeString,
+											DiffSuppressFunc: suppress.CaseDifference,
+											ValidateFunc:     validate.DayOfTheWeek(true),
+										},
+									},
+								},
+							},
+						},
+
+						// Differential & Log
+						"simple_retention": {
+							Type:     schema.TypeList,
+							MaxItems: 1,
+							Optional: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"count": {
+										Type:         schema.TypeInt,
+										Required:     true,
+										ValidateFunc: validation.IntBetween(7, 35),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceArmBackupPolicyVmWorkloadCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).recoveryServicesWorkloadPoliciesClient
+	ctx := meta.(*ArmClient).StopContext
+
+	policyName := d.Get("name").(string)
+	resourceGroup := d.Get("resource_group_name").(string)
+	vaultName := d.Get("recovery_vault_name").(string)
+	workloadType := d.Get("workload_type").(string)
+
+	log.Printf("[DEBUG] Creating/updating Backup Policy %q (Vault %q / Resource Group %q)", policyName, vaultName, resourceGroup)
+
+	subPolicies, err := expandArmBackupPolicyVmWorkloadProtectionPolicies(d.Get("protection_policy").(*schema.Set).List())
+	if err != nil {
+		return fmt.Errorf("Error expanding `protection_policy` for Backup Policy %q (Vault %q / Resource Group %q): %+v", policyName, vaultName, resourceGroup, err)
+	}
+
+	policy := backup.ProtectionPolicyResource{
+		Properties: &backup.AzureVMWorkloadProtectionPolicy{
+			BackupManagementType: backup.BackupManagementTypeAzureWorkload,
+			WorkLoadType:         utils.String(workloadType),
+			Settings:             expandArmBackupPolicyVmWorkloadSettings(d.Get("settings").([]interface{})),
+			SubProtectionPolicy:  subPolicies,
+		},
+	}
+
+	if _, err := client.CreateOrUpdate(ctx, vaultName, resourceGroup, policyName, policy); err != nil {
+		return fmt.Errorf("Error creating/updating Backup Policy %q (Vault %q / Resource Group %q): %+v", policyName, vaultName, resourceGroup, err)
+	}
+
+	resp, err := resourceArmBackupPolicyVmWorkloadWaitForState(client, ctx, true, vaultName, resourceGroup, policyName)
+	if err != nil {
+		return err
+	}
+
+	id := strings.Replace(*resp.ID, "Subscriptions", "subscriptions", 1)
+	d.SetId(id)
+
+	return resourceArmBackupPolicyVmWorkloadRead(d, meta)
+}
+
+func resourceArmBackupPolicyVmWorkloadRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).recoveryServicesWorkloadPoliciesClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	policyName := id.Path["backupPolicies"]
+	vaultName := id.Path["vaults"]
+	resourceGroup := id.ResourceGroup
+
+	log.Printf("[DEBUG] Reading Backup Policy %q (Vault %q / Resource Group %q)", policyName, vaultName, resourceGroup)
+
+	resp, err := client.Get(ctx, vaultName, resourceGroup, policyName)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("Error making Read request on Backup Policy %q (Vault %q / Resource Group %q): %+v", policyName, vaultName, resourceGroup, err)
+	}
+
+	d.Set("name", policyName)
+	d.Set("resource_group_name", resourceGroup)
+	d.Set("recovery_vault_name", vaultName)
+
+	if properties := resp.Properties; properties != nil {
+		if policy, ok := properties.AsAzureVMWorkloadProtectionPolicy(); ok && policy != nil {
+			d.Set("workload_type", policy.WorkLoadType)
+
+			if err := d.Set("settings", flattenArmBackupPolicyVmWorkloadSettings(policy.Settings)); err != nil {
+				return fmt.Errorf("Error setting `settings`: %+v", err)
+			}
+
+			if err := d.Set("protection_policy", flattenArmBackupPolicyVmWorkloadProtectionPolicies(policy.SubProtectionPolicy)); err != nil {
+				return fmt.Errorf("Error setting `protection_policy`: %+v", err)
+			}
+		}
+	}
+
+	return nil
+}
+
+func resourceArmBackupPolicyVmWorkloadDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).recoveryServicesWorkloadPoliciesClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	policyName := id.Path["backupPolicies"]
+	vaultName := id.Path["vaults"]
+	resourceGroup := id.ResourceGroup
+
+	log.Printf("[DEBUG] Deleting Backup Policy %q (Vault %q / Resource Group %q)", policyName, vaultName, resourceGroup)
+
+	resp, err := client.Delete(ctx, vaultName, resourceGroup, policyName)
+	if err != nil {
+		if !utils.ResponseWasNotFound(resp) {
+			return fmt.Errorf("Error issuing delete request for Backup Policy %q (Vault %q / Resource Group %q): %+v", policyName, vaultName, resourceGroup, err)
+		}
+	}
+
+	if _, err := resourceArmBackupPolicyVmWorkloadWaitForState(client, ctx, false, vaultName, resourceGroup, policyName); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func expandArmBackupPolicyVmWorkloadSettings(input []interface{}) *backup.Settings {
+	if len(input) == 0 || input[0] == nil {
+		return nil
+	}
+
+	block := input[0].(map[string]interface{})
+	compression := block["compression_enabled"].(bool)
+
+	return &backup.Settings{
+		TimeZone:         utils.String(block["time_zone"].(string)),
+		Issqlcompression: utils.Bool(compression),
+		IsCompression:    utils.Bool(compression),
+	}
+}
+
+func expandArmBackupPolicyVmWorkloadProtectionPolicies(input []interface{}) (*[]backup.SubProtectionPolicy, error) {
+	policies := make([]backup.SubProtectionPolicy, 0)
+	seen := make(map[string]bool)
+
+	for _, v := range input {
+		block := v.(map[string]interface{})
+		policyType := block["policy_type"].(string)
+
+		if seen[policyType] {
+			return nil, fmt.Errorf("only one `protection_policy` with a `policy_type` of %q can be specified", policyType)
+		}
+		seen[policyType] = true
+
+		backupBlock := make(map[string]interface{})
+		if bb := block["backup"].([]interface{}); len(bb) > 0 && bb[0] != nil {
+			backupBlock = bb[0].(map[string]interface{})
+		}
+
+		dailyRetention := block["retention_daily"].([]interface{})
+		weeklyRetention := block["retention_weekly"].([]interface{})
+		simpleRetention := block["simple_retention"].([]interface{})
+
+		policy := backup.SubProtectionPolicy{
+			PolicyType: utils.String(policyType),
+		}
+
+		switch policyType {
+		case backupPolicyVmWorkloadPolicyTypeLog:
+			frequency := backupBlock["frequency_in_minutes"].(int)
+			if frequency == 0 {
+				return nil, fmt.Errorf("`backup.0.frequency_in_minutes` must be set for a `Log` protection policy")
+			}
+			if len(simpleRetention) == 0 {
+				return nil, fmt.Errorf("`simple_retention` must be set for a `Log` protection policy")
+			}
+
+			policy.SchedulePolicy = &backup.LogSchedulePolicy{
+				SchedulePolicyType:      backup.SchedulePolicyTypeLogSchedulePolicy,
+				ScheduleFrequencyInMins: utils.Int32(int32(frequency)),
+			}
+			policy.RetentionPolicy = expandArmBackupPolicyVmWorkloadSimpleRetention(simpleRetention)
+
+		case backupPolicyVmWorkloadPolicyTypeDifferential:
+			if !strings.EqualFold(backupBlock["frequency"].(string), string(backup.ScheduleRunTypeWeekly)) {
+				return nil, fmt.Errorf("`backup.0.frequency` must be `Weekly` for a `Differential` protection policy")
+			}
+			if len(simpleRetention) == 0 {
+				return nil, fmt.Errorf("`simple_retention` must be set for a `Differential` protection policy")
+			}
+
+			times, err := expandArmBackupPolicyVmWorkloadTimes(backupBlock)
+			if err != nil {
+				return nil, err
+			}
+
+			policy.SchedulePolicy = expandArmBackupPolicyVmWorkloadSchedule(backupBlock, times)
+			policy.RetentionPolicy = expandArmBackupPolicyVmWorkloadSimpleRetention(simpleRetention)
+
+		default:
+			frequency := backupBlock["frequency"].(string)
+			if strings.EqualFold(frequency, string(backup.ScheduleRunTypeDaily)) && len(dailyRetention) == 0 {
+				return nil, fmt.Errorf("`retention_daily` must be set when `backup.0.frequency` is `Daily`")
+			}
+			if strings.EqualFold(frequency, string(backup.ScheduleRunTypeWeekly)) && len(weeklyRetention) == 0 {
+				return nil, fmt.Errorf("`retention_weekly` must be set when `backup.0.frequency` is `Weekly`")
+			}
+
+			times, err := expandArmBackupPolicyVmWorkloadTimes(backupBlock)
+			if err != nil {
+				return nil, err
+			}
+
+			policy.SchedulePolicy = expandArmBackupPolicyVmWorkloadSchedule(backupBlock, times)
+			policy.RetentionPolicy = &backup.LongTermRetentionPolicy{
+				RetentionPolicyType: backup.RetentionPolicyTypeLongTermRetentionPolicy,
+				DailySchedule:       expandArmBackupPolicyVmWorkloadRetentionDaily(dailyRetention, times),
+				WeeklySchedule:      expandArmBackupPolicyVmWorkloadRetentionWeekly(weeklyRetention, times),
+			}
+		}
+
+		policies = append(policies, policy)
+	}
+
+	if !seen[backupPolicyVmWorkloadPolicyTypeFull] {
+		return nil, fmt.Errorf("a `protection_policy` with a `policy_type` of `Full` must be specified")
+	}
+
+	return &policies, nil
+}
+
+func expandArmBackupPolicyVmWorkloadTimes(block map[string]interface{}) ([]date.Time, error) {
+	timeOfDay := block["time"].(string)
+	if timeOfDay == "" {
+		return nil, fmt.Errorf("`backup.0.time` must be set for `Full` and `Differential` protection policies")
+	}
+
+	dateOfDay, err := time.Parse(time.RFC3339, fmt.Sprintf("2018-07-30T%s:00Z", timeOfDay))
+	if err != nil {
+		return nil, fmt.Errorf("Error generating time from %q: %+v", timeOfDay, err)
+	}
+
+	return []date.Time{{Time: dateOfDay}}, nil
+}
+
+func expandArmBackupPolicyVmWorkloadSchedule(block map[string]interface{}, times []date.Time) *backup.SimpleSchedulePolicy {
+	schedule := backup.SimpleSchedulePolicy{
+		SchedulePolicyType:   backup.SchedulePolicyTypeSimpleSchedulePolicy,
+		ScheduleRunFrequency: backup.ScheduleRunType(block["frequency"].(string)),
+		ScheduleRunTimes:     &times,
+	}
+
+	if v, ok := block["weekdays"].(*schema.Set); ok && v.Len() > 0 {
+		days := make([]backup.DayOfWeek, 0)
+		for _, day := range v.List() {
+			days = append(days, backup.DayOfWeek(day.(string)))
+		}
+		schedule.ScheduleRunDays = &days
+	}
+
+	return &schedule
+}
+
+func expandArmBackupPolicyVmWorkloadRetentionDaily(input []interface{}, times []date.Time) *backup.DailyRetentionSchedule {
+	if len(input) == 0 || input[0] == nil {
+		return nil
+	}
+
+	block := input[0].(map[string]interface{})
+
+	return &backup.DailyRetentionSchedule{
+		RetentionTimes: &times,
+		RetentionDuration: &backup.RetentionDuration{
+			Count:        utils.Int32(int32(block["count"].(int))),
+			DurationType: backup.RetentionDurationTypeDays,
+		},
+	}
+}
+
+func expandArmBackupPolicyVmWorkloadRetentionWeekly(input []interface{}, times []date.Time) *backup.WeeklyRetentionSchedule {
+	if len(input) == 0 || input[0] == nil {
+		return nil
+	}
+
+	block := input[0].(map[string]interface{})
+
+	retention := backup.WeeklyRetentionSchedule{
+		RetentionTimes: &times,
+		RetentionDuration: &backup.RetentionDuration{
+			Count:        utils.Int32(int32(block["count"].(int))),
+			DurationType: backup.RetentionDurationTypeWeeks,
+		},
+	}
+
+	if v, ok := block["weekdays"].(*schema.Set); ok {
+		days := make([]backup.DayOfWeek, 0)
+		for _, day := range v.List() {
+			days = append(days, backup.DayOfWeek(day.(string)))
+		}
+		retention.DaysOfTheWeek = &days
+	}
+
+	return &retention
+}
+
+func expandArmBackupPolicyVmWorkloadSimpleRetention(input []interface{}) *backup.SimpleRetentionPolicy {
+	block := input[0].(map[string]interface{})
+
+	return &backup.SimpleRetentionPolicy{
+		RetentionPolicyType: backup.RetentionPolicyTypeSimpleRetentionPolicy,
+		RetentionDuration: &backup.RetentionDuration{
+			Count:        utils.Int32(int32(block["count"].(int))),
+			DurationType: backup.RetentionDurationTypeDays,
+		},
+	}
+}
+
+func flattenArmBackupPolicyVmWorkloadSettings(input *backup.Settings) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+
+	block := make(map[string]interface{})
+
+	if v := input.TimeZone; v != nil {
+		block["time_zone"] = *v
+	}
+
+	compression := false
+	if v := input.IsCompression; v != nil {
+		compression = *v
+	} else if v := input.Issqlcompression; v != nil {
+		compression = *v
+	}
+	block["compression_enabled"] = compression
+
+	return []interface{}{block}
+}
+
+func flattenArmBackupPolicyVmWorkloadProtectionPolicies(input *[]backup.SubProtectionPolicy) []interface{} {
+	results := make([]interface{}, 0)
+	if input == nil {
+		return results
+	}
+
+	for _, policy := range *input {
+		block := map[string]interface{}{
+			"retention_daily":  []interface{}{},
+			"retention_weekly": []interface{}{},
+			"simple_retention": []interface{}{},
+		}
+
+		if v := policy.PolicyType; v != nil {
+			block["policy_type"] = *v
+		}
+
+		backupBlock := map[string]interface{}{}
+		if schedule := policy.SchedulePolicy; schedule != nil {
+			if simple, ok := schedule.AsSimpleSchedulePolicy(); ok && simple != nil {
+				backupBlock["frequency"] = string(simple.ScheduleRunFrequency)
+
+				if times := simple.ScheduleRunTimes; times != nil && len(*times) > 0 {
+					backupBlock["time"] = (*times)[0].Format("15:04")
+				}
+
+				weekdays := make([]interface{}, 0)
+				if days := simple.ScheduleRunDays; days != nil {
+					for _, d := range *days {
+						weekdays = append(weekdays, string(d))
+					}
+				}
+				backupBlock["weekdays"] = schema.NewSet(set.HashStringIgnoreCase, weekdays)
+			}
+
+			if logSchedule, ok := schedule.AsLogSchedulePolicy(); ok && logSchedule != nil {
+				if v := logSchedule.ScheduleFrequencyInMins; v != nil {
+					backupBlock["frequency_in_minutes"] = int(*v)
+				}
+			}
+		}
+		block["backup"] = []interface{}{backupBlock}
+
+		if retention := policy.RetentionPolicy; retention != nil {
+			if longTerm, ok := retention.AsLongTermRetentionPolicy(); ok && longTerm != nil {
+				if daily := longTerm.DailySchedule; daily != nil {
+					block["retention_daily"] = []interface{}{
+						map[string]interface{}{
+							"count": flattenArmBackupPolicyVmWorkloadRetentionCount(daily.RetentionDuration),
+						},
+					}
+				}
+
+				if weekly := longTerm.WeeklySchedule; weekly != nil {
+					weekdays := make([]interface{}, 0)
+					if days := weekly.DaysOfTheWeek; days != nil {
+						for _, d := range *days {
+							weekdays = append(weekdays, string(d))
+						}
+					}
+
+					block["retention_weekly"] = []interface{}{
+						map[string]interface{}{
+							"count":    flattenArmBackupPolicyVmWorkloadRetentionCount(weekly.RetentionDuration),
+							"weekdays": schema.NewSet(set.HashStringIgnoreCase, weekdays),
+						},
+					}
+				}
+			}
+
+			if simple, ok := retention.AsSimpleRetentionPolicy(); ok && simple != nil {
+				block["simple_retention"] = []interface{}{
+					map[string]interface{}{
+						"count": flattenArmBackupPolicyVmWorkloadRetentionCount(simple.RetentionDuration),
+					},
+				}
+			}
+		}
+
+		results = append(results, block)
+	}
+
+	return results
+}
+
+func flattenArmBackupPolicyVmWorkloadRetentionCount(input *backup.RetentionDuration) int {
+	if input == nil || input.Count == nil {
+		return 0
+	}
+
+	return int(*input.Count)
+}
+
+func resourceArmBackupPolicyVmWorkloadWaitForState(client backup.ProtectionPoliciesClient, ctx context.Context, found bool, vaultName, resourceGroup, policyName string) (backup.ProtectionPolicyResource, error) {
+	state := &resource.StateChangeConf{
+		Timeout:    30 * time.Minute,
+		MinTimeout: 30 * time.Second,
+		Delay:      10 * time.Second,
+		Refresh: func() (interface{}, string, error) {
+
+			resp, err := client.Get(ctx, vaultName, resourceGroup, policyName)
+			if err != nil {
+				if utils.ResponseWasNotFound(resp.Response) {
+					return resp, "NotFound", nil
+				}
+
+				return resp, "Error", fmt.Errorf("Error making Read request on Backup Policy %q (Vault %q / Resource Group %q): %+v", policyName, vaultName, resourceGroup, err)
+			}
+
+			return resp, "Found", nil
+		},
+	}
+
+	if found {
+		state.Pending = []string{"NotFound"}
+		state.Target = []string{"Found"}
+	} else {
+		state.Pending = []string{"Found"}
+		state.Target = []string{"NotFound"}
+	}
+
+	resp, err := state.WaitForState()
+	if err != nil {
+		return resp.(backup.ProtectionPolicyResource), fmt.Errorf("Error waiting for the Backup Policy %q to be %t (Vault %q / Resource Group %q): %+v", policyName, found, vaultName, resourceGroup, err)
+	}
+
+	return resp.(backup.ProtectionPolicyResource), nil
+}
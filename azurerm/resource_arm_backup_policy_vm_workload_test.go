@@ -0,0 +1,336 @@
+package azurerm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func TestAccAzureRMBackupPolicyVmWorkload_sqlBasic(t *testing.T) {
+	resourceName := "azurerm_backup_policy_vm_workload.test"
+	ri := acctest.RandInt()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMBackupPolicyVmWorkloadDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMBackupPolicyVmWorkload_sqlBasic(ri, testLocation()),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMBackupPolicyVmWorkloadExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "workload_type", "SQLDataBase"),
+					resource.TestCheckResourceAttr(resourceName, "settings.0.time_zone", "UTC"),
+					resource.TestCheckResourceAttr(resourceName, "protection_policy.#", "2"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAzureRMBackupPolicyVmWorkload_sqlUpdate(t *testing.T) {
+	resourceName := "azurerm_backup_policy_vm_workload.test"
+	ri := acctest.RandInt()
+	location := testLocation()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMBackupPolicyVmWorkloadDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMBackupPolicyVmWorkload_sqlBasic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMBackupPolicyVmWorkloadExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "settings.0.compression_enabled", "false"),
+					resource.TestCheckResourceAttr(resourceName, "protection_policy.#", "2"),
+				),
+			},
+			{
+				Config: testAccAzureRMBackupPolicyVmWorkload_sqlComplete(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMBackupPolicyVmWorkloadExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "settings.0.compression_enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "protection_policy.#", "3"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAzureRMBackupPolicyVmWorkload_sqlBasic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMBackupPolicyVmWorkloadExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "protection_policy.#", "2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMBackupPolicyVmWorkload_sapHana(t *testing.T) {
+	resourceName := "azurerm_backup_policy_vm_workload.test"
+	ri := acctest.RandInt()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMBackupPolicyVmWorkloadDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMBackupPolicyVmWorkload_sapHana(ri, testLocation()),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMBackupPolicyVmWorkloadExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "workload_type", "SAPHanaDatabase"),
+					resource.TestCheckResourceAttr(resourceName, "protection_policy.#", "3"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testCheckAzureRMBackupPolicyVmWorkloadDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*ArmClient).recoveryServicesWorkloadPoliciesClient
+	ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_backup_policy_vm_workload" {
+			continue
+		}
+
+		resourceGroup := rs.Primary.Attributes["resource_group_name"]
+		vaultName := rs.Primary.Attributes["recovery_vault_name"]
+		policyName := rs.Primary.Attributes["name"]
+
+		resp, err := client.Get(ctx, vaultName, resourceGroup, policyName)
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return nil
+			}
+
+			return err
+		}
+
+		return fmt.Errorf("Backup Policy %q (Vault %q / Resource Group %q) still exists:\n%#v", policyName, vaultName, resourceGroup, resp)
+	}
+
+	return nil
+}
+
+func testCheckAzureRMBackupPolicyVmWorkloadExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		resourceGroup := rs.Primary.Attributes["resource_group_name"]
+		vaultName := rs.Primary.Attributes["recovery_vault_name"]
+		policyName := rs.Primary.Attributes["name"]
+
+		client := testAccProvider.Meta().(*ArmClient).recoveryServicesWorkloadPoliciesClient
+		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+		resp, err := client.Get(ctx, vaultName, resourceGroup, policyName)
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return fmt.Errorf("Bad: Backup Policy %q (Vault %q / Resource Group %q) does not exist", policyName, vaultName, resourceGroup)
+			}
+
+			return fmt.Errorf("Bad: Get on recoveryServicesWorkloadPoliciesClient: %+v", err)
+		}
+
+		return nil
+	}
+}
+
+func testAccAzureRMBackupPolicyVmWorkload_template(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_recovery_services_vault" "test" {
+  name                = "acctest-%d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  sku                 = "Standard"
+}
+`, rInt, location, rInt)
+}
+
+func testAccAzureRMBackupPolicyVmWorkload_sqlBasic(rInt int, location string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_backup_policy_vm_workload" "test" {
+  name                = "acctest-%d"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  recovery_vault_name = "${azurerm_recovery_services_vault.test.name}"
+  workload_type       = "SQLDataBase"
+
+  settings {
+    time_zone = "UTC"
+  }
+
+  protection_policy {
+    policy_type = "Full"
+
+    backup {
+      frequency = "Daily"
+      time      = "15:00"
+    }
+
+    retention_daily {
+      count = 8
+    }
+  }
+
+  protection_policy {
+    policy_type = "Log"
+
+    backup {
+      frequency_in_minutes = 15
+    }
+
+    simple_retention {
+      count = 8
+    }
+  }
+}
+`, testAccAzureRMBackupPolicyVmWorkload_template(rInt, location), rInt)
+}
+
+func testAccAzureRMBackupPolicyVmWorkload_sqlComplete(rInt int, location string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_backup_policy_vm_workload" "test" {
+  name                = "acctest-%d"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  recovery_vault_name = "${azurerm_recovery_services_vault.test.name}"
+  workload_type       = "SQLDataBase"
+
+  settings {
+    time_zone           = "UTC"
+    compression_enabled = true
+  }
+
+  protection_policy {
+    policy_type = "Full"
+
+    backup {
+      frequency = "Weekly"
+      time      = "15:00"
+      weekdays  = ["Sunday"]
+    }
+
+    retention_weekly {
+      count    = 12
+      weekdays = ["Sunday"]
+    }
+  }
+
+  protection_policy {
+    policy_type = "Differential"
+
+    backup {
+      frequency = "Weekly"
+      time      = "15:00"
+      weekdays  = ["Wednesday", "Friday"]
+    }
+
+    simple_retention {
+      count = 14
+    }
+  }
+
+  protection_policy {
+    policy_type = "Log"
+
+    backup {
+      frequency_in_minutes = 60
+    }
+
+    simple_retention {
+      count = 14
+    }
+  }
+}
+`, testAccAzureRMBackupPolicyVmWorkload_template(rInt, location), rInt)
+}
+
+func testAccAzureRMBackupPolicyVmWorkload_sapHana(rInt int, location string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_backup_policy_vm_workload" "test" {
+  name                = "acctest-%d"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  recovery_vault_name = "${azurerm_recovery_services_vault.test.name}"
+  workload_type       = "SAPHanaDatabase"
+
+  settings {
+    time_zone = "UTC"
+  }
+
+  protection_policy {
+    policy_type = "Full"
+
+    backup {
+      frequency = "Weekly"
+      time      = "15:00"
+      weekdays  = ["Monday"]
+    }
+
+    retention_weekly {
+      count    = 10
+      weekdays = ["Monday"]
+    }
+  }
+
+  protection_policy {
+    policy_type = "Differential"
+
+    backup {
+      frequency = "Weekly"
+      time      = "15:00"
+      weekdays  = ["Thursday"]
+    }
+
+    simple_retention {
+      count = 10
+    }
+  }
+
+  protection_policy {
+    policy_type = "Log"
+
+    backup {
+      frequency_in_minutes = 120
+    }
+
+    simple_retention {
+      count = 10
+    }
+  }
+}
+`, testAccAzureRMBackupPolicyVmWorkload_template(rInt, location), rInt)
+}
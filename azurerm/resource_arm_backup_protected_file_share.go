@@ -0,0 +1,282 @@
+package azurerm
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/recoveryservices/mgmt/2017-07-01/backup"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmBackupProtectedFileShare() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmBackupProtectedFileShareCreateUpdate,
+		Read:   resourceArmBackupProtectedFileShareRead,
+		Update: resourceArmBackupProtectedFileShareCreateUpdate,
+		Delete: resourceArmBackupProtectedFileShareDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+
+			"resource_group_name": resourceGroupNameSchema(),
+
+			"recovery_vault_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringMatch(
+					regexp.MustCompile("^[a-zA-Z][-a-zA-Z0-9]{1,49}$"),
+					"Recovery Service Vault name must be 2 - 50 characters long, start with a letter, contain only letters, numbers and hyphens.",
+				),
+			},
+
+			"source_storage_account_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: azure.ValidateResourceID,
+			},
+
+			"source_file_share_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateArmStorageShareName,
+			},
+
+			"backup_policy_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: azure.ValidateResourceID,
+			},
+
+			"protection_state": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceArmBackupProtectedFileShareCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).recoveryServicesWorkloadProtectedItemsClient
+	ctx := meta.(*ArmClient).StopContext
+
+	resourceGroup := d.Get("resource_group_name").(string)
+	vaultName := d.Get("recovery_vault_name").(string)
+	storageAccountId := d.Get("source_storage_account_id").(string)
+	fileShareName := d.Get("source_file_share_name").(string)
+	policyId := d.Get("backup_policy_id").(string)
+
+	containerName, err := backupContainerStorageAccountName(storageAccountId)
+	if err != nil {
+		return err
+	}
+
+	var protectedItemName string
+	if d.IsNewResource() {
+		// the name of the Protected Item is assigned by Azure Backup when the file shares in the container are discovered
+		protectedItemName, err = resourceArmBackupProtectedFileShareFindProtectableItem(meta, ctx, vaultName, resourceGroup, containerName, fileShareName)
+		if err != nil {
+			return err
+		}
+	} else {
+		id, err := parseAzureResourceID(d.Id())
+		if err != nil {
+			return err
+		}
+		protectedItemName = id.Path["protectedItems"]
+	}
+
+	log.Printf("[DEBUG] Creating/updating Backup Protected File Share %q (Vault %q / Resource Group %q)", protectedItemName, vaultName, resourceGroup)
+
+	item := backup.ProtectedItemResource{
+		Properties: &backup.AzureFileshareProtectedItem{
+			ProtectedItemType: backup.ProtectedItemTypeAzureFileShareProtectedItem,
+			WorkloadType:      backup.DataSourceTypeAzureFileShare,
+			SourceResourceID:  utils.String(storageAccountId),
+			PolicyID:          utils.String(policyId),
+		},
+	}
+
+	if _, err := client.CreateOrUpdate(ctx, vaultName, resourceGroup, "Azure", containerName, protectedItemName, item); err != nil {
+		return fmt.Errorf("Error creating/updating Backup Protected File Share %q (Vault %q / Resource Group %q): %+v", protectedItemName, vaultName, resourceGroup, err)
+	}
+
+	resp, err := resourceArmBackupProtectedFileShareWaitForState(client, ctx, true, vaultName, resourceGroup, containerName, protectedItemName)
+	if err != nil {
+		return err
+	}
+
+	id := strings.Replace(*resp.ID, "Subscriptions", "subscriptions", 1)
+	d.SetId(id)
+
+	return resourceArmBackupProtectedFileShareRead(d, meta)
+}
+
+func resourceArmBackupProtectedFileShareRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).recoveryServicesWorkloadProtectedItemsClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	protectedItemName := id.Path["protectedItems"]
+	containerName := id.Path["protectionContainers"]
+	vaultName := id.Path["vaults"]
+	resourceGroup := id.ResourceGroup
+
+	log.Printf("[DEBUG] Reading Backup Protected File Share %q (Vault %q / Resource Group %q)", protectedItemName, vaultName, resourceGroup)
+
+	resp, err := client.Get(ctx, vaultName, resourceGroup, "Azure", containerName, protectedItemName, "")
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("Error making Read request on Backup Protected File Share %q (Vault %q / Resource Group %q): %+v", protectedItemName, vaultName, resourceGroup, err)
+	}
+
+	d.Set("resource_group_name", resourceGroup)
+	d.Set("recovery_vault_name", vaultName)
+
+	if properties := resp.Properties; properties != nil {
+		if item, ok := properties.AsAzureFileshareProtectedItem(); ok && item != nil {
+			d.Set("source_storage_account_id", item.SourceResourceID)
+			d.Set("source_file_share_name", item.FriendlyName)
+			d.Set("protection_state", string(item.ProtectionState))
+
+			if v := item.PolicyID; v != nil {
+				d.Set("backup_policy_id", strings.Replace(*v, "Subscriptions", "subscriptions", 1))
+			}
+		}
+	}
+
+	return nil
+}
+
+func resourceArmBackupProtectedFileShareDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).recoveryServicesWorkloadProtectedItemsClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	protectedItemName := id.Path["protectedItems"]
+	containerName := id.Path["protectionContainers"]
+	vaultName := id.Path["vaults"]
+	resourceGroup := id.ResourceGroup
+
+	log.Printf("[DEBUG] Deleting Backup Protected File Share %q (Vault %q / Resource Group %q)", protectedItemName, vaultName, resourceGroup)
+
+	resp, err := client.Delete(ctx, vaultName, resourceGroup, "Azure", containerName, protectedItemName)
+	if err != nil {
+		if !utils.ResponseWasNotFound(resp) {
+			return fmt.Errorf("Error issuing delete request for Backup Protected File Share %q (Vault %q / Resource Group %q): %+v", protectedItemName, vaultName, resourceGroup, err)
+		}
+	}
+
+	if _, err := resourceArmBackupProtectedFileShareWaitForState(client, ctx, false, vaultName, resourceGroup, containerName, protectedItemName); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// resourceArmBackupProtectedFileShareFindProtectableItem triggers an inquiry of the file shares within the
+// Storage Account container and returns the name of the protectable item matching the specified file share
+func resourceArmBackupProtectedFileShareFindProtectableItem(meta interface{}, ctx context.Context, vaultName, resourceGroup, containerName, fileShareName string) (string, error) {
+	containersClient := meta.(*ArmClient).recoveryServicesProtectionContainersClient
+	itemsClient := meta.(*ArmClient).recoveryServicesProtectableItemsClient
+
+	if _, err := containersClient.Inquire(ctx, vaultName, resourceGroup, "Azure", containerName, ""); err != nil {
+		return "", fmt.Errorf("Error inquiring the file shares within Backup Container %q (Vault %q / Resource Group %q): %+v", containerName, vaultName, resourceGroup, err)
+	}
+
+	filter := fmt.Sprintf("backupManagementType eq '%s'", string(backup.ManagementTypeAzureStorage))
+	containerSegment := strings.ToLower(fmt.Sprintf("/protectionContainers/%s/", containerName))
+
+	var protectableItemName string
+	err := resource.Retry(30*time.Minute, func() *resource.RetryError {
+		items, err := itemsClient.ListComplete(ctx, vaultName, resourceGroup, filter, "")
+		if err != nil {
+			return resource.NonRetryableError(fmt.Errorf("Error listing Protectable Items (Vault %q / Resource Group %q): %+v", vaultName, resourceGroup, err))
+		}
+
+		for items.NotDone() {
+			item := items.Value()
+			if item.ID != nil && item.Name != nil && strings.Contains(strings.ToLower(*item.ID), containerSegment) {
+				if share, ok := item.Properties.AsAzureFileShareProtectableItem(); ok && share != nil {
+					if share.FriendlyName != nil && strings.EqualFold(*share.FriendlyName, fileShareName) {
+						protectableItemName = *item.Name
+						return nil
+					}
+				}
+			}
+
+			if err := items.Next(); err != nil {
+				return resource.NonRetryableError(fmt.Errorf("Error listing Protectable Items (Vault %q / Resource Group %q): %+v", vaultName, resourceGroup, err))
+			}
+		}
+
+		return resource.RetryableError(fmt.Errorf("File Share %q was not found in Backup Container %q (Vault %q / Resource Group %q)", fileShareName, containerName, vaultName, resourceGroup))
+	})
+	if err != nil {
+		return "", err
+	}
+
+	return protectableItemName, nil
+}
+
+func resourceArmBackupProtectedFileShareWaitForState(client backup.ProtectedItemsGroupClient, ctx context.Context, found bool, vaultName, resourceGroup, containerName, protectedItemName string) (backup.ProtectedItemResource, error) {
+	state := &resource.StateChangeConf{
+		Timeout:    30 * time.Minute,
+		MinTimeout: 30 * time.Second,
+		Delay:      10 * time.Second,
+		Refresh: func() (interface{}, string, error) {
+
+			resp, err := client.Get(ctx, vaultName, resourceGroup, "Azure", containerName, protectedItemName, "")
+			if err != nil {
+				if utils.ResponseWasNotFound(resp.Response) {
+					return resp, "NotFound", nil
+				}
+
+				return resp, "Error", fmt.Errorf("Error making Read request on Backup Protected File Share %q (Vault %q / Resource Group %q): %+v", protectedItemName, vaultName, resourceGroup, err)
+			}
+
+			return resp, "Found", nil
+		},
+	}
+
+	if found {
+		state.Pending = []string{"NotFound"}
+		state.Target = []string{"Found"}
+	} else {
+		state.Pending = []string{"Found"}
+		state.Target = []string{"NotFound"}
+	}
+
+	resp, err := state.WaitForState()
+	if err != nil {
+		return resp.(backup.ProtectedItemResource), fmt.Errorf("Error waiting for the Backup Protected File Share %q to be %t (Vault %q / Resource Group %q): %+v", protectedItemName, found, vaultName, resourceGroup, err)
+	}
+
+	return resp.(backup.ProtectedItemResource), nil
+}
@@ -0,0 +1,150 @@
+package azurerm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func TestAccAzureRMBackupProtectedFileShare_basic(t *testing.T) {
+	resourceName := "azurerm_backup_protected_file_share.test"
+	ri := acctest.RandInt()
+	rs := acctest.RandString(4)
+	location := testLocation()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMBackupProtectedFileShareDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMBackupProtectedFileShare_basic(ri, rs, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMBackupProtectedFileShareExists(resourceName),
+					resource.TestCheckResourceAttrSet(resourceName, "protection_state"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{ //the container cannot be unregistered whilst file shares are protected
+				Config: testAccAzureRMBackupProtectedFileShare_template(ri, rs, location),
+				Check:  resource.ComposeTestCheckFunc(),
+			},
+		},
+	})
+}
+
+func testCheckAzureRMBackupProtectedFileShareDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*ArmClient).recoveryServicesWorkloadProtectedItemsClient
+	ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_backup_protected_file_share" {
+			continue
+		}
+
+		id, err := parseAzureResourceID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		protectedItemName := id.Path["protectedItems"]
+		containerName := id.Path["protectionContainers"]
+		vaultName := id.Path["vaults"]
+		resourceGroup := id.ResourceGroup
+
+		resp, err := client.Get(ctx, vaultName, resourceGroup, "Azure", containerName, protectedItemName, "")
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return nil
+			}
+
+			return err
+		}
+
+		return fmt.Errorf("Backup Protected File Share %q (Vault %q / Resource Group %q) still exists:\n%#v", protectedItemName, vaultName, resourceGroup, resp)
+	}
+
+	return nil
+}
+
+func testCheckAzureRMBackupProtectedFileShareExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		id, err := parseAzureResourceID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		protectedItemName := id.Path["protectedItems"]
+		containerName := id.Path["protectionContainers"]
+		vaultName := id.Path["vaults"]
+		resourceGroup := id.ResourceGroup
+
+		client := testAccProvider.Meta().(*ArmClient).recoveryServicesWorkloadProtectedItemsClient
+		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+		resp, err := client.Get(ctx, vaultName, resourceGroup, "Azure", containerName, protectedItemName, "")
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return fmt.Errorf("Bad: Backup Protected File Share %q (Vault %q / Resource Group %q) does not exist", protectedItemName, vaultName, resourceGroup)
+			}
+
+			return fmt.Errorf("Bad: Get on recoveryServicesWorkloadProtectedItemsClient: %+v", err)
+		}
+
+		return nil
+	}
+}
+
+func testAccAzureRMBackupProtectedFileShare_template(rInt int, rString string, location string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_backup_container_storage_account" "test" {
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  recovery_vault_name = "${azurerm_recovery_services_vault.test.name}"
+  storage_account_id  = "${azurerm_storage_account.test.id}"
+}
+
+resource "azurerm_backup_policy_file_share" "test" {
+  name                = "acctest-%d"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  recovery_vault_name = "${azurerm_recovery_services_vault.test.name}"
+
+  backup {
+    frequency = "Daily"
+    time      = "23:00"
+  }
+
+  retention_daily {
+    count = 10
+  }
+}
+`, testAccAzureRMBackupContainerStorageAccount_template(rInt, rString, location), rInt)
+}
+
+func testAccAzureRMBackupProtectedFileShare_basic(rInt int, rString string, location string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_backup_protected_file_share" "test" {
+  resource_group_name       = "${azurerm_resource_group.test.name}"
+  recovery_vault_name       = "${azurerm_recovery_services_vault.test.name}"
+  source_storage_account_id = "${azurerm_backup_container_storage_account.test.storage_account_id}"
+  source_file_share_name    = "${azurerm_storage_share.test.name}"
+  backup_policy_id          = "${azurerm_backup_policy_file_share.test.id}"
+}
+`, testAccAzureRMBackupProtectedFileShare_template(rInt, rString, location))
+}
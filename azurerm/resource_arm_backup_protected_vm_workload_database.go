@@ -0,0 +1,351 @@
+package azurerm
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/recoveryservices/mgmt/2017-07-01/backup"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmBackupProtectedVmWorkloadDatabase() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmBackupProtectedVmWorkloadDatabaseCreateUpdate,
+		Read:   resourceArmBackupProtectedVmWorkloadDatabaseRead,
+		Update: resourceArmBackupProtectedVmWorkloadDatabaseCreateUpdate,
+		Delete: resourceArmBackupProtectedVmWorkloadDatabaseDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+
+			"resource_group_name": resourceGroupNameSchema(),
+
+			"recovery_vault_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringMatch(
+					regexp.MustCompile("^[a-zA-Z][-a-zA-Z0-9]{1,49}$"),
+					"Recovery Service Vault name must be 2 - 50 characters long, start with a letter, contain only letters, numbers and hyphens.",
+				),
+			},
+
+			"source_vm_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: azure.ValidateResourceID,
+			},
+
+			"workload_type": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(backup.WorkloadTypeSQLDataBase),
+					string(backup.WorkloadTypeSAPHanaDatabase),
+				}, false),
+			},
+
+			"instance_name": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				DiffSuppressFunc: ignoreCaseDiffSuppressFunc,
+				ValidateFunc:     validation.NoZeroValues,
+			},
+
+			"database_name": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				DiffSuppressFunc: ignoreCaseDiffSuppressFunc,
+				ValidateFunc:     validation.NoZeroValues,
+			},
+
+			"backup_policy_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: azure.ValidateResourceID,
+			},
+
+			"protection_state": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceArmBackupProtectedVmWorkloadDatabaseCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).recoveryServicesWorkloadProtectedItemsClient
+	ctx := meta.(*ArmClient).StopContext
+
+	resourceGroup := d.Get("resource_group_name").(string)
+	vaultName := d.Get("recovery_vault_name").(string)
+	vmId := d.Get("source_vm_id").(string)
+	workloadType := d.Get("workload_type").(string)
+	instanceName := d.Get("instance_name").(string)
+	databaseName := d.Get("database_name").(string)
+	policyId := d.Get("backup_policy_id").(string)
+
+	containerName, err := backupContainerVmWorkloadName(vmId)
+	if err != nil {
+		return err
+	}
+
+	var protectedItemName string
+	if d.IsNewResource() {
+		// the name of the Protected Item is assigned by Azure Backup when the databases on the container are discovered
+		protectedItemName, err = resourceArmBackupProtectedVmWorkloadDatabaseFindProtectableItem(meta, ctx, vaultName, resourceGroup, containerName, workloadType, instanceName, databaseName)
+		if err != nil {
+			return err
+		}
+	} else {
+		id, err := parseAzureResourceID(d.Id())
+		if err != nil {
+			return err
+		}
+		protectedItemName = id.Path["protectedItems"]
+	}
+
+	log.Printf("[DEBUG] Creating/updating Backup Protected Database %q (Vault %q / Resource Group %q)", protectedItemName, vaultName, resourceGroup)
+
+	item := backup.ProtectedItemResource{}
+	switch workloadType {
+	case string(backup.WorkloadTypeSAPHanaDatabase):
+		item.Properties = &backup.AzureVMWorkloadSAPHanaDatabaseProtectedItem{
+			ProtectedItemType: backup.ProtectedItemTypeAzureVMWorkloadSAPHanaDatabase,
+			WorkloadType:      backup.DataSourceTypeSAPHanaDatabase,
+			SourceResourceID:  utils.String(vmId),
+			PolicyID:          utils.String(policyId),
+		}
+	default:
+		item.Properties = &backup.AzureVMWorkloadSQLDatabaseProtectedItem{
+			ProtectedItemType: backup.ProtectedItemTypeAzureVMWorkloadSQLDatabase,
+			WorkloadType:      backup.DataSourceTypeSQLDataBase,
+			SourceResourceID:  utils.String(vmId),
+			PolicyID:          utils.String(policyId),
+		}
+	}
+
+	if _, err := client.CreateOrUpdate(ctx, vaultName, resourceGroup, "Azure", containerName, protectedItemName, item); err != nil {
+		return fmt.Errorf("Error creating/updating Backup Protected Database %q (Vault %q / Resource Group %q): %+v", protectedItemName, vaultName, resourceGroup, err)
+	}
+
+	resp, err := resourceArmBackupProtectedVmWorkloadDatabaseWaitForState(client, ctx, true, vaultName, resourceGroup, containerName, protectedItemName)
+	if err != nil {
+		return err
+	}
+
+	id := strings.Replace(*resp.ID, "Subscriptions", "subscriptions", 1)
+	d.SetId(id)
+
+	return resourceArmBackupProtectedVmWorkloadDatabaseRead(d, meta)
+}
+
+func resourceArmBackupProtectedVmWorkloadDatabaseRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).recoveryServicesWorkloadProtectedItemsClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	protectedItemName := id.Path["protectedItems"]
+	containerName := id.Path["protectionContainers"]
+	vaultName := id.Path["vaults"]
+	resourceGroup := id.ResourceGroup
+
+	log.Printf("[DEBUG] Reading Backup Protected Database %q (Vault %q / Resource Group %q)", protectedItemName, vaultName, resourceGroup)
+
+	resp, err := client.Get(ctx, vaultName, resourceGroup, "Azure", containerName, protectedItemName, "")
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("Error making Read request on Backup Protected Database %q (Vault %q / Resource Group %q): %+v", protectedItemName, vaultName, resourceGroup, err)
+	}
+
+	d.Set("resource_group_name", resourceGroup)
+	d.Set("recovery_vault_name", vaultName)
+
+	if properties := resp.Properties; properties != nil {
+		if item, ok := properties.AsAzureVMWorkloadSQLDatabaseProtectedItem(); ok && item != nil {
+			d.Set("source_vm_id", item.SourceResourceID)
+			d.Set("workload_type", string(backup.WorkloadTypeSQLDataBase))
+			d.Set("instance_name", item.ParentName)
+			d.Set("database_name", item.FriendlyName)
+			d.Set("protection_state", string(item.ProtectionState))
+
+			if v := item.PolicyID; v != nil {
+				d.Set("backup_policy_id", strings.Replace(*v, "Subscriptions", "subscriptions", 1))
+			}
+		}
+
+		if item, ok := properties.AsAzureVMWorkloadSAPHanaDatabaseProtectedItem(); ok && item != nil {
+			d.Set("source_vm_id", item.SourceResourceID)
+			d.Set("workload_type", string(backup.WorkloadTypeSAPHanaDatabase))
+			d.Set("instance_name", item.ParentName)
+			d.Set("database_name", item.FriendlyName)
+			d.Set("protection_state", string(item.ProtectionState))
+
+			if v := item.PolicyID; v != nil {
+				d.Set("backup_policy_id", strings.Replace(*v, "Subscriptions", "subscriptions", 1))
+			}
+		}
+	}
+
+	return nil
+}
+
+func resourceArmBackupProtectedVmWorkloadDatabaseDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).recoveryServicesWorkloadProtectedItemsClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	protectedItemName := id.Path["protectedItems"]
+	containerName := id.Path["protectionContainers"]
+	vaultName := id.Path["vaults"]
+	resourceGroup := id.ResourceGroup
+
+	log.Printf("[DEBUG] Deleting Backup Protected Database %q (Vault %q / Resource Group %q)", protectedItemName, vaultName, resourceGroup)
+
+	resp, err := client.Delete(ctx, vaultName, resourceGroup, "Azure", containerName, protectedItemName)
+	if err != nil {
+		if !utils.ResponseWasNotFound(resp) {
+			return fmt.Errorf("Error issuing delete request for Backup Protected Database %q (Vault %q / Resource Group %q): %+v", protectedItemName, vaultName, resourceGroup, err)
+		}
+	}
+
+	if _, err := resourceArmBackupProtectedVmWorkloadDatabaseWaitForState(client, ctx, false, vaultName, resourceGroup, containerName, protectedItemName); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// resourceArmBackupProtectedVmWorkloadDatabaseFindProtectableItem triggers an inquiry of the databases within the
+// container and returns the name of the protectable item matching the specified instance & database
+func resourceArmBackupProtectedVmWorkloadDatabaseFindProtectableItem(meta interface{}, ctx context.Context, vaultName, resourceGroup, containerName, workloadType, instanceName, databaseName string) (string, error) {
+	containersClient := meta.(*ArmClient).recoveryServicesProtectionContainersClient
+	itemsClient := meta.(*ArmClient).recoveryServicesProtectableItemsClient
+
+	if _, err := containersClient.Inquire(ctx, vaultName, resourceGroup, "Azure", containerName, ""); err != nil {
+		return "", fmt.Errorf("Error inquiring the databases within Backup Container %q (Vault %q / Resource Group %q): %+v", containerName, vaultName, resourceGroup, err)
+	}
+
+	filter := fmt.Sprintf("backupManagementType eq '%s' and workloadType eq '%s'", string(backup.ManagementTypeAzureWorkload), workloadType)
+	containerSegment := strings.ToLower(fmt.Sprintf("/protectionContainers/%s/", containerName))
+
+	var protectableItemName string
+	err := resource.Retry(30*time.Minute, func() *resource.RetryError {
+		items, err := itemsClient.ListComplete(ctx, vaultName, resourceGroup, filter, "")
+		if err != nil {
+			return resource.NonRetryableError(fmt.Errorf("Error listing Protectable Items (Vault %q / Resource Group %q): %+v", vaultName, resourceGroup, err))
+		}
+
+		for items.NotDone() {
+			item := items.Value()
+			if item.ID != nil && item.Name != nil && strings.Contains(strings.ToLower(*item.ID), containerSegment) {
+				parentName, friendlyName := flattenBackupWorkloadProtectableItemNames(item.Properties)
+				if strings.EqualFold(parentName, instanceName) && strings.EqualFold(friendlyName, databaseName) {
+					protectableItemName = *item.Name
+					return nil
+				}
+			}
+
+			if err := items.Next(); err != nil {
+				return resource.NonRetryableError(fmt.Errorf("Error listing Protectable Items (Vault %q / Resource Group %q): %+v", vaultName, resourceGroup, err))
+			}
+		}
+
+		return resource.RetryableError(fmt.Errorf("Database %q within Instance %q was not found in Backup Container %q (Vault %q / Resource Group %q)", databaseName, instanceName, containerName, vaultName, resourceGroup))
+	})
+	if err != nil {
+		return "", err
+	}
+
+	return protectableItemName, nil
+}
+
+func flattenBackupWorkloadProtectableItemNames(input backup.BasicWorkloadProtectableItem) (parentName string, friendlyName string) {
+	if input == nil {
+		return "", ""
+	}
+
+	if item, ok := input.AsAzureVMWorkloadSQLDatabaseProtectableItem(); ok && item != nil {
+		if item.ParentName != nil {
+			parentName = *item.ParentName
+		}
+		if item.FriendlyName != nil {
+			friendlyName = *item.FriendlyName
+		}
+	}
+
+	if item, ok := input.AsAzureVMWorkloadSAPHanaDatabaseProtectableItem(); ok && item != nil {
+		if item.ParentName != nil {
+			parentName = *item.ParentName
+		}
+		if item.FriendlyName != nil {
+			friendlyName = *item.FriendlyName
+		}
+	}
+
+	return parentName, friendlyName
+}
+
+func resourceArmBackupProtectedVmWorkloadDatabaseWaitForState(client backup.ProtectedItemsGroupClient, ctx context.Context, found bool, vaultName, resourceGroup, containerName, protectedItemName string) (backup.ProtectedItemResource, error) {
+	state := &resource.StateChangeConf{
+		Timeout:    30 * time.Minute,
+		MinTimeout: 30 * time.Second,
+		Delay:      10 * time.Second,
+		Refresh: func() (interface{}, string, error) {
+
+			resp, err := client.Get(ctx, vaultName, resourceGroup, "Azure", containerName, protectedItemName, "")
+			if err != nil {
+				if utils.ResponseWasNotFound(resp.Response) {
+					return resp, "NotFound", nil
+				}
+
+				return resp, "Error", fmt.Errorf("Error making Read request on Backup Protected Database %q (Vault %q / Resource Group %q): %+v", protectedItemName, vaultName, resourceGroup, err)
+			}
+
+			return resp, "Found", nil
+		},
+	}
+
+	if found {
+		state.Pending = []string{"NotFound"}
+		state.Target = []string{"Found"}
+	} else {
+		state.Pending = []string{"Found"}
+		state.Target = []string{"NotFound"}
+	}
+
+	resp, err := state.WaitForState()
+	if err != nil {
+		return resp.(backup.ProtectedItemResource), fmt.Errorf("Error waiting for the Backup Protected Database %q to be %t (Vault %q / Resource Group %q): %+v", protectedItemName, found, vaultName, resourceGroup, err)
+	}
+
+	return resp.(backup.ProtectedItemResource), nil
+}
@@ -0,0 +1,174 @@
+package azurerm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func TestAccAzureRMBackupProtectedVmWorkloadDatabase_basic(t *testing.T) {
+	resourceName := "azurerm_backup_protected_vm_workload_database.test"
+	ri := acctest.RandInt()
+	location := testLocation()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMBackupProtectedVmWorkloadDatabaseDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMBackupProtectedVmWorkloadDatabase_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMBackupProtectedVmWorkloadDatabaseExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "workload_type", "SQLDataBase"),
+					resource.TestCheckResourceAttrSet(resourceName, "protection_state"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{ //the container cannot be unregistered whilst databases are protected
+				Config: testAccAzureRMBackupProtectedVmWorkloadDatabase_template(ri, location),
+				Check:  resource.ComposeTestCheckFunc(),
+			},
+		},
+	})
+}
+
+func testCheckAzureRMBackupProtectedVmWorkloadDatabaseDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*ArmClient).recoveryServicesWorkloadProtectedItemsClient
+	ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_backup_protected_vm_workload_database" {
+			continue
+		}
+
+		id, err := parseAzureResourceID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		protectedItemName := id.Path["protectedItems"]
+		containerName := id.Path["protectionContainers"]
+		vaultName := id.Path["vaults"]
+		resourceGroup := id.ResourceGroup
+
+		resp, err := client.Get(ctx, vaultName, resourceGroup, "Azure", containerName, protectedItemName, "")
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return nil
+			}
+
+			return err
+		}
+
+		return fmt.Errorf("Backup Protected Database %q (Vault %q / Resource Group %q) still exists:\n%#v", protectedItemName, vaultName, resourceGroup, resp)
+	}
+
+	return nil
+}
+
+func testCheckAzureRMBackupProtectedVmWorkloadDatabaseExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		id, err := parseAzureResourceID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		protectedItemName := id.Path["protectedItems"]
+		containerName := id.Path["protectionContainers"]
+		vaultName := id.Path["vaults"]
+		resourceGroup := id.ResourceGroup
+
+		client := testAccProvider.Meta().(*ArmClient).recoveryServicesWorkloadProtectedItemsClient
+		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+		resp, err := client.Get(ctx, vaultName, resourceGroup, "Azure", containerName, protectedItemName, "")
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return fmt.Errorf("Bad: Backup Protected Database %q (Vault %q / Resource Group %q) does not exist", protectedItemName, vaultName, resourceGroup)
+			}
+
+			return fmt.Errorf("Bad: Get on recoveryServicesWorkloadProtectedItemsClient: %+v", err)
+		}
+
+		return nil
+	}
+}
+
+func testAccAzureRMBackupProtectedVmWorkloadDatabase_template(rInt int, location string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_backup_container_vm_workload" "test" {
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  recovery_vault_name = "${azurerm_recovery_services_vault.test.name}"
+  source_vm_id        = "${azurerm_virtual_machine.test.id}"
+  workload_type       = "SQLDataBase"
+}
+
+resource "azurerm_backup_policy_vm_workload" "test" {
+  name                = "acctest-%d"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  recovery_vault_name = "${azurerm_recovery_services_vault.test.name}"
+  workload_type       = "SQLDataBase"
+
+  settings {
+    time_zone = "UTC"
+  }
+
+  protection_policy {
+    policy_type = "Full"
+
+    backup {
+      frequency = "Daily"
+      time      = "15:00"
+    }
+
+    retention_daily {
+      count = 8
+    }
+  }
+
+  protection_policy {
+    policy_type = "Log"
+
+    backup {
+      frequency_in_minutes = 15
+    }
+
+    simple_retention {
+      count = 8
+    }
+  }
+}
+`, testAccAzureRMBackupContainerVmWorkload_template(rInt, location), rInt)
+}
+
+func testAccAzureRMBackupProtectedVmWorkloadDatabase_basic(rInt int, location string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_backup_protected_vm_workload_database" "test" {
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  recovery_vault_name = "${azurerm_recovery_services_vault.test.name}"
+  source_vm_id        = "${azurerm_backup_container_vm_workload.test.source_vm_id}"
+  workload_type       = "SQLDataBase"
+  instance_name       = "MSSQLSERVER"
+  database_name       = "model"
+  backup_policy_id    = "${azurerm_backup_policy_vm_workload.test.id}"
+}
+`, testAccAzureRMBackupProtectedVmWorkloadDatabase_template(rInt, location))
+}
@@ -0,0 +1,307 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/recoveryservices/mgmt/2017-07-01/backup"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+// resourceArmBackupVmRestore triggers a one-off restore of a protected Virtual Machine; as such every argument
+// forces a new restore and deleting the resource only removes it from the state.
+func resourceArmBackupVmRestore() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmBackupVmRestoreCreate,
+		Read:   resourceArmBackupVmRestoreRead,
+		Delete: resourceArmBackupVmRestoreDelete,
+
+		Schema: map[string]*schema.Schema{
+			"recovery_point_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: azure.ValidateResourceID,
+			},
+
+			"location": locationSchema(),
+
+			"recovery_type": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(backup.RecoveryTypeOriginalLocation),
+					string(backup.RecoveryTypeAlternateLocation),
+					string(backup.RecoveryTypeRestoreDisks),
+				}, false),
+			},
+
+			"storage_account_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: azure.ValidateResourceID,
+			},
+
+			"target_resource_group_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: azure.ValidateResourceID,
+			},
+
+			"target_virtual_machine_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: azure.ValidateResourceID,
+			},
+
+			"target_virtual_network_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: azure.ValidateResourceID,
+			},
+
+			"target_subnet_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: azure.ValidateResourceID,
+			},
+
+			"job_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"job_status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceArmBackupVmRestoreCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).recoveryServicesRestoresClient
+	itemsClient := meta.(*ArmClient).recoveryServicesWorkloadProtectedItemsClient
+	ctx := meta.(*ArmClient).StopContext
+
+	recoveryPointId := d.Get("recovery_point_id").(string)
+	id, err := parseAzureResourceID(recoveryPointId)
+	if err != nil {
+		return err
+	}
+
+	resourceGroup := id.ResourceGroup
+	vaultName := id.Path["vaults"]
+	fabricName := id.Path["backupFabrics"]
+	containerName := id.Path["protectionContainers"]
+	protectedItemName := id.Path["protectedItems"]
+	recoveryPointName := id.Path["recoveryPoints"]
+	if vaultName == "" || fabricName == "" || containerName == "" || protectedItemName == "" || recoveryPointName == "" {
+		return fmt.Errorf("Error: `recovery_point_id` %q is not the ID of a Backup Recovery Point", recoveryPointId)
+	}
+
+	recoveryType := d.Get("recovery_type").(string)
+	targetResourceGroupId := d.Get("target_resource_group_id").(string)
+	targetVirtualMachineId := d.Get("target_virtual_machine_id").(string)
+	targetVirtualNetworkId := d.Get("target_virtual_network_id").(string)
+	targetSubnetId := d.Get("target_subnet_id").(string)
+
+	if recoveryType == string(backup.RecoveryTypeAlternateLocation) {
+		if targetResourceGroupId == "" || targetVirtualMachineId == "" || targetVirtualNetworkId == "" || targetSubnetId == "" {
+			return fmt.Errorf("Error: `target_resource_group_id`, `target_virtual_machine_id`, `target_virtual_network_id` and `target_subnet_id` must be set when `recovery_type` is `AlternateLocation`")
+		}
+	}
+
+	item, err := itemsClient.Get(ctx, vaultName, resourceGroup, fabricName, containerName, protectedItemName, "")
+	if err != nil {
+		return fmt.Errorf("Error retrieving Backup Protected Item %q (Vault %q / Resource Group %q): %+v", protectedItemName, vaultName, resourceGroup, err)
+	}
+
+	var sourceResourceId *string
+	if props := item.Properties; props != nil {
+		if vm, ok := props.AsAzureIaaSComputeVMProtectedItem(); ok && vm != nil {
+			sourceResourceId = vm.SourceResourceID
+		}
+	}
+	if sourceResourceId == nil {
+		return fmt.Errorf("Error: Backup Protected Item %q (Vault %q / Resource Group %q) is not a protected Virtual Machine", protectedItemName, vaultName, resourceGroup)
+	}
+
+	request := backup.IaasVMRestoreRequest{
+		ObjectType:                   backup.ObjectTypeIaasVMRestoreRequest,
+		RecoveryPointID:              utils.String(recoveryPointName),
+		RecoveryType:                 backup.RecoveryType(recoveryType),
+		SourceResourceID:             sourceResourceId,
+		StorageAccountID:             utils.String(d.Get("storage_account_id").(string)),
+		Region:                       utils.String(azureRMNormalizeLocation(d.Get("location").(string))),
+		CreateNewCloudService:        utils.Bool(false),
+		OriginalStorageAccountOption: utils.Bool(false),
+	}
+
+	if targetResourceGroupId != "" {
+		request.TargetResourceGroupID = utils.String(targetResourceGroupId)
+	}
+	if targetVirtualMachineId != "" {
+		request.TargetVirtualMachineID = utils.String(targetVirtualMachineId)
+	}
+	if targetVirtualNetworkId != "" {
+		request.VirtualNetworkID = utils.String(targetVirtualNetworkId)
+	}
+	if targetSubnetId != "" {
+		request.SubnetID = utils.String(targetSubnetId)
+	}
+
+	log.Printf("[DEBUG] Triggering Restore of Recovery Point %q (Protected Item %q / Vault %q / Resource Group %q)", recoveryPointName, protectedItemName, vaultName, resourceGroup)
+
+	parameters := backup.RestoreRequestResource{
+		Properties: &request,
+	}
+	resp, err := client.Trigger(ctx, vaultName, resourceGroup, fabricName, containerName, protectedItemName, recoveryPointName, parameters)
+	if err != nil {
+		return fmt.Errorf("Error triggering Restore of Recovery Point %q (Protected Item %q / Vault %q / Resource Group %q): %+v", recoveryPointName, protectedItemName, vaultName, resourceGroup, err)
+	}
+
+	operationId, err := backupOperationIdFromResponse(resp.Response)
+	if err != nil {
+		return fmt.Errorf("Error determining the Operation ID for the Restore of Recovery Point %q (Protected Item %q / Vault %q / Resource Group %q): %+v", recoveryPointName, protectedItemName, vaultName, resourceGroup, err)
+	}
+
+	jobId, err := resourceArmBackupVmRestoreWaitForJob(meta, vaultName, resourceGroup, fabricName, containerName, protectedItemName, operationId)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(fmt.Sprintf("%s/restores/%s", recoveryPointId, operationId))
+	d.Set("job_id", jobId)
+
+	return resourceArmBackupVmRestoreRead(d, meta)
+}
+
+func resourceArmBackupVmRestoreRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).recoveryServicesJobDetailsClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resourceGroup := id.ResourceGroup
+	vaultName := id.Path["vaults"]
+	jobId := d.Get("job_id").(string)
+	if jobId == "" {
+		return nil
+	}
+
+	resp, err := client.Get(ctx, vaultName, resourceGroup, jobId)
+	if err != nil {
+		// jobs are only retained for a limited time, at which point there's nothing further to read
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] Backup Job %q (Vault %q / Resource Group %q) was not found - leaving the Restore in the state", jobId, vaultName, resourceGroup)
+			return nil
+		}
+
+		return fmt.Errorf("Error retrieving Backup Job %q (Vault %q / Resource Group %q): %+v", jobId, vaultName, resourceGroup, err)
+	}
+
+	if props := resp.Properties; props != nil {
+		if job, ok := props.AsAzureIaaSVMJob(); ok && job != nil {
+			d.Set("job_status", job.Status)
+		}
+	}
+
+	return nil
+}
+
+func resourceArmBackupVmRestoreDelete(d *schema.ResourceData, meta interface{}) error {
+	// a Restore can't be undone - the restored resources are managed independently
+	log.Printf("[DEBUG] Removing Backup Restore %q from the state", d.Id())
+	return nil
+}
+
+// backupOperationIdFromResponse returns the ID of the asynchronous operation started by a Backup request, which is
+// the final segment of the URI returned in the `Azure-AsyncOperation` (or `Location`) header
+func backupOperationIdFromResponse(resp *http.Response) (string, error) {
+	if resp == nil {
+		return "", fmt.Errorf("response was nil")
+	}
+
+	location := resp.Header.Get("Azure-AsyncOperation")
+	if location == "" {
+		location = resp.Header.Get("Location")
+	}
+	if location == "" {
+		return "", fmt.Errorf("neither the `Azure-AsyncOperation` nor `Location` header was returned")
+	}
+
+	uri, err := url.Parse(location)
+	if err != nil {
+		return "", fmt.Errorf("Error parsing %q as a URI: %+v", location, err)
+	}
+
+	segments := strings.Split(strings.TrimSuffix(uri.Path, "/"), "/")
+	operationId := segments[len(segments)-1]
+	if operationId == "" {
+		return "", fmt.Errorf("unable to determine the Operation ID from %q", location)
+	}
+
+	return operationId, nil
+}
+
+func resourceArmBackupVmRestoreWaitForJob(meta interface{}, vaultName, resourceGroup, fabricName, containerName, protectedItemName, operationId string) (string, error) {
+	client := meta.(*ArmClient).recoveryServicesOperationStatusesClient
+	ctx := meta.(*ArmClient).StopContext
+
+	state := &resource.StateChangeConf{
+		Pending:    []string{string(backup.OperationStatusValuesInProgress)},
+		Target:     []string{string(backup.OperationStatusValuesSucceeded)},
+		Timeout:    30 * time.Minute,
+		MinTimeout: 15 * time.Second,
+		Delay:      10 * time.Second,
+		Refresh: func() (interface{}, string, error) {
+			resp, err := client.Get(ctx, vaultName, resourceGroup, fabricName, containerName, protectedItemName, operationId)
+			if err != nil {
+				return resp, "Error", fmt.Errorf("Error retrieving Backup Operation %q (Vault %q / Resource Group %q): %+v", operationId, vaultName, resourceGroup, err)
+			}
+
+			if resp.Status == backup.OperationStatusValuesFailed || resp.Status == backup.OperationStatusValuesCanceled {
+				message := ""
+				if e := resp.Error; e != nil && e.Message != nil {
+					message = *e.Message
+				}
+				return resp, string(resp.Status), fmt.Errorf("Backup Operation %q (Vault %q / Resource Group %q) was %s: %s", operationId, vaultName, resourceGroup, string(resp.Status), message)
+			}
+
+			return resp, string(resp.Status), nil
+		},
+	}
+
+	resp, err := state.WaitForState()
+	if err != nil {
+		return "", fmt.Errorf("Error waiting for the Restore to be triggered (Vault %q / Resource Group %q): %+v", vaultName, resourceGroup, err)
+	}
+
+	status := resp.(backup.OperationStatus)
+	if props := status.Properties; props != nil {
+		if job, ok := props.AsOperationStatusJobExtendedInfo(); ok && job != nil && job.JobID != nil {
+			return *job.JobID, nil
+		}
+	}
+
+	return "", nil
+}
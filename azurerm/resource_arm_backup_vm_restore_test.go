@@ -0,0 +1,115 @@
+package azurerm
+
+import (
+	"fmt"
+	"net/http"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestBackupOperationIdFromResponse(t *testing.T) {
+	cases := []struct {
+		Headers  map[string]string
+		Expected string
+		Error    bool
+	}{
+		{
+			Headers: map[string]string{},
+			Error:   true,
+		},
+		{
+			Headers: map[string]string{
+				"Azure-AsyncOperation": "https://management.azure.com/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.RecoveryServices/vaults/vault1/backupFabrics/Azure/protectionContainers/container1/protectedItems/item1/operationsStatus/11111111-1111-1111-1111-111111111111?api-version=2017-07-01",
+			},
+			Expected: "11111111-1111-1111-1111-111111111111",
+		},
+		{
+			Headers: map[string]string{
+				"Location": "https://management.azure.com/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.RecoveryServices/vaults/vault1/backupFabrics/Azure/protectionContainers/container1/protectedItems/item1/operationResults/22222222-2222-2222-2222-222222222222?api-version=2017-07-01",
+			},
+			Expected: "22222222-2222-2222-2222-222222222222",
+		},
+	}
+
+	for _, tc := range cases {
+		resp := &http.Response{
+			Header: http.Header{},
+		}
+		for k, v := range tc.Headers {
+			resp.Header.Set(k, v)
+		}
+
+		actual, err := backupOperationIdFromResponse(resp)
+		if err != nil {
+			if tc.Error {
+				continue
+			}
+
+			t.Fatalf("Expected no error for %+v but got: %+v", tc.Headers, err)
+		}
+
+		if tc.Error {
+			t.Fatalf("Expected an error for %+v but didn't get one", tc.Headers)
+		}
+
+		if actual != tc.Expected {
+			t.Fatalf("Expected %q but got %q", tc.Expected, actual)
+		}
+	}
+}
+
+func TestAccAzureRMBackupVmRestore_restoreDisks(t *testing.T) {
+	// restoring requires a Recovery Point, which can take several hours to be created for a newly protected VM
+	recoveryPointEnvVariable := "ARM_TEST_BACKUP_RECOVERY_POINT_ID"
+	recoveryPointId := os.Getenv(recoveryPointEnvVariable)
+	if recoveryPointId == "" {
+		t.Skipf("Skipping as %q is not specified", recoveryPointEnvVariable)
+	}
+
+	resourceName := "azurerm_backup_vm_restore.test"
+	ri := acctest.RandInt()
+	rs := acctest.RandString(6)
+	location := testLocation()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMBackupVmRestore_restoreDisks(ri, rs, location, recoveryPointId),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "job_id"),
+					resource.TestCheckResourceAttrSet(resourceName, "job_status"),
+				),
+			},
+		},
+	})
+}
+
+func testAccAzureRMBackupVmRestore_restoreDisks(rInt int, rString string, location string, recoveryPointId string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_storage_account" "test" {
+  name                     = "acctestsa%s"
+  resource_group_name      = "${azurerm_resource_group.test.name}"
+  location                 = "${azurerm_resource_group.test.location}"
+  account_tier             = "Standard"
+  account_replication_type = "LRS"
+}
+
+resource "azurerm_backup_vm_restore" "test" {
+  recovery_point_id        = "%s"
+  location                 = "${azurerm_resource_group.test.location}"
+  recovery_type            = "RestoreDisks"
+  storage_account_id       = "${azurerm_storage_account.test.id}"
+  target_resource_group_id = "${azurerm_resource_group.test.id}"
+}
+`, rInt, location, rString, recoveryPointId)
+}
@@ -0,0 +1,279 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/Azure/azure-sdk-for-go/services/batch/mgmt/2017-09-01/batch"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/response"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmBatchAccount() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmBatchAccountCreate,
+		Read:   resourceArmBatchAccountRead,
+		Update: resourceArmBatchAccountUpdate,
+		Delete: resourceArmBatchAccountDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: azure.ValidateBatchAccountName(),
+			},
+
+			"resource_group_name": resourceGroupNameSchema(),
+
+			"location": locationSchema(),
+
+			"storage_account_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: azure.ValidateResourceIDOrEmpty,
+			},
+
+			"pool_allocation_mode": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Default:  string(batch.BatchService),
+				ValidateFunc: validation.StringInSlice([]string{
+					string(batch.BatchService),
+					string(batch.UserSubscription),
+				}, false),
+			},
+
+			"key_vault_reference": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: azure.ValidateResourceID,
+						},
+						"url": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validate.URLIsHTTPS,
+						},
+					},
+				},
+			},
+
+			"account_endpoint": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"tags": tagsSchema(),
+		},
+	}
+}
+
+func resourceArmBatchAccountCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).batchAccountClient
+	ctx := meta.(*ArmClient).StopContext
+
+	log.Printf("[INFO] preparing arguments for Azure Batch Account creation.")
+
+	name := d.Get("name").(string)
+	resourceGroup := d.Get("resource_group_name").(string)
+	location := azureRMNormalizeLocation(d.Get("location").(string))
+	storageAccountId := d.Get("storage_account_id").(string)
+	poolAllocationMode := d.Get("pool_allocation_mode").(string)
+	tags := d.Get("tags").(map[string]interface{})
+
+	keyVaultReference := expandAzureRMBatchAccountKeyVaultReference(d.Get("key_vault_reference").([]interface{}))
+	if poolAllocationMode == string(batch.UserSubscription) && keyVaultReference == nil {
+		return fmt.Errorf("A `key_vault_reference` must be specified when `pool_allocation_mode` is set to `UserSubscription`")
+	}
+
+	parameters := batch.AccountCreateParameters{
+		Location: utils.String(location),
+		AccountCreateProperties: &batch.AccountCreateProperties{
+			PoolAllocationMode: batch.PoolAllocationMode(poolAllocationMode),
+			KeyVaultReference:  keyVaultReference,
+		},
+		Tags: expandTags(tags),
+	}
+
+	if storageAccountId != "" {
+		parameters.AccountCreateProperties.AutoStorage = &batch.AutoStorageBaseProperties{
+			StorageAccountID: utils.String(storageAccountId),
+		}
+	}
+
+	future, err := client.Create(ctx, resourceGroup, name, parameters)
+	if err != nil {
+		return fmt.Errorf("Error creating Batch Account %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("Error waiting for creation of Batch Account %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	read, err := client.Get(ctx, resourceGroup, name)
+	if err != nil {
+		return fmt.Errorf("Error retrieving Batch Account %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+	if read.ID == nil {
+		return fmt.Errorf("Cannot read Batch Account %q (Resource Group %q) ID", name, resourceGroup)
+	}
+
+	d.SetId(*read.ID)
+
+	return resourceArmBatchAccountRead(d, meta)
+}
+
+func resourceArmBatchAccountRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).batchAccountClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	name := id.Path["batchAccounts"]
+
+	resp, err := client.Get(ctx, resourceGroup, name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] Batch Account %q was not found in Resource Group %q - removing from state", name, resourceGroup)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("Error retrieving Batch Account %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	d.Set("name", resp.Name)
+	d.Set("resource_group_name", resourceGroup)
+	if location := resp.Location; location != nil {
+		d.Set("location", azureRMNormalizeLocation(*location))
+	}
+
+	if props := resp.AccountProperties; props != nil {
+		d.Set("account_endpoint", props.AccountEndpoint)
+		d.Set("pool_allocation_mode", string(props.PoolAllocationMode))
+
+		storageAccountId := ""
+		if autoStorage := props.AutoStorage; autoStorage != nil && autoStorage.StorageAccountID != nil {
+			storageAccountId = *autoStorage.StorageAccountID
+		}
+		d.Set("storage_account_id", storageAccountId)
+
+		if err := d.Set("key_vault_reference", flattenAzureRMBatchAccountKeyVaultReference(props.KeyVaultReference)); err != nil {
+			return fmt.Errorf("Error setting `key_vault_reference`: %+v", err)
+		}
+	}
+
+	flattenAndSetTags(d, resp.Tags)
+
+	return nil
+}
+
+func resourceArmBatchAccountUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).batchAccountClient
+	ctx := meta.(*ArmClient).StopContext
+
+	log.Printf("[INFO] preparing arguments for Azure Batch Account update.")
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	name := id.Path["batchAccounts"]
+
+	storageAccountId := d.Get("storage_account_id").(string)
+	tags := d.Get("tags").(map[string]interface{})
+
+	parameters := batch.AccountUpdateParameters{
+		AccountUpdateProperties: &batch.AccountUpdateProperties{
+			AutoStorage: &batch.AutoStorageBaseProperties{
+				StorageAccountID: utils.String(storageAccountId),
+			},
+		},
+		Tags: expandTags(tags),
+	}
+
+	if _, err := client.Update(ctx, resourceGroup, name, parameters); err != nil {
+		return fmt.Errorf("Error updating Batch Account %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	return resourceArmBatchAccountRead(d, meta)
+}
+
+func resourceArmBatchAccountDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).batchAccountClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	name := id.Path["batchAccounts"]
+
+	future, err := client.Delete(ctx, resourceGroup, name)
+	if err != nil {
+		if response.WasNotFound(future.Response()) {
+			return nil
+		}
+		return fmt.Errorf("Error deleting Batch Account %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		if !response.WasNotFound(future.Response()) {
+			return fmt.Errorf("Error waiting for deletion of Batch Account %q (Resource Group %q): %+v", name, resourceGroup, err)
+		}
+	}
+
+	return nil
+}
+
+func expandAzureRMBatchAccountKeyVaultReference(input []interface{}) *batch.KeyVaultReference {
+	if len(input) == 0 || input[0] == nil {
+		return nil
+	}
+
+	v := input[0].(map[string]interface{})
+	return &batch.KeyVaultReference{
+		ID:  utils.String(v["id"].(string)),
+		URL: utils.String(v["url"].(string)),
+	}
+}
+
+func flattenAzureRMBatchAccountKeyVaultReference(input *batch.KeyVaultReference) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+
+	output := make(map[string]interface{})
+
+	if input.ID != nil {
+		output["id"] = *input.ID
+	}
+
+	if input.URL != nil {
+		output["url"] = *input.URL
+	}
+
+	return []interface{}{output}
+}
@@ -46,6 +46,15 @@ func testSweepCDNProfiles(region string) error {
 		resourceGroup := resourceId.ResourceGroup
 		name := resourceId.Path["profiles"]
 
+		inUse, err := sweeperResourceGroupInUse(ctx, armClient, resourceGroup)
+		if err != nil {
+			return err
+		}
+
+		if inUse {
+			continue
+		}
+
 		log.Printf("Deleting CDN Profile '%s' in Resource Group '%s'", name, resourceGroup)
 		future, err := client.Delete(ctx, resourceGroup, name)
 		if err != nil {
@@ -228,9 +228,10 @@ func resourceArmContainerGroup() *schema.Resource {
 									},
 
 									"storage_account_key": {
-										Type:     schema.TypeString,
-										Required: true,
-										ForceNew: true,
+										Type:      schema.TypeString,
+										Required:  true,
+										ForceNew:  true,
+										Sensitive: true,
 									},
 								},
 							},
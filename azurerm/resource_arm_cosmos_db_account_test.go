@@ -48,6 +48,15 @@ func testSweepCosmosDBAccount(region string) error {
 		resourceGroup := resourceId.ResourceGroup
 		name := resourceId.Path["databaseAccounts"]
 
+		inUse, err := sweeperResourceGroupInUse(ctx, armClient, resourceGroup)
+		if err != nil {
+			return err
+		}
+
+		if inUse {
+			continue
+		}
+
 		log.Printf("Deleting CosmosDB Account '%s' in Resource Group '%s'", name, resourceGroup)
 		future, err := client.Delete(ctx, resourceGroup, name)
 		if err != nil {
@@ -0,0 +1,171 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/Azure/azure-sdk-for-go/services/preview/datamigration/mgmt/2018-03-31-preview/datamigration"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmDatabaseMigrationProject() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmDatabaseMigrationProjectCreateUpdate,
+		Read:   resourceArmDatabaseMigrationProjectRead,
+		Update: resourceArmDatabaseMigrationProjectCreateUpdate,
+		Delete: resourceArmDatabaseMigrationProjectDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: azure.ValidateDatabaseMigrationProjectName(),
+			},
+
+			"service_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: azure.ValidateDatabaseMigrationServiceName(),
+			},
+
+			"resource_group_name": resourceGroupNameSchema(),
+
+			"location": locationSchema(),
+
+			"source_platform": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(datamigration.SQL),
+				}, false),
+			},
+
+			"target_platform": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(datamigration.ProjectTargetPlatformSQLDB),
+					string(datamigration.ProjectTargetPlatformSQLMI),
+				}, false),
+			},
+
+			"tags": tagsSchema(),
+		},
+	}
+}
+
+func resourceArmDatabaseMigrationProjectCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).dmsProjectsClient
+	ctx := meta.(*ArmClient).StopContext
+
+	log.Printf("[INFO] preparing arguments for Azure Database Migration Project creation/update.")
+
+	name := d.Get("name").(string)
+	serviceName := d.Get("service_name").(string)
+	resourceGroup := d.Get("resource_group_name").(string)
+	location := azureRMNormalizeLocation(d.Get("location").(string))
+	sourcePlatform := d.Get("source_platform").(string)
+	targetPlatform := d.Get("target_platform").(string)
+	tags := d.Get("tags").(map[string]interface{})
+
+	parameters := datamigration.Project{
+		Location: utils.String(location),
+		ProjectProperties: &datamigration.ProjectProperties{
+			SourcePlatform: datamigration.ProjectSourcePlatform(sourcePlatform),
+			TargetPlatform: datamigration.ProjectTargetPlatform(targetPlatform),
+		},
+		Tags: expandTags(tags),
+	}
+
+	if _, err := client.CreateOrUpdate(ctx, parameters, resourceGroup, serviceName, name); err != nil {
+		return fmt.Errorf("Error creating/updating Database Migration Project %q (Service %q / Resource Group %q): %+v", name, serviceName, resourceGroup, err)
+	}
+
+	read, err := client.Get(ctx, resourceGroup, serviceName, name)
+	if err != nil {
+		return fmt.Errorf("Error retrieving Database Migration Project %q (Service %q / Resource Group %q): %+v", name, serviceName, resourceGroup, err)
+	}
+	if read.ID == nil {
+		return fmt.Errorf("Cannot read Database Migration Project %q (Service %q / Resource Group %q) ID", name, serviceName, resourceGroup)
+	}
+
+	d.SetId(*read.ID)
+
+	return resourceArmDatabaseMigrationProjectRead(d, meta)
+}
+
+func resourceArmDatabaseMigrationProjectRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).dmsProjectsClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	serviceName := id.Path["services"]
+	name := id.Path["projects"]
+
+	resp, err := client.Get(ctx, resourceGroup, serviceName, name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] Database Migration Project %q was not found in Service %q (Resource Group %q) - removing from state", name, serviceName, resourceGroup)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("Error retrieving Database Migration Project %q (Service %q / Resource Group %q): %+v", name, serviceName, resourceGroup, err)
+	}
+
+	d.Set("name", resp.Name)
+	d.Set("service_name", serviceName)
+	d.Set("resource_group_name", resourceGroup)
+	if location := resp.Location; location != nil {
+		d.Set("location", azureRMNormalizeLocation(*location))
+	}
+
+	if props := resp.ProjectProperties; props != nil {
+		d.Set("source_platform", string(props.SourcePlatform))
+		d.Set("target_platform", string(props.TargetPlatform))
+	}
+
+	flattenAndSetTags(d, resp.Tags)
+
+	return nil
+}
+
+func resourceArmDatabaseMigrationProjectDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).dmsProjectsClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	serviceName := id.Path["services"]
+	name := id.Path["projects"]
+
+	// any Migration Tasks must be stopped before the Project can be deleted
+	deleteRunningTasks := false
+	resp, err := client.Delete(ctx, resourceGroup, serviceName, name, &deleteRunningTasks)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp) {
+			return nil
+		}
+
+		return fmt.Errorf("Error deleting Database Migration Project %q (Service %q / Resource Group %q): %+v", name, serviceName, resourceGroup, err)
+	}
+
+	return nil
+}
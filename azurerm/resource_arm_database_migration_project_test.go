@@ -0,0 +1,210 @@
+package azurerm
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+func TestValidateAzureRMDatabaseMigrationProjectName(t *testing.T) {
+	cases := []struct {
+		Value       string
+		ShouldError bool
+	}{
+		{
+			Value:       "ab",
+			ShouldError: true,
+		},
+		{
+			Value:       "abc",
+			ShouldError: false,
+		},
+		{
+			Value:       "acctest-dms.project_1",
+			ShouldError: false,
+		},
+		{
+			Value:       ".project",
+			ShouldError: true,
+		},
+		{
+			Value:       "project.",
+			ShouldError: true,
+		},
+		{
+			Value:       acctest.RandStringFromCharSet(58, acctest.CharSetAlpha),
+			ShouldError: false,
+		},
+		{
+			Value:       acctest.RandStringFromCharSet(59, acctest.CharSetAlpha),
+			ShouldError: true,
+		},
+	}
+
+	for _, tc := range cases {
+		_, errors := azure.ValidateDatabaseMigrationProjectName()(tc.Value, "name")
+
+		hasErrors := len(errors) > 0
+		if hasErrors != tc.ShouldError {
+			t.Fatalf("Expected %q to return errors %t but got %d errors", tc.Value, tc.ShouldError, len(errors))
+		}
+	}
+}
+
+func TestAccAzureRMDatabaseMigrationProject_basic(t *testing.T) {
+	resourceName := "azurerm_database_migration_project.test"
+	ri := acctest.RandInt()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMDatabaseMigrationProjectDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMDatabaseMigrationProject_basic(ri, testLocation()),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMDatabaseMigrationProjectExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "source_platform", "SQL"),
+					resource.TestCheckResourceAttr(resourceName, "target_platform", "SQLDB"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAzureRMDatabaseMigrationProject_update(t *testing.T) {
+	resourceName := "azurerm_database_migration_project.test"
+	ri := acctest.RandInt()
+	location := testLocation()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMDatabaseMigrationProjectDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMDatabaseMigrationProject_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMDatabaseMigrationProjectExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				Config: testAccAzureRMDatabaseMigrationProject_tags(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMDatabaseMigrationProjectExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.name", "test"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testCheckAzureRMDatabaseMigrationProjectExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Bad: Not found: %s", resourceName)
+		}
+
+		name := rs.Primary.Attributes["name"]
+		serviceName := rs.Primary.Attributes["service_name"]
+		resourceGroup, hasResourceGroup := rs.Primary.Attributes["resource_group_name"]
+		if !hasResourceGroup {
+			return fmt.Errorf("Bad: No resource group found in state for Database Migration Project: %s", name)
+		}
+
+		client := testAccProvider.Meta().(*ArmClient).dmsProjectsClient
+		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+		resp, err := client.Get(ctx, resourceGroup, serviceName, name)
+		if err != nil {
+			if resp.StatusCode == http.StatusNotFound {
+				return fmt.Errorf("Bad: Database Migration Project %q (Service %q / Resource Group %q) does not exist", name, serviceName, resourceGroup)
+			}
+
+			return fmt.Errorf("Bad: Get on dmsProjectsClient: %+v", err)
+		}
+
+		return nil
+	}
+}
+
+func testCheckAzureRMDatabaseMigrationProjectDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*ArmClient).dmsProjectsClient
+	ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_database_migration_project" {
+			continue
+		}
+
+		name := rs.Primary.Attributes["name"]
+		serviceName := rs.Primary.Attributes["service_name"]
+		resourceGroup := rs.Primary.Attributes["resource_group_name"]
+		resp, err := client.Get(ctx, resourceGroup, serviceName, name)
+
+		if err != nil {
+			if resp.StatusCode == http.StatusNotFound {
+				return nil
+			}
+
+			return err
+		}
+
+		return fmt.Errorf("Bad: Database Migration Project %q (Service %q / Resource Group %q) still exists", name, serviceName, resourceGroup)
+	}
+
+	return nil
+}
+
+func testAccAzureRMDatabaseMigrationProject_basic(rInt int, location string) string {
+	template := testAccAzureRMDatabaseMigrationService_basic(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_database_migration_project" "test" {
+  name                = "acctestdbmsproject-%d"
+  service_name        = "${azurerm_database_migration_service.test.name}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  location            = "${azurerm_resource_group.test.location}"
+  source_platform     = "SQL"
+  target_platform     = "SQLDB"
+}
+`, template, rInt)
+}
+
+func testAccAzureRMDatabaseMigrationProject_tags(rInt int, location string) string {
+	template := testAccAzureRMDatabaseMigrationService_basic(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_database_migration_project" "test" {
+  name                = "acctestdbmsproject-%d"
+  service_name        = "${azurerm_database_migration_service.test.name}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  location            = "${azurerm_resource_group.test.location}"
+  source_platform     = "SQL"
+  target_platform     = "SQLDB"
+
+  tags {
+    name = "test"
+  }
+}
+`, template, rInt)
+}
@@ -0,0 +1,208 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/Azure/azure-sdk-for-go/services/preview/datamigration/mgmt/2018-03-31-preview/datamigration"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/response"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmDatabaseMigrationService() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmDatabaseMigrationServiceCreate,
+		Read:   resourceArmDatabaseMigrationServiceRead,
+		Update: resourceArmDatabaseMigrationServiceUpdate,
+		Delete: resourceArmDatabaseMigrationServiceDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: azure.ValidateDatabaseMigrationServiceName(),
+			},
+
+			"location": locationSchema(),
+
+			"resource_group_name": resourceGroupNameSchema(),
+
+			"subnet_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: azure.ValidateResourceID,
+			},
+
+			"sku_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"Standard_1vCores",
+					"Standard_2vCores",
+					"Standard_4vCores",
+					"Premium_4vCores",
+				}, false),
+			},
+
+			"tags": tagsSchema(),
+		},
+	}
+}
+
+func resourceArmDatabaseMigrationServiceCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).dmsServicesClient
+	ctx := meta.(*ArmClient).StopContext
+
+	log.Printf("[INFO] preparing arguments for Azure Database Migration Service creation.")
+
+	name := d.Get("name").(string)
+	resourceGroup := d.Get("resource_group_name").(string)
+	location := azureRMNormalizeLocation(d.Get("location").(string))
+	subnetId := d.Get("subnet_id").(string)
+	skuName := d.Get("sku_name").(string)
+	tags := d.Get("tags").(map[string]interface{})
+
+	parameters := datamigration.Service{
+		Location: utils.String(location),
+		ServiceProperties: &datamigration.ServiceProperties{
+			VirtualSubnetID: utils.String(subnetId),
+		},
+		Sku: &datamigration.ServiceSku{
+			Name: utils.String(skuName),
+		},
+		Kind: utils.String("Cloud"), // currently only "Cloud" is supported, hence hardcode here
+		Tags: expandTags(tags),
+	}
+
+	future, err := client.CreateOrUpdate(ctx, parameters, resourceGroup, name)
+	if err != nil {
+		return fmt.Errorf("Error creating Database Migration Service %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("Error waiting for creation of Database Migration Service %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	read, err := client.Get(ctx, resourceGroup, name)
+	if err != nil {
+		return fmt.Errorf("Error retrieving Database Migration Service %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+	if read.ID == nil {
+		return fmt.Errorf("Cannot read Database Migration Service %q (Resource Group %q) ID", name, resourceGroup)
+	}
+
+	d.SetId(*read.ID)
+
+	return resourceArmDatabaseMigrationServiceRead(d, meta)
+}
+
+func resourceArmDatabaseMigrationServiceRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).dmsServicesClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	name := id.Path["services"]
+
+	resp, err := client.Get(ctx, resourceGroup, name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] Database Migration Service %q was not found in Resource Group %q - removing from state", name, resourceGroup)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("Error retrieving Database Migration Service %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	d.Set("name", resp.Name)
+	d.Set("resource_group_name", resourceGroup)
+	if location := resp.Location; location != nil {
+		d.Set("location", azureRMNormalizeLocation(*location))
+	}
+
+	if props := resp.ServiceProperties; props != nil {
+		d.Set("subnet_id", props.VirtualSubnetID)
+	}
+
+	if sku := resp.Sku; sku != nil {
+		d.Set("sku_name", sku.Name)
+	}
+
+	flattenAndSetTags(d, resp.Tags)
+
+	return nil
+}
+
+func resourceArmDatabaseMigrationServiceUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).dmsServicesClient
+	ctx := meta.(*ArmClient).StopContext
+
+	log.Printf("[INFO] preparing arguments for Azure Database Migration Service update.")
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	name := id.Path["services"]
+
+	tags := d.Get("tags").(map[string]interface{})
+
+	parameters := datamigration.Service{
+		Tags: expandTags(tags),
+	}
+
+	future, err := client.Update(ctx, parameters, resourceGroup, name)
+	if err != nil {
+		return fmt.Errorf("Error updating Database Migration Service %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("Error waiting for update of Database Migration Service %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	return resourceArmDatabaseMigrationServiceRead(d, meta)
+}
+
+func resourceArmDatabaseMigrationServiceDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).dmsServicesClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	name := id.Path["services"]
+
+	// any Migration Tasks must be stopped before the Service can be deleted
+	deleteRunningTasks := false
+	future, err := client.Delete(ctx, resourceGroup, name, &deleteRunningTasks)
+	if err != nil {
+		if response.WasNotFound(future.Response()) {
+			return nil
+		}
+		return fmt.Errorf("Error deleting Database Migration Service %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		if !response.WasNotFound(future.Response()) {
+			return fmt.Errorf("Error waiting for deletion of Database Migration Service %q (Resource Group %q): %+v", name, resourceGroup, err)
+		}
+	}
+
+	return nil
+}
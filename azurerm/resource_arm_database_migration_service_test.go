@@ -0,0 +1,233 @@
+package azurerm
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+func TestValidateAzureRMDatabaseMigrationServiceName(t *testing.T) {
+	cases := []struct {
+		Value       string
+		ShouldError bool
+	}{
+		{
+			Value:       "a",
+			ShouldError: true,
+		},
+		{
+			Value:       "ab",
+			ShouldError: false,
+		},
+		{
+			Value:       "acctest-dms.service_1",
+			ShouldError: false,
+		},
+		{
+			Value:       "-dms",
+			ShouldError: true,
+		},
+		{
+			Value:       "dms-",
+			ShouldError: true,
+		},
+		{
+			Value:       "dms!service",
+			ShouldError: true,
+		},
+		{
+			Value:       acctest.RandStringFromCharSet(63, acctest.CharSetAlpha),
+			ShouldError: false,
+		},
+		{
+			Value:       acctest.RandStringFromCharSet(64, acctest.CharSetAlpha),
+			ShouldError: true,
+		},
+	}
+
+	for _, tc := range cases {
+		_, errors := azure.ValidateDatabaseMigrationServiceName()(tc.Value, "name")
+
+		hasErrors := len(errors) > 0
+		if hasErrors != tc.ShouldError {
+			t.Fatalf("Expected %q to return errors %t but got %d errors", tc.Value, tc.ShouldError, len(errors))
+		}
+	}
+}
+
+func TestAccAzureRMDatabaseMigrationService_basic(t *testing.T) {
+	resourceName := "azurerm_database_migration_service.test"
+	ri := acctest.RandInt()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMDatabaseMigrationServiceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMDatabaseMigrationService_basic(ri, testLocation()),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMDatabaseMigrationServiceExists(resourceName),
+					resource.TestCheckResourceAttrSet(resourceName, "subnet_id"),
+					resource.TestCheckResourceAttr(resourceName, "sku_name", "Standard_1vCores"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAzureRMDatabaseMigrationService_update(t *testing.T) {
+	resourceName := "azurerm_database_migration_service.test"
+	ri := acctest.RandInt()
+	location := testLocation()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMDatabaseMigrationServiceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMDatabaseMigrationService_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMDatabaseMigrationServiceExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				Config: testAccAzureRMDatabaseMigrationService_tags(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMDatabaseMigrationServiceExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.name", "test"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testCheckAzureRMDatabaseMigrationServiceExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Bad: Not found: %s", resourceName)
+		}
+
+		name := rs.Primary.Attributes["name"]
+		resourceGroup, hasResourceGroup := rs.Primary.Attributes["resource_group_name"]
+		if !hasResourceGroup {
+			return fmt.Errorf("Bad: No resource group found in state for Database Migration Service: %s", name)
+		}
+
+		client := testAccProvider.Meta().(*ArmClient).dmsServicesClient
+		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+		resp, err := client.Get(ctx, resourceGroup, name)
+		if err != nil {
+			if resp.StatusCode == http.StatusNotFound {
+				return fmt.Errorf("Bad: Database Migration Service %q (Resource Group %q) does not exist", name, resourceGroup)
+			}
+
+			return fmt.Errorf("Bad: Get on dmsServicesClient: %+v", err)
+		}
+
+		return nil
+	}
+}
+
+func testCheckAzureRMDatabaseMigrationServiceDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*ArmClient).dmsServicesClient
+	ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_database_migration_service" {
+			continue
+		}
+
+		name := rs.Primary.Attributes["name"]
+		resourceGroup := rs.Primary.Attributes["resource_group_name"]
+		resp, err := client.Get(ctx, resourceGroup, name)
+
+		if err != nil {
+			if resp.StatusCode == http.StatusNotFound {
+				return nil
+			}
+
+			return err
+		}
+
+		return fmt.Errorf("Bad: Database Migration Service %q (Resource Group %q) still exists", name, resourceGroup)
+	}
+
+	return nil
+}
+
+func testAccAzureRMDatabaseMigrationService_template(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_virtual_network" "test" {
+  name                = "acctestvnet-%d"
+  address_space       = ["10.0.0.0/16"]
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+}
+
+resource "azurerm_subnet" "test" {
+  name                 = "acctestsubnet-%d"
+  resource_group_name  = "${azurerm_resource_group.test.name}"
+  virtual_network_name = "${azurerm_virtual_network.test.name}"
+  address_prefix       = "10.0.1.0/24"
+}
+`, rInt, location, rInt, rInt)
+}
+
+func testAccAzureRMDatabaseMigrationService_basic(rInt int, location string) string {
+	template := testAccAzureRMDatabaseMigrationService_template(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_database_migration_service" "test" {
+  name                = "acctestdms-%d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  subnet_id           = "${azurerm_subnet.test.id}"
+  sku_name            = "Standard_1vCores"
+}
+`, template, rInt)
+}
+
+func testAccAzureRMDatabaseMigrationService_tags(rInt int, location string) string {
+	template := testAccAzureRMDatabaseMigrationService_template(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_database_migration_service" "test" {
+  name                = "acctestdms-%d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  subnet_id           = "${azurerm_subnet.test.id}"
+  sku_name            = "Standard_1vCores"
+
+  tags {
+    name = "test"
+  }
+}
+`, template, rInt)
+}
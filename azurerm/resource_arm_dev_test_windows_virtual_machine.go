@@ -57,8 +57,9 @@ func resourceArmDevTestWindowsVirtualMachine() *schema.Resource {
 			},
 
 			"password": {
-				Type:     schema.TypeString,
-				Required: true,
+				Type:      schema.TypeString,
+				Required:  true,
+				Sensitive: true,
 				// since this isn't returned from the API
 				ForceNew: true,
 			},
@@ -5,7 +5,7 @@ import (
 	"net/http"
 	"testing"
 
-	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2018-04-01/network"
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2018-08-01/network"
 	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
@@ -29,11 +29,13 @@ func TestAccAzureRMExpressRouteCircuit(t *testing.T) {
 			"azurePrivatePeering": testAccAzureRMExpressRouteCircuitPeering_azurePrivatePeering,
 		},
 		"MicrosoftPeering": {
-			"microsoftPeering": testAccAzureRMExpressRouteCircuitPeering_microsoftPeering,
+			"microsoftPeering":                testAccAzureRMExpressRouteCircuitPeering_microsoftPeering,
+			"microsoftPeeringWithRouteFilter": testAccAzureRMExpressRouteCircuitPeering_microsoftPeeringWithRouteFilter,
 		},
 		"authorization": {
-			"basic":    testAccAzureRMExpressRouteCircuitAuthorization_basic,
-			"multiple": testAccAzureRMExpressRouteCircuitAuthorization_multiple,
+			"basic":      testAccAzureRMExpressRouteCircuitAuthorization_basic,
+			"multiple":   testAccAzureRMExpressRouteCircuitAuthorization_multiple,
+			"dataSource": testAccDataSourceAzureRMExpressRouteCircuitAuthorization_basic,
 		},
 	}
 
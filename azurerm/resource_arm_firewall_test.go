@@ -64,6 +64,7 @@ func TestAccAzureRMFirewall_basic(t *testing.T) {
 					testCheckAzureRMFirewallExists(resourceName),
 					resource.TestCheckResourceAttr(resourceName, "ip_configuration.0.name", "configuration"),
 					resource.TestCheckResourceAttrSet(resourceName, "ip_configuration.0.private_ip_address"),
+					resource.TestCheckResourceAttrPair(resourceName, "ip_configuration.0.internal_public_ip_address_id", "azurerm_public_ip.test", "id"),
 				),
 			},
 			{
@@ -100,6 +101,8 @@ func TestAccAzureRMFirewall_withTags(t *testing.T) {
 					testCheckAzureRMFirewallExists(resourceName),
 					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
 					resource.TestCheckResourceAttr(resourceName, "tags.environment", "staging"),
+					// the IP Configuration is sent again on update, so should still reference the Public IP
+					resource.TestCheckResourceAttrPair(resourceName, "ip_configuration.0.internal_public_ip_address_id", "azurerm_public_ip.test", "id"),
 				),
 			},
 			{
@@ -0,0 +1,963 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/services/preview/frontdoor/mgmt/2018-08-01-preview/frontdoor"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/response"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmFrontDoor() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmFrontDoorCreateUpdate,
+		Read:   resourceArmFrontDoorRead,
+		Update: resourceArmFrontDoorCreateUpdate,
+		Delete: resourceArmFrontDoorDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.FrontDoorName(),
+			},
+
+			"resource_group_name": resourceGroupNameSchema(),
+
+			"friendly_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"load_balancer_enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+
+			"cname": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"routing_rule": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 100,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.NoZeroValues,
+						},
+
+						"enabled": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  true,
+						},
+
+						"frontend_endpoints": {
+							Type:     schema.TypeList,
+							Required: true,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validation.NoZeroValues,
+							},
+						},
+
+						"accepted_protocols": {
+							Type:     schema.TypeList,
+							Required: true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+								ValidateFunc: validation.StringInSlice([]string{
+									string(frontdoor.HTTP),
+									string(frontdoor.HTTPS),
+								}, false),
+							},
+						},
+
+						"patterns_to_match": {
+							Type:     schema.TypeList,
+							Required: true,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validation.NoZeroValues,
+							},
+						},
+
+						"forwarding_configuration": {
+							Type:     schema.TypeList,
+							Required: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"backend_pool_name": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.NoZeroValues,
+									},
+
+									"forwarding_protocol": {
+										Type:     schema.TypeString,
+										Optional: true,
+										Default:  string(frontdoor.HTTPSOnly),
+										ValidateFunc: validation.StringInSlice([]string{
+											string(frontdoor.HTTPOnly),
+											string(frontdoor.HTTPSOnly),
+											string(frontdoor.MatchRequest),
+										}, false),
+									},
+
+									"custom_forwarding_path": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+
+									"cache_enabled": {
+										Type:     schema.TypeBool,
+										Optional: true,
+										Default:  false,
+									},
+
+									"cache_query_parameter_strip_directive": {
+										Type:     schema.TypeString,
+										Optional: true,
+										Default:  string(frontdoor.StripNone),
+										ValidateFunc: validation.StringInSlice([]string{
+											string(frontdoor.StripAll),
+											string(frontdoor.StripNone),
+										}, false),
+									},
+
+									"cache_use_dynamic_compression": {
+										Type:     schema.TypeBool,
+										Optional: true,
+										Default:  false,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+
+			"backend_pool_load_balancing": {
+				Type:     schema.TypeList,
+				Required: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.NoZeroValues,
+						},
+
+						"sample_size": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      4,
+							ValidateFunc: validation.IntAtLeast(1),
+						},
+
+						"successful_samples_required": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      2,
+							ValidateFunc: validation.IntAtLeast(1),
+						},
+
+						"additional_latency_milliseconds": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      0,
+							ValidateFunc: validation.IntAtLeast(0),
+						},
+					},
+				},
+			},
+
+			"backend_pool_health_probe": {
+				Type:     schema.TypeList,
+				Required: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.NoZeroValues,
+						},
+
+						"path": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  "/",
+						},
+
+						"protocol": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  string(frontdoor.HTTP),
+							ValidateFunc: validation.StringInSlice([]string{
+								string(frontdoor.HTTP),
+								string(frontdoor.HTTPS),
+							}, false),
+						},
+
+						"interval_in_seconds": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      120,
+							ValidateFunc: validation.IntAtLeast(1),
+						},
+					},
+				},
+			},
+
+			"backend_pool": {
+				Type:     schema.TypeList,
+				Required: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.NoZeroValues,
+						},
+
+						"backend": {
+							Type:     schema.TypeList,
+							Required: true,
+							MaxItems: 100,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"address": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.NoZeroValues,
+									},
+
+									"host_header": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.NoZeroValues,
+									},
+
+									"http_port": {
+										Type:         schema.TypeInt,
+										Required:     true,
+										ValidateFunc: validation.IntBetween(1, 65535),
+									},
+
+									"https_port": {
+										Type:         schema.TypeInt,
+										Required:     true,
+										ValidateFunc: validation.IntBetween(1, 65535),
+									},
+
+									"enabled": {
+										Type:     schema.TypeBool,
+										Optional: true,
+										Default:  true,
+									},
+
+									"priority": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										Default:      1,
+										ValidateFunc: validation.IntBetween(1, 5),
+									},
+
+									"weight": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										Default:      50,
+										ValidateFunc: validation.IntBetween(1, 1000),
+									},
+								},
+							},
+						},
+
+						"load_balancing_name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.NoZeroValues,
+						},
+
+						"health_probe_name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.NoZeroValues,
+						},
+					},
+				},
+			},
+
+			"frontend_endpoint": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 100,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.NoZeroValues,
+						},
+
+						"host_name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.NoZeroValues,
+						},
+
+						"session_affinity_enabled": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+
+						"session_affinity_ttl_seconds": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      0,
+							ValidateFunc: validation.IntAtLeast(0),
+						},
+
+						"web_application_firewall_policy_link_id": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: azure.ValidateResourceID,
+						},
+					},
+				},
+			},
+
+			"tags": tagsSchema(),
+		},
+	}
+}
+
+func resourceArmFrontDoorCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	armClient := meta.(*ArmClient)
+	client := armClient.frontDoorsClient
+	ctx := armClient.StopContext
+
+	log.Printf("[INFO] preparing arguments for Front Door creation.")
+
+	name := d.Get("name").(string)
+	resourceGroup := d.Get("resource_group_name").(string)
+	tags := d.Get("tags").(map[string]interface{})
+
+	// the Front Door ID is needed to link the sub-resources together
+	frontDoorId := fmt.Sprintf("/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Network/frontDoors/%s", armClient.subscriptionId, resourceGroup, name)
+
+	enabledState := frontdoor.EnabledStateEnumDisabled
+	if d.Get("load_balancer_enabled").(bool) {
+		enabledState = frontdoor.EnabledStateEnumEnabled
+	}
+
+	parameters := frontdoor.FrontDoor{
+		// Front Door is a global resource
+		Location: utils.String("Global"),
+		Tags:     expandTags(tags),
+		Properties: &frontdoor.Properties{
+			EnabledState:          enabledState,
+			RoutingRules:          expandArmFrontDoorRoutingRules(d.Get("routing_rule").([]interface{}), frontDoorId),
+			LoadBalancingSettings: expandArmFrontDoorLoadBalancingSettings(d.Get("backend_pool_load_balancing").([]interface{})),
+			HealthProbeSettings:   expandArmFrontDoorHealthProbeSettings(d.Get("backend_pool_health_probe").([]interface{})),
+			BackendPools:          expandArmFrontDoorBackendPools(d.Get("backend_pool").([]interface{}), frontDoorId),
+			FrontendEndpoints:     expandArmFrontDoorFrontendEndpoints(d.Get("frontend_endpoint").([]interface{})),
+		},
+	}
+
+	if v, ok := d.GetOk("friendly_name"); ok {
+		parameters.Properties.FriendlyName = utils.String(v.(string))
+	}
+
+	future, err := client.CreateOrUpdate(ctx, resourceGroup, name, parameters)
+	if err != nil {
+		return fmt.Errorf("Error creating/updating Front Door %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("Error waiting for creation/update of Front Door %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	read, err := client.Get(ctx, resourceGroup, name)
+	if err != nil {
+		return fmt.Errorf("Error retrieving Front Door %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	if read.ID == nil {
+		return fmt.Errorf("Cannot read Front Door %q (Resource Group %q) ID", name, resourceGroup)
+	}
+
+	d.SetId(*read.ID)
+
+	return resourceArmFrontDoorRead(d, meta)
+}
+
+func resourceArmFrontDoorRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).frontDoorsClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	name := frontDoorNameFromResourceID(id)
+
+	resp, err := client.Get(ctx, resourceGroup, name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] Front Door %q was not found in Resource Group %q - removing from state!", name, resourceGroup)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("Error retrieving Front Door %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	d.Set("name", resp.Name)
+	d.Set("resource_group_name", resourceGroup)
+
+	if props := resp.Properties; props != nil {
+		d.Set("friendly_name", props.FriendlyName)
+		d.Set("cname", props.Cname)
+		d.Set("load_balancer_enabled", props.EnabledState == frontdoor.EnabledStateEnumEnabled)
+
+		if err := d.Set("routing_rule", flattenArmFrontDoorRoutingRules(props.RoutingRules)); err != nil {
+			return fmt.Errorf("Error setting `routing_rule`: %+v", err)
+		}
+
+		if err := d.Set("backend_pool_load_balancing", flattenArmFrontDoorLoadBalancingSettings(props.LoadBalancingSettings)); err != nil {
+			return fmt.Errorf("Error setting `backend_pool_load_balancing`: %+v", err)
+		}
+
+		if err := d.Set("backend_pool_health_probe", flattenArmFrontDoorHealthProbeSettings(props.HealthProbeSettings)); err != nil {
+			return fmt.Errorf("Error setting `backend_pool_health_probe`: %+v", err)
+		}
+
+		if err := d.Set("backend_pool", flattenArmFrontDoorBackendPools(props.BackendPools)); err != nil {
+			return fmt.Errorf("Error setting `backend_pool`: %+v", err)
+		}
+
+		if err := d.Set("frontend_endpoint", flattenArmFrontDoorFrontendEndpoints(props.FrontendEndpoints)); err != nil {
+			return fmt.Errorf("Error setting `frontend_endpoint`: %+v", err)
+		}
+	}
+
+	flattenAndSetTags(d, resp.Tags)
+
+	return nil
+}
+
+func resourceArmFrontDoorDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).frontDoorsClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	name := frontDoorNameFromResourceID(id)
+
+	future, err := client.Delete(ctx, resourceGroup, name)
+	if err != nil {
+		if response.WasNotFound(future.Response()) {
+			return nil
+		}
+		return fmt.Errorf("Error deleting Front Door %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		if !response.WasNotFound(future.Response()) {
+			return fmt.Errorf("Error waiting for the deletion of Front Door %q (Resource Group %q): %+v", name, resourceGroup, err)
+		}
+	}
+
+	return nil
+}
+
+// the API returns the Front Door ID with a lower-cased `frontdoors` segment
+func frontDoorNameFromResourceID(id *ResourceID) string {
+	if name, ok := id.Path["frontDoors"]; ok {
+		return name
+	}
+	return id.Path["frontdoors"]
+}
+
+// sub-resources are referenced by ID, but are configured by name within the Front Door
+func frontDoorSubResourceName(input *string) string {
+	if input == nil {
+		return ""
+	}
+
+	segments := strings.Split(*input, "/")
+	return segments[len(segments)-1]
+}
+
+func expandArmFrontDoorRoutingRules(input []interface{}, frontDoorId string) *[]frontdoor.RoutingRule {
+	rules := make([]frontdoor.RoutingRule, 0)
+
+	for _, v := range input {
+		raw := v.(map[string]interface{})
+
+		frontendEndpoints := make([]frontdoor.SubResource, 0)
+		for _, endpoint := range raw["frontend_endpoints"].([]interface{}) {
+			frontendEndpoints = append(frontendEndpoints, frontdoor.SubResource{
+				ID: utils.String(fmt.Sprintf("%s/frontendEndpoints/%s", frontDoorId, endpoint.(string))),
+			})
+		}
+
+		acceptedProtocols := make([]frontdoor.Protocol, 0)
+		for _, protocol := range raw["accepted_protocols"].([]interface{}) {
+			acceptedProtocols = append(acceptedProtocols, frontdoor.Protocol(protocol.(string)))
+		}
+
+		enabledState := frontdoor.EnabledStateEnumDisabled
+		if raw["enabled"].(bool) {
+			enabledState = frontdoor.EnabledStateEnumEnabled
+		}
+
+		props := frontdoor.RoutingRuleProperties{
+			FrontendEndpoints: &frontendEndpoints,
+			AcceptedProtocols: &acceptedProtocols,
+			PatternsToMatch:   utils.ExpandStringArray(raw["patterns_to_match"].([]interface{})),
+			EnabledState:      enabledState,
+		}
+
+		forwardingConfigs := raw["forwarding_configuration"].([]interface{})
+		if len(forwardingConfigs) > 0 && forwardingConfigs[0] != nil {
+			config := forwardingConfigs[0].(map[string]interface{})
+
+			props.BackendPool = &frontdoor.SubResource{
+				ID: utils.String(fmt.Sprintf("%s/backendPools/%s", frontDoorId, config["backend_pool_name"].(string))),
+			}
+			props.ForwardingProtocol = frontdoor.ForwardingProtocol(config["forwarding_protocol"].(string))
+
+			if path := config["custom_forwarding_path"].(string); path != "" {
+				props.CustomForwardingPath = utils.String(path)
+			}
+
+			if config["cache_enabled"].(bool) {
+				dynamicCompression := frontdoor.DynamicCompressionEnabledDisabled
+				if config["cache_use_dynamic_compression"].(bool) {
+					dynamicCompression = frontdoor.DynamicCompressionEnabledEnabled
+				}
+
+				props.CacheConfiguration = &frontdoor.CacheConfiguration{
+					QueryParameterStripDirective: frontdoor.Query(config["cache_query_parameter_strip_directive"].(string)),
+					DynamicCompression:           dynamicCompression,
+				}
+			}
+		}
+
+		rules = append(rules, frontdoor.RoutingRule{
+			Name:                  utils.String(raw["name"].(string)),
+			RoutingRuleProperties: &props,
+		})
+	}
+
+	return &rules
+}
+
+func expandArmFrontDoorLoadBalancingSettings(input []interface{}) *[]frontdoor.LoadBalancingSettingsModel {
+	settings := make([]frontdoor.LoadBalancingSettingsModel, 0)
+
+	for _, v := range input {
+		raw := v.(map[string]interface{})
+
+		settings = append(settings, frontdoor.LoadBalancingSettingsModel{
+			Name: utils.String(raw["name"].(string)),
+			LoadBalancingSettingsProperties: &frontdoor.LoadBalancingSettingsProperties{
+				SampleSize:                    utils.Int32(int32(raw["sample_size"].(int))),
+				SuccessfulSamplesRequired:     utils.Int32(int32(raw["successful_samples_required"].(int))),
+				AdditionalLatencyMilliseconds: utils.Int32(int32(raw["additional_latency_milliseconds"].(int))),
+			},
+		})
+	}
+
+	return &settings
+}
+
+func expandArmFrontDoorHealthProbeSettings(input []interface{}) *[]frontdoor.HealthProbeSettingsModel {
+	settings := make([]frontdoor.HealthProbeSettingsModel, 0)
+
+	for _, v := range input {
+		raw := v.(map[string]interface{})
+
+		settings = append(settings, frontdoor.HealthProbeSettingsModel{
+			Name: utils.String(raw["name"].(string)),
+			HealthProbeSettingsProperties: &frontdoor.HealthProbeSettingsProperties{
+				Path:              utils.String(raw["path"].(string)),
+				Protocol:          frontdoor.Protocol(raw["protocol"].(string)),
+				IntervalInSeconds: utils.Int32(int32(raw["interval_in_seconds"].(int))),
+			},
+		})
+	}
+
+	return &settings
+}
+
+func expandArmFrontDoorBackendPools(input []interface{}, frontDoorId string) *[]frontdoor.BackendPool {
+	pools := make([]frontdoor.BackendPool, 0)
+
+	for _, v := range input {
+		raw := v.(map[string]interface{})
+
+		backends := make([]frontdoor.Backend, 0)
+		for _, b := range raw["backend"].([]interface{}) {
+			backend := b.(map[string]interface{})
+
+			enabledState := frontdoor.EnabledStateEnumDisabled
+			if backend["enabled"].(bool) {
+				enabledState = frontdoor.EnabledStateEnumEnabled
+			}
+
+			backends = append(backends, frontdoor.Backend{
+				Address:           utils.String(backend["address"].(string)),
+				BackendHostHeader: utils.String(backend["host_header"].(string)),
+				HTTPPort:          utils.Int32(int32(backend["http_port"].(int))),
+				HTTPSPort:         utils.Int32(int32(backend["https_port"].(int))),
+				EnabledState:      enabledState,
+				Priority:          utils.Int32(int32(backend["priority"].(int))),
+				Weight:            utils.Int32(int32(backend["weight"].(int))),
+			})
+		}
+
+		pools = append(pools, frontdoor.BackendPool{
+			Name: utils.String(raw["name"].(string)),
+			BackendPoolProperties: &frontdoor.BackendPoolProperties{
+				Backends: &backends,
+				LoadBalancingSettings: &frontdoor.SubResource{
+					ID: utils.String(fmt.Sprintf("%s/loadBalancingSettings/%s", frontDoorId, raw["load_balancing_name"].(string))),
+				},
+				HealthProbeSettings: &frontdoor.SubResource{
+					ID: utils.String(fmt.Sprintf("%s/healthProbeSettings/%s", frontDoorId, raw["health_probe_name"].(string))),
+				},
+			},
+		})
+	}
+
+	return &pools
+}
+
+func expandArmFrontDoorFrontendEndpoints(input []interface{}) *[]frontdoor.FrontendEndpoint {
+	endpoints := make([]frontdoor.FrontendEndpoint, 0)
+
+	for _, v := range input {
+		raw := v.(map[string]interface{})
+
+		sessionAffinity := frontdoor.SessionAffinityEnabledStateDisabled
+		if raw["session_affinity_enabled"].(bool) {
+			sessionAffinity = frontdoor.SessionAffinityEnabledStateEnabled
+		}
+
+		props := frontdoor.FrontendEndpointProperties{
+			HostName:                    utils.String(raw["host_name"].(string)),
+			SessionAffinityEnabledState: sessionAffinity,
+			SessionAffinityTTLSeconds:   utils.Int32(int32(raw["session_affinity_ttl_seconds"].(int))),
+		}
+
+		if policyId := raw["web_application_firewall_policy_link_id"].(string); policyId != "" {
+			props.WebApplicationFirewallPolicyLink = &frontdoor.FrontendEndpointUpdateParametersWebApplicationFirewallPolicyLink{
+				ID: utils.String(policyId),
+			}
+		}
+
+		endpoints = append(endpoints, frontdoor.FrontendEndpoint{
+			Name:                       utils.String(raw["name"].(string)),
+			FrontendEndpointProperties: &props,
+		})
+	}
+
+	return &endpoints
+}
+
+func flattenArmFrontDoorRoutingRules(input *[]frontdoor.RoutingRule) []interface{} {
+	results := make([]interface{}, 0)
+	if input == nil {
+		return results
+	}
+
+	for _, v := range *input {
+		output := make(map[string]interface{})
+
+		if v.ID != nil {
+			output["id"] = *v.ID
+		}
+
+		if v.Name != nil {
+			output["name"] = *v.Name
+		}
+
+		if props := v.RoutingRuleProperties; props != nil {
+			output["enabled"] = props.EnabledState == frontdoor.EnabledStateEnumEnabled
+
+			frontendEndpoints := make([]interface{}, 0)
+			if endpoints := props.FrontendEndpoints; endpoints != nil {
+				for _, endpoint := range *endpoints {
+					frontendEndpoints = append(frontendEndpoints, frontDoorSubResourceName(endpoint.ID))
+				}
+			}
+			output["frontend_endpoints"] = frontendEndpoints
+
+			acceptedProtocols := make([]interface{}, 0)
+			if protocols := props.AcceptedProtocols; protocols != nil {
+				for _, protocol := range *protocols {
+					acceptedProtocols = append(acceptedProtocols, string(protocol))
+				}
+			}
+			output["accepted_protocols"] = acceptedProtocols
+			output["patterns_to_match"] = utils.FlattenStringArray(props.PatternsToMatch)
+
+			forwardingConfig := map[string]interface{}{
+				"forwarding_protocol":                   string(props.ForwardingProtocol),
+				"cache_enabled":                         false,
+				"cache_query_parameter_strip_directive": string(frontdoor.StripNone),
+				"cache_use_dynamic_compression":         false,
+			}
+
+			if pool := props.BackendPool; pool != nil {
+				forwardingConfig["backend_pool_name"] = frontDoorSubResourceName(pool.ID)
+			}
+
+			if props.CustomForwardingPath != nil {
+				forwardingConfig["custom_forwarding_path"] = *props.CustomForwardingPath
+			}
+
+			if cache := props.CacheConfiguration; cache != nil {
+				forwardingConfig["cache_enabled"] = true
+				forwardingConfig["cache_query_parameter_strip_directive"] = string(cache.QueryParameterStripDirective)
+				forwardingConfig["cache_use_dynamic_compression"] = cache.DynamicCompression == frontdoor.DynamicCompressionEnabledEnabled
+			}
+
+			output["forwarding_configuration"] = []interface{}{forwardingConfig}
+		}
+
+		results = append(results, output)
+	}
+
+	return results
+}
+
+func flattenArmFrontDoorLoadBalancingSettings(input *[]frontdoor.LoadBalancingSettingsModel) []interface{} {
+	results := make([]interface{}, 0)
+	if input == nil {
+		return results
+	}
+
+	for _, v := range *input {
+		output := make(map[string]interface{})
+
+		if v.ID != nil {
+			output["id"] = *v.ID
+		}
+
+		if v.Name != nil {
+			output["name"] = *v.Name
+		}
+
+		if props := v.LoadBalancingSettingsProperties; props != nil {
+			if props.SampleSize != nil {
+				output["sample_size"] = int(*props.SampleSize)
+			}
+
+			if props.SuccessfulSamplesRequired != nil {
+				output["successful_samples_required"] = int(*props.SuccessfulSamplesRequired)
+			}
+
+			if props.AdditionalLatencyMilliseconds != nil {
+				output["additional_latency_milliseconds"] = int(*props.AdditionalLatencyMilliseconds)
+			}
+		}
+
+		results = append(results, output)
+	}
+
+	return results
+}
+
+func flattenArmFrontDoorHealthProbeSettings(input *[]frontdoor.HealthProbeSettingsModel) []interface{} {
+	results := make([]interface{}, 0)
+	if input == nil {
+		return results
+	}
+
+	for _, v := range *input {
+		output := make(map[string]interface{})
+
+		if v.ID != nil {
+			output["id"] = *v.ID
+		}
+
+		if v.Name != nil {
+			output["name"] = *v.Name
+		}
+
+		if props := v.HealthProbeSettingsProperties; props != nil {
+			if props.Path != nil {
+				output["path"] = *props.Path
+			}
+
+			output["protocol"] = string(props.Protocol)
+
+			if props.IntervalInSeconds != nil {
+				output["interval_in_seconds"] = int(*props.IntervalInSeconds)
+			}
+		}
+
+		results = append(results, output)
+	}
+
+	return results
+}
+
+func flattenArmFrontDoorBackendPools(input *[]frontdoor.BackendPool) []interface{} {
+	results := make([]interface{}, 0)
+	if input == nil {
+		return results
+	}
+
+	for _, v := range *input {
+		output := make(map[string]interface{})
+
+		if v.ID != nil {
+			output["id"] = *v.ID
+		}
+
+		if v.Name != nil {
+			output["name"] = *v.Name
+		}
+
+		if props := v.BackendPoolProperties; props != nil {
+			backends := make([]interface{}, 0)
+			if props.Backends != nil {
+				for _, backend := range *props.Backends {
+					result := map[string]interface{}{
+						"enabled": backend.EnabledState == frontdoor.EnabledStateEnumEnabled,
+					}
+
+					if backend.Address != nil {
+						result["address"] = *backend.Address
+					}
+
+					if backend.BackendHostHeader != nil {
+						result["host_header"] = *backend.BackendHostHeader
+					}
+
+					if backend.HTTPPort != nil {
+						result["http_port"] = int(*backend.HTTPPort)
+					}
+
+					if backend.HTTPSPort != nil {
+						result["https_port"] = int(*backend.HTTPSPort)
+					}
+
+					if backend.Priority != nil {
+						result["priority"] = int(*backend.Priority)
+					}
+
+					if backend.Weight != nil {
+						result["weight"] = int(*backend.Weight)
+					}
+
+					backends = append(backends, result)
+				}
+			}
+			output["backend"] = backends
+
+			if settings := props.LoadBalancingSettings; settings != nil {
+				output["load_balancing_name"] = frontDoorSubResourceName(settings.ID)
+			}
+
+			if settings := props.HealthProbeSettings; settings != nil {
+				output["health_probe_name"] = frontDoorSubResourceName(settings.ID)
+			}
+		}
+
+		results = append(results, output)
+	}
+
+	return results
+}
+
+func flattenArmFrontDoorFrontendEndpoints(input *[]frontdoor.FrontendEndpoint) []interface{} {
+	results := make([]interface{}, 0)
+	if input == nil {
+		return results
+	}
+
+	for _, v := range *input {
+		output := make(map[string]interface{})
+
+		if v.ID != nil {
+			output["id"] = *v.ID
+		}
+
+		if v.Name != nil {
+			output["name"] = *v.Name
+		}
+
+		if props := v.FrontendEndpointProperties; props != nil {
+			if props.HostName != nil {
+				output["host_name"] = *props.HostName
+			}
+
+			output["session_affinity_enabled"] = props.SessionAffinityEnabledState == frontdoor.SessionAffinityEnabledStateEnabled
+
+			if props.SessionAffinityTTLSeconds != nil {
+				output["session_affinity_ttl_seconds"] = int(*props.SessionAffinityTTLSeconds)
+			}
+
+			if link := props.WebApplicationFirewallPolicyLink; link != nil && link.ID != nil {
+				output["web_application_firewall_policy_link_id"] = *link.ID
+			}
+		}
+
+		results = append(results, output)
+	}
+
+	return results
+}
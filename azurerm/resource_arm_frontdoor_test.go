@@ -0,0 +1,260 @@
+package azurerm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func TestAccAzureRMFrontDoor_basic(t *testing.T) {
+	resourceName := "azurerm_frontdoor.test"
+	ri := acctest.RandInt()
+	location := testLocation()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMFrontDoorDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMFrontDoor_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMFrontDoorExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "load_balancer_enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "routing_rule.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "backend_pool.0.backend.#", "1"),
+					resource.TestCheckResourceAttrSet(resourceName, "cname"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAzureRMFrontDoor_complete(t *testing.T) {
+	resourceName := "azurerm_frontdoor.test"
+	ri := acctest.RandInt()
+	location := testLocation()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMFrontDoorDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMFrontDoor_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMFrontDoorExists(resourceName),
+				),
+			},
+			{
+				Config: testAccAzureRMFrontDoor_complete(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMFrontDoorExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "friendly_name", "tf-acctest"),
+					resource.TestCheckResourceAttr(resourceName, "routing_rule.0.forwarding_configuration.0.cache_enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "backend_pool.0.backend.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testCheckAzureRMFrontDoorExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		// Ensure we have enough information in state to look up in API
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		name := rs.Primary.Attributes["name"]
+		resourceGroup := rs.Primary.Attributes["resource_group_name"]
+
+		client := testAccProvider.Meta().(*ArmClient).frontDoorsClient
+		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+		resp, err := client.Get(ctx, resourceGroup, name)
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return fmt.Errorf("Bad: Front Door %q (Resource Group %q) does not exist", name, resourceGroup)
+			}
+
+			return fmt.Errorf("Bad: Get on frontDoorsClient: %+v", err)
+		}
+
+		return nil
+	}
+}
+
+func testCheckAzureRMFrontDoorDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*ArmClient).frontDoorsClient
+	ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_frontdoor" {
+			continue
+		}
+
+		name := rs.Primary.Attributes["name"]
+		resourceGroup := rs.Primary.Attributes["resource_group_name"]
+
+		resp, err := client.Get(ctx, resourceGroup, name)
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return nil
+			}
+
+			return err
+		}
+
+		return fmt.Errorf("Front Door %q (Resource Group %q) still exists", name, resourceGroup)
+	}
+
+	return nil
+}
+
+func testAccAzureRMFrontDoor_basic(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_frontdoor" "test" {
+  name                = "acctestfd-%d"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+
+  routing_rule {
+    name               = "routing-rule"
+    accepted_protocols = ["Http", "Https"]
+    patterns_to_match  = ["/*"]
+    frontend_endpoints = ["frontend-endpoint"]
+
+    forwarding_configuration {
+      forwarding_protocol = "MatchRequest"
+      backend_pool_name   = "backend-bing"
+    }
+  }
+
+  backend_pool_load_balancing {
+    name = "load-balancing-setting"
+  }
+
+  backend_pool_health_probe {
+    name = "health-probe-setting"
+  }
+
+  backend_pool {
+    name                = "backend-bing"
+    load_balancing_name = "load-balancing-setting"
+    health_probe_name   = "health-probe-setting"
+
+    backend {
+      host_header = "www.bing.com"
+      address     = "www.bing.com"
+      http_port   = 80
+      https_port  = 443
+    }
+  }
+
+  frontend_endpoint {
+    name      = "frontend-endpoint"
+    host_name = "acctestfd-%d.azurefd.net"
+  }
+}
+`, rInt, location, rInt, rInt)
+}
+
+func testAccAzureRMFrontDoor_complete(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_frontdoor" "test" {
+  name                = "acctestfd-%d"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  friendly_name       = "tf-acctest"
+
+  routing_rule {
+    name               = "routing-rule"
+    accepted_protocols = ["Https"]
+    patterns_to_match  = ["/*"]
+    frontend_endpoints = ["frontend-endpoint"]
+
+    forwarding_configuration {
+      forwarding_protocol                   = "HttpsOnly"
+      backend_pool_name                     = "backend-bing"
+      cache_enabled                         = true
+      cache_query_parameter_strip_directive = "StripAll"
+      cache_use_dynamic_compression         = true
+    }
+  }
+
+  backend_pool_load_balancing {
+    name                            = "load-balancing-setting"
+    sample_size                     = 6
+    successful_samples_required     = 3
+    additional_latency_milliseconds = 10
+  }
+
+  backend_pool_health_probe {
+    name                = "health-probe-setting"
+    path                = "/health"
+    protocol            = "Https"
+    interval_in_seconds = 60
+  }
+
+  backend_pool {
+    name                = "backend-bing"
+    load_balancing_name = "load-balancing-setting"
+    health_probe_name   = "health-probe-setting"
+
+    backend {
+      host_header = "www.bing.com"
+      address     = "www.bing.com"
+      http_port   = 80
+      https_port  = 443
+      priority    = 1
+      weight      = 75
+    }
+
+    backend {
+      host_header = "www.microsoft.com"
+      address     = "www.microsoft.com"
+      http_port   = 80
+      https_port  = 443
+      priority    = 2
+      enabled     = false
+    }
+  }
+
+  frontend_endpoint {
+    name                         = "frontend-endpoint"
+    host_name                    = "acctestfd-%d.azurefd.net"
+    session_affinity_enabled     = true
+    session_affinity_ttl_seconds = 300
+  }
+
+  tags {
+    environment = "Production"
+  }
+}
+`, rInt, location, rInt, rInt)
+}
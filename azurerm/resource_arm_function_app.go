@@ -9,6 +9,7 @@ import (
 	"github.com/Azure/azure-sdk-for-go/services/web/mgmt/2018-02-01/web"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
@@ -23,6 +24,7 @@ func resourceArmFunctionApp() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
+		CustomizeDiff: functionAppCustomizeDiff,
 
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -111,12 +113,21 @@ func resourceArmFunctionApp() *schema.Resource {
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"type": {
-							Type:             schema.TypeString,
-							Required:         true,
-							DiffSuppressFunc: ignoreCaseDiffSuppressFunc,
+							Type:     schema.TypeString,
+							Required: true,
 							ValidateFunc: validation.StringInSlice([]string{
 								string(web.SystemAssigned),
-							}, true),
+								string(web.UserAssigned),
+							}, false),
+						},
+						"identity_ids": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MinItems: 1,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: azure.ValidateResourceID,
+							},
 						},
 						"principal_id": {
 							Type:     schema.TypeString,
@@ -166,6 +177,7 @@ func resourceArmFunctionApp() *schema.Resource {
 							Optional: true,
 							Default:  false,
 						},
+						"cors": azure.SchemaAppServiceCorsSettings(),
 						"use_32_bit_worker_process": {
 							Type:     schema.TypeBool,
 							Optional: true,
@@ -254,10 +266,8 @@ func resourceArmFunctionAppCreate(d *schema.ResourceData, meta interface{}) erro
 		},
 	}
 
-	if v, ok := d.GetOk("identity.0.type"); ok {
-		siteEnvelope.Identity = &web.ManagedServiceIdentity{
-			Type: web.ManagedServiceIdentityType(v.(string)),
-		}
+	if _, ok := d.GetOk("identity"); ok {
+		siteEnvelope.Identity = expandFunctionAppIdentity(d.Get("identity").([]interface{}))
 	}
 
 	createFuture, err := client.CreateOrUpdate(ctx, resGroup, name, siteEnvelope)
@@ -325,10 +335,8 @@ func resourceArmFunctionAppUpdate(d *schema.ResourceData, meta interface{}) erro
 		},
 	}
 
-	if v, ok := d.GetOk("identity.0.type"); ok {
-		siteEnvelope.Identity = &web.ManagedServiceIdentity{
-			Type: web.ManagedServiceIdentityType(v.(string)),
-		}
+	if _, ok := d.GetOk("identity"); ok {
+		siteEnvelope.Identity = expandFunctionAppIdentity(d.Get("identity").([]interface{}))
 	}
 
 	future, err := client.CreateOrUpdate(ctx, resGroup, name, siteEnvelope)
@@ -541,6 +549,113 @@ func getBasicFunctionAppAppSettings(d *schema.ResourceData, appServiceTier strin
 	return append(basicSettings, consumptionSettings...)
 }
 
+// functionAppReservedAppSettings are the App Settings which are managed by the Function App resource itself,
+// and the argument which should be used to configure them instead
+var functionAppReservedAppSettings = map[string]string{
+	"AzureWebJobsDashboard":                    "storage_connection_string",
+	"AzureWebJobsStorage":                      "storage_connection_string",
+	"FUNCTIONS_EXTENSION_VERSION":              "version",
+	"WEBSITE_CONTENTAZUREFILECONNECTIONSTRING": "storage_connection_string",
+	"WEBSITE_CONTENTSHARE":                     "name",
+}
+
+func functionAppCustomizeDiff(diff *schema.ResourceDiff, v interface{}) error {
+	if diff.NewValueKnown("identity.0.type") && diff.NewValueKnown("identity.0.identity_ids") {
+		if err := validateFunctionAppIdentity(diff.Get("identity").([]interface{})); err != nil {
+			return err
+		}
+	}
+
+	if !diff.NewValueKnown("app_settings") {
+		return nil
+	}
+
+	appSettings := diff.Get("app_settings").(map[string]interface{})
+	if !diff.NewValueKnown("app_settings.FUNCTIONS_WORKER_RUNTIME") {
+		// the value will be validated by the API once it's known
+		delete(appSettings, "FUNCTIONS_WORKER_RUNTIME")
+	}
+
+	version := ""
+	if diff.NewValueKnown("version") {
+		version = diff.Get("version").(string)
+	}
+
+	return validateFunctionAppAppSettings(version, appSettings)
+}
+
+// functionAppWorkerRuntimes are the language workers which can be set using the `FUNCTIONS_WORKER_RUNTIME` App Setting
+// for each major version of the Functions runtime - version 1 has no language workers
+var functionAppWorkerRuntimes = map[string][]string{
+	"1": {},
+	"2": {"dotnet", "java", "node", "powershell", "python"},
+}
+
+func validateFunctionAppAppSettings(version string, appSettings map[string]interface{}) error {
+	for key := range appSettings {
+		for reserved, argument := range functionAppReservedAppSettings {
+			if strings.EqualFold(key, reserved) {
+				return fmt.Errorf("The App Setting %q is managed by the Function App and can't be set in `app_settings` - use the `%s` argument instead", key, argument)
+			}
+		}
+	}
+
+	v, ok := appSettings["FUNCTIONS_WORKER_RUNTIME"]
+	if !ok {
+		return nil
+	}
+
+	runtime := v.(string)
+	if runtime == "" {
+		return fmt.Errorf("The App Setting `FUNCTIONS_WORKER_RUNTIME` must not be empty")
+	}
+
+	// the version isn't known until apply
+	if version == "" {
+		return nil
+	}
+
+	// the version is either a major version (e.g. `~2`) or a specific version (e.g. `2.0.12050.0`)
+	majorVersion := strings.SplitN(strings.TrimPrefix(version, "~"), ".", 2)[0]
+	runtimes, ok := functionAppWorkerRuntimes[majorVersion]
+	if !ok {
+		// the language workers for other versions (e.g. `beta`) are validated by the API
+		return nil
+	}
+
+	if len(runtimes) == 0 {
+		return fmt.Errorf("The App Setting `FUNCTIONS_WORKER_RUNTIME` requires `version` to be `~2` or later - got %q", version)
+	}
+
+	for _, r := range runtimes {
+		if runtime == r {
+			return nil
+		}
+	}
+
+	return fmt.Errorf("The App Setting `FUNCTIONS_WORKER_RUNTIME` must be one of %q for version %q of the Functions runtime - got %q", runtimes, version, runtime)
+}
+
+func validateFunctionAppIdentity(input []interface{}) error {
+	if len(input) == 0 || input[0] == nil {
+		return nil
+	}
+
+	identity := input[0].(map[string]interface{})
+	identityType := web.ManagedServiceIdentityType(identity["type"].(string))
+	identityIds := identity["identity_ids"].([]interface{})
+
+	if identityType == web.UserAssigned && len(identityIds) == 0 {
+		return fmt.Errorf("`identity_ids` must be specified when the `type` of the `identity` block is %q", string(web.UserAssigned))
+	}
+
+	if identityType != web.UserAssigned && len(identityIds) > 0 {
+		return fmt.Errorf("`identity_ids` can only be specified when the `type` of the `identity` block is %q - got %q", string(web.UserAssigned), string(identityType))
+	}
+
+	return nil
+}
+
 func getFunctionAppServiceTier(ctx context.Context, appServicePlanId string, meta interface{}) (string, error) {
 	id, err := parseAzureResourceID(appServicePlanId)
 	if err != nil {
@@ -588,6 +703,14 @@ func expandFunctionAppSiteConfig(d *schema.ResourceData) web.SiteConfig {
 		siteConfig.AlwaysOn = utils.Bool(v.(bool))
 	}
 
+	if v, ok := config["cors"]; ok {
+		corsSettings := v.([]interface{})
+		if len(corsSettings) > 0 {
+			expand := azure.ExpandAppServiceCorsSettings(corsSettings)
+			siteConfig.Cors = &expand
+		}
+	}
+
 	if v, ok := config["use_32_bit_worker_process"]; ok {
 		siteConfig.Use32BitWorkerProcess = utils.Bool(v.(bool))
 	}
@@ -612,6 +735,8 @@ func flattenFunctionAppSiteConfig(input *web.SiteConfig) []interface{} {
 		result["always_on"] = *input.AlwaysOn
 	}
 
+	result["cors"] = azure.FlattenAppServiceCorsSettings(input.Cors)
+
 	if input.Use32BitWorkerProcess != nil {
 		result["use_32_bit_worker_process"] = *input.Use32BitWorkerProcess
 	}
@@ -658,6 +783,25 @@ func flattenFunctionAppConnectionStrings(input map[string]*web.ConnStringValueTy
 	return results
 }
 
+func expandFunctionAppIdentity(input []interface{}) *web.ManagedServiceIdentity {
+	if len(input) == 0 || input[0] == nil {
+		return nil
+	}
+
+	identity := input[0].(map[string]interface{})
+	identityType := web.ManagedServiceIdentityType(identity["type"].(string))
+
+	result := web.ManagedServiceIdentity{
+		Type: identityType,
+	}
+
+	if identityType == web.UserAssigned {
+		result.IdentityIds = utils.ExpandStringArray(identity["identity_ids"].([]interface{}))
+	}
+
+	return &result
+}
+
 func flattenFunctionAppIdentity(identity *web.ManagedServiceIdentity) interface{} {
 	if identity == nil {
 		return make([]interface{}, 0)
@@ -673,6 +817,12 @@ func flattenFunctionAppIdentity(identity *web.ManagedServiceIdentity) interface{
 		result["tenant_id"] = *identity.TenantID
 	}
 
+	identityIds := make([]string, 0)
+	if identity.IdentityIds != nil {
+		identityIds = *identity.IdentityIds
+	}
+	result["identity_ids"] = identityIds
+
 	return []interface{}{result}
 }
 
@@ -145,6 +145,226 @@ func TestAccAzureRMFunctionApp_appSettings(t *testing.T) {
 	})
 }
 
+func TestAccAzureRMFunctionApp_workerRuntime(t *testing.T) {
+	resourceName := "azurerm_function_app.test"
+	ri := acctest.RandInt()
+	rs := strings.ToLower(acctest.RandString(11))
+	config := testAccAzureRMFunctionApp_workerRuntime(ri, rs, testLocation())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMFunctionAppDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMFunctionAppExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "version", "~2"),
+					resource.TestCheckResourceAttr(resourceName, "app_settings.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "app_settings.FUNCTIONS_WORKER_RUNTIME", "node"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestValidateFunctionAppAppSettings(t *testing.T) {
+	cases := []struct {
+		Version     string
+		AppSettings map[string]interface{}
+		ExpectError bool
+	}{
+		{
+			Version:     "~1",
+			AppSettings: map[string]interface{}{},
+			ExpectError: false,
+		},
+		{
+			Version: "~1",
+			AppSettings: map[string]interface{}{
+				"hello": "world",
+			},
+			ExpectError: false,
+		},
+		{
+			Version: "~2",
+			AppSettings: map[string]interface{}{
+				"FUNCTIONS_EXTENSION_VERSION": "~2",
+			},
+			ExpectError: true,
+		},
+		{
+			Version: "~2",
+			AppSettings: map[string]interface{}{
+				"azurewebjobsstorage": "DefaultEndpointsProtocol=https;",
+			},
+			ExpectError: true,
+		},
+		{
+			Version: "~2",
+			AppSettings: map[string]interface{}{
+				"FUNCTIONS_WORKER_RUNTIME": "node",
+			},
+			ExpectError: false,
+		},
+		{
+			Version: "2.0.12050.0",
+			AppSettings: map[string]interface{}{
+				"FUNCTIONS_WORKER_RUNTIME": "java",
+			},
+			ExpectError: false,
+		},
+		{
+			Version: "~2",
+			AppSettings: map[string]interface{}{
+				"FUNCTIONS_WORKER_RUNTIME": "ruby",
+			},
+			ExpectError: true,
+		},
+		{
+			Version: "~2",
+			AppSettings: map[string]interface{}{
+				"FUNCTIONS_WORKER_RUNTIME": "DotNet",
+			},
+			ExpectError: true,
+		},
+		{
+			Version: "~2",
+			AppSettings: map[string]interface{}{
+				"FUNCTIONS_WORKER_RUNTIME": "",
+			},
+			ExpectError: true,
+		},
+		{
+			Version: "~1",
+			AppSettings: map[string]interface{}{
+				"FUNCTIONS_WORKER_RUNTIME": "dotnet",
+			},
+			ExpectError: true,
+		},
+		{
+			Version: "1.0.11959.0",
+			AppSettings: map[string]interface{}{
+				"FUNCTIONS_WORKER_RUNTIME": "node",
+			},
+			ExpectError: true,
+		},
+		{
+			// the language workers for versions which aren't known are validated by the API
+			Version: "beta",
+			AppSettings: map[string]interface{}{
+				"FUNCTIONS_WORKER_RUNTIME": "python",
+			},
+			ExpectError: false,
+		},
+		{
+			// the version isn't known until apply
+			Version: "",
+			AppSettings: map[string]interface{}{
+				"FUNCTIONS_WORKER_RUNTIME": "python",
+			},
+			ExpectError: false,
+		},
+	}
+
+	for _, tc := range cases {
+		err := validateFunctionAppAppSettings(tc.Version, tc.AppSettings)
+		if tc.ExpectError && err == nil {
+			t.Fatalf("Expected an error for Version %q / App Settings %+v but didn't get one", tc.Version, tc.AppSettings)
+		}
+		if !tc.ExpectError && err != nil {
+			t.Fatalf("Expected no error for Version %q / App Settings %+v but got: %+v", tc.Version, tc.AppSettings, err)
+		}
+	}
+}
+
+func TestExpandFunctionAppIdentity(t *testing.T) {
+	cases := []struct {
+		Type                string
+		ExpectedIdentityIds int
+	}{
+		{
+			Type:                "SystemAssigned",
+			ExpectedIdentityIds: 0,
+		},
+		{
+			Type:                "UserAssigned",
+			ExpectedIdentityIds: 1,
+		},
+	}
+
+	for _, tc := range cases {
+		input := []interface{}{
+			map[string]interface{}{
+				"type":         tc.Type,
+				"identity_ids": []interface{}{"/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.ManagedIdentity/userAssignedIdentities/identity1"},
+			},
+		}
+
+		identity := expandFunctionAppIdentity(input)
+		actual := 0
+		if identity.IdentityIds != nil {
+			actual = len(*identity.IdentityIds)
+		}
+		if actual != tc.ExpectedIdentityIds {
+			t.Fatalf("Expected %d Identity IDs for Type %q but got %d", tc.ExpectedIdentityIds, tc.Type, actual)
+		}
+	}
+}
+
+func TestValidateFunctionAppIdentity(t *testing.T) {
+	identityId := "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.ManagedIdentity/userAssignedIdentities/identity1"
+	cases := []struct {
+		Type        string
+		IdentityIds []interface{}
+		ExpectError bool
+	}{
+		{
+			Type:        "SystemAssigned",
+			IdentityIds: []interface{}{},
+			ExpectError: false,
+		},
+		{
+			Type:        "SystemAssigned",
+			IdentityIds: []interface{}{identityId},
+			ExpectError: true,
+		},
+		{
+			Type:        "UserAssigned",
+			IdentityIds: []interface{}{identityId},
+			ExpectError: false,
+		},
+		{
+			Type:        "UserAssigned",
+			IdentityIds: []interface{}{},
+			ExpectError: true,
+		},
+	}
+
+	for _, tc := range cases {
+		input := []interface{}{
+			map[string]interface{}{
+				"type":         tc.Type,
+				"identity_ids": tc.IdentityIds,
+			},
+		}
+
+		err := validateFunctionAppIdentity(input)
+		if tc.ExpectError && err == nil {
+			t.Fatalf("Expected an error for Type %q / Identity IDs %+v but didn't get one", tc.Type, tc.IdentityIds)
+		}
+		if !tc.ExpectError && err != nil {
+			t.Fatalf("Expected no error for Type %q / Identity IDs %+v but got: %+v", tc.Type, tc.IdentityIds, err)
+		}
+	}
+}
+
 func TestAccAzureRMFunctionApp_siteConfig(t *testing.T) {
 	resourceName := "azurerm_function_app.test"
 	ri := acctest.RandInt()
@@ -172,6 +392,34 @@ func TestAccAzureRMFunctionApp_siteConfig(t *testing.T) {
 	})
 }
 
+func TestAccAzureRMFunctionApp_corsSettings(t *testing.T) {
+	resourceName := "azurerm_function_app.test"
+	ri := acctest.RandInt()
+	rs := strings.ToLower(acctest.RandString(11))
+	config := testAccAzureRMFunctionApp_corsSettings(ri, rs, testLocation())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMFunctionAppDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMFunctionAppExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "site_config.0.cors.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "site_config.0.cors.0.allowed_origins.#", "2"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
 func TestAccAzureRMFunctionApp_connectionStrings(t *testing.T) {
 	resourceName := "azurerm_function_app.test"
 	ri := acctest.RandInt()
@@ -455,6 +703,30 @@ func TestAccAzureRMFunctionApp_updateIdentity(t *testing.T) {
 	})
 }
 
+func TestAccAzureRMFunctionApp_userAssignedIdentity(t *testing.T) {
+	resourceName := "azurerm_function_app.test"
+	ri := acctest.RandInt()
+	rs := strings.ToLower(acctest.RandString(11))
+	config := testAccAzureRMFunctionApp_userAssignedIdentity(ri, rs, testLocation())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMFunctionAppDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMFunctionAppExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "identity.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "identity.0.type", "UserAssigned"),
+					resource.TestCheckResourceAttr(resourceName, "identity.0.identity_ids.#", "1"),
+				),
+			},
+		},
+	})
+}
+
 func testCheckAzureRMFunctionAppDestroy(s *terraform.State) error {
 	client := testAccProvider.Meta().(*ArmClient).appServicesClient
 
@@ -758,6 +1030,45 @@ resource "azurerm_function_app" "test" {
 `, rInt, location, rString)
 }
 
+func testAccAzureRMFunctionApp_workerRuntime(rInt int, rString, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+	name     = "acctestRG-%[1]d"
+	location = "%[2]s"
+}
+
+resource "azurerm_storage_account" "test" {
+	name                     = "acctestsa%[3]s"
+	resource_group_name      = "${azurerm_resource_group.test.name}"
+	location                 = "${azurerm_resource_group.test.location}"
+	account_tier             = "Standard"
+	account_replication_type = "LRS"
+}
+
+resource "azurerm_app_service_plan" "test" {
+	name                = "acctestASP-%[1]d"
+	location            = "${azurerm_resource_group.test.location}"
+	resource_group_name = "${azurerm_resource_group.test.name}"
+	sku {
+		tier = "Standard"
+		size = "S1"
+	}
+}
+
+resource "azurerm_function_app" "test" {
+	name                      = "acctest-%[1]d-func"
+	location                  = "${azurerm_resource_group.test.location}"
+	resource_group_name       = "${azurerm_resource_group.test.name}"
+	app_service_plan_id       = "${azurerm_app_service_plan.test.id}"
+	storage_connection_string = "${azurerm_storage_account.test.primary_connection_string}"
+	version                   = "~2"
+	app_settings {
+		"FUNCTIONS_WORKER_RUNTIME" = "node"
+	}
+}
+`, rInt, location, rString)
+}
+
 func testAccAzureRMFunctionApp_alwaysOn(rInt int, rString, location string) string {
 	return fmt.Sprintf(`
 resource "azurerm_resource_group" "test" {
@@ -796,6 +1107,49 @@ resource "azurerm_function_app" "test" {
 `, rInt, location, rString)
 }
 
+func testAccAzureRMFunctionApp_corsSettings(rInt int, rString, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+	name     = "acctestRG-%[1]d"
+	location = "%[2]s"
+}
+
+resource "azurerm_storage_account" "test" {
+	name                     = "acctestsa%[3]s"
+	resource_group_name      = "${azurerm_resource_group.test.name}"
+	location                 = "${azurerm_resource_group.test.location}"
+	account_tier             = "Standard"
+	account_replication_type = "LRS"
+}
+
+resource "azurerm_app_service_plan" "test" {
+	name                = "acctestASP-%[1]d"
+	location            = "${azurerm_resource_group.test.location}"
+	resource_group_name = "${azurerm_resource_group.test.name}"
+	sku {
+		tier = "Standard"
+		size = "S1"
+	}
+}
+
+resource "azurerm_function_app" "test" {
+	name                      = "acctest-%[1]d-func"
+	location                  = "${azurerm_resource_group.test.location}"
+	resource_group_name       = "${azurerm_resource_group.test.name}"
+	app_service_plan_id       = "${azurerm_app_service_plan.test.id}"
+	storage_connection_string = "${azurerm_storage_account.test.primary_connection_string}"
+	site_config {
+		cors {
+			allowed_origins = [
+				"http://www.contoso.com",
+				"www.contoso.com",
+			]
+		}
+	}
+}
+`, rInt, location, rString)
+}
+
 func testAccAzureRMFunctionApp_connectionStrings(rInt int, rString, location string) string {
 	return fmt.Sprintf(`
 resource "azurerm_resource_group" "test" {
@@ -1110,3 +1464,48 @@ resource "azurerm_function_app" "test" {
   }
 }`, rInt, location, storage)
 }
+
+func testAccAzureRMFunctionApp_userAssignedIdentity(rInt int, storage string, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_user_assigned_identity" "test" {
+  name                = "acct-%[1]d"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  location            = "${azurerm_resource_group.test.location}"
+}
+
+resource "azurerm_storage_account" "test" {
+  name                     = "acctestsa%[3]s"
+  resource_group_name      = "${azurerm_resource_group.test.name}"
+  location                 = "${azurerm_resource_group.test.location}"
+  account_tier             = "Standard"
+  account_replication_type = "LRS"
+}
+
+resource "azurerm_app_service_plan" "test" {
+  name                = "acctestASP-%[1]d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  sku {
+    tier = "Standard"
+    size = "S1"
+  }
+}
+
+resource "azurerm_function_app" "test" {
+  name                      = "acctest-%[1]d-func"
+  location                  = "${azurerm_resource_group.test.location}"
+  resource_group_name       = "${azurerm_resource_group.test.name}"
+  app_service_plan_id       = "${azurerm_app_service_plan.test.id}"
+  storage_connection_string = "${azurerm_storage_account.test.primary_connection_string}"
+
+  identity {
+    type         = "UserAssigned"
+    identity_ids = ["${azurerm_user_assigned_identity.test.id}"]
+  }
+}`, rInt, location, storage)
+}
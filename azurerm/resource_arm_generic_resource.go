@@ -0,0 +1,445 @@
+package azurerm
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+	"strings"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/structure"
+	"github.com/hashicorp/terraform/helper/validation"
+	azureHelpers "github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+// the Generic Resource allows any ARM Resource to be managed by sending the JSON body as-is, which means
+// new Resource Types / API Versions can be used before a typed Resource exists in the Provider
+func resourceArmGenericResource() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmGenericResourceCreateUpdate,
+		Read:   resourceArmGenericResourceRead,
+		Update: resourceArmGenericResourceCreateUpdate,
+		Delete: resourceArmGenericResourceDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceArmGenericResourceImport,
+		},
+
+		CustomizeDiff: resourceArmGenericResourceCustomizeDiff,
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+
+			"parent_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: azureHelpers.ValidateResourceID,
+			},
+
+			// changing the API Version is an in-place update, whereas changing the Resource Type is
+			// handled in the CustomizeDiff
+			"type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateGenericResourceType,
+			},
+
+			"location": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				ForceNew:         true,
+				StateFunc:        azureRMNormalizeLocation,
+				DiffSuppressFunc: azureRMSuppressLocationDiff,
+			},
+
+			"body": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Default:          "{}",
+				ValidateFunc:     validation.ValidateJsonString,
+				DiffSuppressFunc: structure.SuppressJsonDiff,
+			},
+
+			"ignore_casing": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"ignore_missing_property": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+
+			"output": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"tags": tagsSchema(),
+		},
+	}
+}
+
+func resourceArmGenericResourceCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).resourcesClient
+	ctx := meta.(*ArmClient).StopContext
+
+	log.Printf("[INFO] preparing arguments for Generic Resource creation/update.")
+
+	name := d.Get("name").(string)
+	parentId := d.Get("parent_id").(string)
+	resourceType, apiVersion, err := parseGenericResourceType(d.Get("type").(string))
+	if err != nil {
+		return err
+	}
+
+	resourceId := d.Id()
+	if d.IsNewResource() {
+		resourceId, err = buildGenericResourceID(parentId, resourceType, name)
+		if err != nil {
+			return err
+		}
+	}
+
+	payload := make(map[string]interface{})
+	if err := json.Unmarshal([]byte(d.Get("body").(string)), &payload); err != nil {
+		return fmt.Errorf("Error unmarshalling `body` for Generic Resource %q: %+v", resourceId, err)
+	}
+
+	if v, ok := d.GetOk("location"); ok {
+		payload["location"] = azureRMNormalizeLocation(v.(string))
+	}
+
+	if v, ok := d.GetOk("tags"); ok {
+		payload["tags"] = expandTags(v.(map[string]interface{}))
+	}
+
+	resp, err := sendGenericResourceRequest(ctx, client.Client, client.BaseURI, http.MethodPut, resourceId, apiVersion, payload)
+	if err != nil {
+		return fmt.Errorf("Error creating/updating Generic Resource %q (API Version %q): %+v", resourceId, apiVersion, err)
+	}
+
+	future, err := azure.NewFutureFromResponse(resp)
+	if err != nil {
+		return fmt.Errorf("Error creating/updating Generic Resource %q (API Version %q): %+v", resourceId, apiVersion, err)
+	}
+
+	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("Error waiting for creation/update of Generic Resource %q (API Version %q): %+v", resourceId, apiVersion, err)
+	}
+
+	d.SetId(resourceId)
+
+	return resourceArmGenericResourceRead(d, meta)
+}
+
+func resourceArmGenericResourceRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).resourcesClient
+	ctx := meta.(*ArmClient).StopContext
+
+	resourceId := d.Id()
+	_, apiVersion, err := parseGenericResourceType(d.Get("type").(string))
+	if err != nil {
+		return err
+	}
+
+	resp, err := sendGenericResourceRequest(ctx, client.Client, client.BaseURI, http.MethodGet, resourceId, apiVersion, nil)
+	if err != nil {
+		return fmt.Errorf("Error retrieving Generic Resource %q (API Version %q): %+v", resourceId, apiVersion, err)
+	}
+
+	if resp.StatusCode == http.StatusNotFound {
+		log.Printf("[DEBUG] Generic Resource %q was not found - removing from state", resourceId)
+		d.SetId("")
+		return nil
+	}
+
+	remote := make(map[string]interface{})
+	err = autorest.Respond(
+		resp,
+		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		autorest.ByUnmarshallingJSON(&remote),
+		autorest.ByClosing())
+	if err != nil {
+		return fmt.Errorf("Error retrieving Generic Resource %q (API Version %q): %+v", resourceId, apiVersion, err)
+	}
+
+	output, err := json.Marshal(remote)
+	if err != nil {
+		return fmt.Errorf("Error marshalling `output` for Generic Resource %q: %+v", resourceId, err)
+	}
+	d.Set("output", string(output))
+
+	if location, ok := remote["location"].(string); ok {
+		d.Set("location", azureRMNormalizeLocation(location))
+	}
+
+	tags, ok := remote["tags"].(map[string]interface{})
+	if !ok {
+		tags = make(map[string]interface{})
+	}
+	if err := d.Set("tags", tags); err != nil {
+		return fmt.Errorf("Error setting `tags`: %+v", err)
+	}
+
+	// the top-level fields which are either read-only or exposed as their own attributes aren't part of the body
+	for _, key := range []string{"id", "name", "type", "location", "tags", "etag"} {
+		delete(remote, key)
+	}
+
+	// when importing there's no body to compare against, so we track everything which is returned
+	var body interface{} = remote
+	if v := d.Get("body").(string); v != "" {
+		var config interface{}
+		if err := json.Unmarshal([]byte(v), &config); err != nil {
+			return fmt.Errorf("Error unmarshalling `body` for Generic Resource %q: %+v", resourceId, err)
+		}
+
+		body = updatedGenericResourceBody(config, remote, d.Get("ignore_casing").(bool), d.Get("ignore_missing_property").(bool))
+	}
+
+	bodyJson, err := json.Marshal(body)
+	if err != nil {
+		return fmt.Errorf("Error marshalling `body` for Generic Resource %q: %+v", resourceId, err)
+	}
+	d.Set("body", string(bodyJson))
+
+	return nil
+}
+
+func resourceArmGenericResourceDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).resourcesClient
+	ctx := meta.(*ArmClient).StopContext
+
+	resourceId := d.Id()
+	_, apiVersion, err := parseGenericResourceType(d.Get("type").(string))
+	if err != nil {
+		return err
+	}
+
+	resp, err := sendGenericResourceRequest(ctx, client.Client, client.BaseURI, http.MethodDelete, resourceId, apiVersion, nil)
+	if err != nil {
+		return fmt.Errorf("Error deleting Generic Resource %q (API Version %q): %+v", resourceId, apiVersion, err)
+	}
+
+	if resp.StatusCode == http.StatusNotFound {
+		return nil
+	}
+
+	future, err := azure.NewFutureFromResponse(resp)
+	if err != nil {
+		return fmt.Errorf("Error deleting Generic Resource %q (API Version %q): %+v", resourceId, apiVersion, err)
+	}
+
+	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("Error waiting for deletion of Generic Resource %q (API Version %q): %+v", resourceId, apiVersion, err)
+	}
+
+	return nil
+}
+
+func resourceArmGenericResourceImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	// the API Version isn't part of the Resource ID, so it has to be specified when importing
+	parts := strings.Split(d.Id(), "?api-version=")
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return nil, fmt.Errorf("Expected the ID to be in the format `{resourceId}?api-version={apiVersion}` but got %q", d.Id())
+	}
+
+	resourceId := parts[0]
+	resourceType, parentId, name, err := parseGenericResourceID(resourceId)
+	if err != nil {
+		return nil, err
+	}
+
+	d.SetId(resourceId)
+	d.Set("name", name)
+	d.Set("parent_id", parentId)
+	d.Set("type", fmt.Sprintf("%s@%s", resourceType, parts[1]))
+	d.Set("body", "")
+	d.Set("ignore_casing", false)
+	d.Set("ignore_missing_property", true)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func resourceArmGenericResourceCustomizeDiff(d *schema.ResourceDiff, v interface{}) error {
+	if !d.HasChange("type") {
+		return nil
+	}
+
+	old, new := d.GetChange("type")
+	if old.(string) == "" {
+		return nil
+	}
+
+	oldType, _, err := parseGenericResourceType(old.(string))
+	if err != nil {
+		return nil
+	}
+	newType, _, err := parseGenericResourceType(new.(string))
+	if err != nil {
+		return nil
+	}
+
+	if !strings.EqualFold(oldType, newType) {
+		return d.ForceNew("type")
+	}
+
+	return nil
+}
+
+func sendGenericResourceRequest(ctx context.Context, client autorest.Client, baseUri string, method string, resourceId string, apiVersion string, payload interface{}) (*http.Response, error) {
+	decorators := []autorest.PrepareDecorator{
+		autorest.WithMethod(method),
+		autorest.WithBaseURL(baseUri),
+		autorest.WithPath(resourceId),
+		autorest.WithQueryParameters(map[string]interface{}{
+			"api-version": apiVersion,
+		}),
+	}
+	if payload != nil {
+		decorators = append(decorators, autorest.AsContentType("application/json; charset=utf-8"), autorest.WithJSON(payload))
+	}
+
+	req, err := autorest.CreatePreparer(decorators...).Prepare((&http.Request{}).WithContext(ctx))
+	if err != nil {
+		return nil, fmt.Errorf("Error preparing request: %+v", err)
+	}
+
+	return autorest.SendWithSender(client, req, autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
+}
+
+// parseGenericResourceType splits a type in the format `Microsoft.Foo/bars@2018-01-01` into the Resource Type and the API Version
+func parseGenericResourceType(input string) (string, string, error) {
+	parts := strings.Split(input, "@")
+	if len(parts) != 2 || parts[1] == "" {
+		return "", "", fmt.Errorf("Expected the `type` to be in the format `{resourceType}@{apiVersion}` but got %q", input)
+	}
+
+	segments := strings.Split(parts[0], "/")
+	if len(segments) < 2 {
+		return "", "", fmt.Errorf("Expected the Resource Type to be in the format `{namespace}/{type}` but got %q", parts[0])
+	}
+	for _, segment := range segments {
+		if segment == "" {
+			return "", "", fmt.Errorf("Expected the Resource Type to be in the format `{namespace}/{type}` but got %q", parts[0])
+		}
+	}
+
+	return parts[0], parts[1], nil
+}
+
+func validateGenericResourceType(i interface{}, k string) (_ []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return
+	}
+
+	if _, _, err := parseGenericResourceType(v); err != nil {
+		errors = append(errors, fmt.Errorf("%q is invalid: %+v", k, err))
+	}
+
+	return
+}
+
+// buildGenericResourceID returns the Resource ID for a Resource of the specified type within the Parent - top-level
+// Resources (e.g. `Microsoft.Network/virtualNetworks`) are nested under the Provider, whereas child Resources
+// (e.g. `Microsoft.Network/virtualNetworks/subnets`) are nested directly under their Parent Resource
+func buildGenericResourceID(parentId string, resourceType string, name string) (string, error) {
+	segments := strings.Split(resourceType, "/")
+	if len(segments) < 2 {
+		return "", fmt.Errorf("Expected the Resource Type to be in the format `{namespace}/{type}` but got %q", resourceType)
+	}
+
+	parentId = strings.TrimSuffix(parentId, "/")
+	if len(segments) == 2 {
+		return fmt.Sprintf("%s/providers/%s/%s/%s", parentId, segments[0], segments[1], name), nil
+	}
+
+	return fmt.Sprintf("%s/%s/%s", parentId, segments[len(segments)-1], name), nil
+}
+
+// parseGenericResourceID is the inverse of buildGenericResourceID, returning the Resource Type, Parent ID and Name
+func parseGenericResourceID(input string) (string, string, string, error) {
+	index := strings.LastIndex(strings.ToLower(input), "/providers/")
+	if index == -1 {
+		return "", "", "", fmt.Errorf("Expected the ID %q to contain a Resource Provider", input)
+	}
+
+	segments := strings.Split(strings.Trim(input[index+len("/providers/"):], "/"), "/")
+	if len(segments) < 3 || len(segments)%2 == 0 {
+		return "", "", "", fmt.Errorf("Expected the ID %q to be in the format `{parentId}/providers/{namespace}/{type}/{name}`", input)
+	}
+
+	types := []string{segments[0]}
+	for i := 1; i < len(segments); i += 2 {
+		types = append(types, segments[i])
+	}
+
+	name := segments[len(segments)-1]
+	parentId := input[:index]
+	if len(segments) > 3 {
+		parentId = strings.TrimSuffix(strings.TrimSuffix(input, "/"), fmt.Sprintf("/%s/%s", segments[len(segments)-2], name))
+	}
+
+	return strings.Join(types, "/"), parentId, name, nil
+}
+
+// updatedGenericResourceBody returns the body as returned by the API, limited to the properties defined
+// in the configuration so that drift is only detected on the properties being managed
+func updatedGenericResourceBody(config interface{}, remote interface{}, ignoreCasing bool, ignoreMissingProperty bool) interface{} {
+	switch configValue := config.(type) {
+	case map[string]interface{}:
+		remoteValue, ok := remote.(map[string]interface{})
+		if !ok {
+			return remote
+		}
+
+		output := make(map[string]interface{})
+		for key, value := range configValue {
+			if v, ok := remoteValue[key]; ok {
+				output[key] = updatedGenericResourceBody(value, v, ignoreCasing, ignoreMissingProperty)
+				continue
+			}
+
+			// some properties (e.g. secrets) are never returned by the API
+			if ignoreMissingProperty {
+				output[key] = value
+			}
+		}
+		return output
+
+	case []interface{}:
+		remoteValue, ok := remote.([]interface{})
+		if !ok || len(remoteValue) != len(configValue) {
+			return remote
+		}
+
+		output := make([]interface{}, 0)
+		for i, value := range configValue {
+			output = append(output, updatedGenericResourceBody(value, remoteValue[i], ignoreCasing, ignoreMissingProperty))
+		}
+		return output
+
+	case string:
+		if remoteValue, ok := remote.(string); ok && ignoreCasing && strings.EqualFold(configValue, remoteValue) {
+			return configValue
+		}
+		return remote
+	}
+
+	return remote
+}
@@ -0,0 +1,394 @@
+package azurerm
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestParseGenericResourceType(t *testing.T) {
+	cases := []struct {
+		Input        string
+		ResourceType string
+		ApiVersion   string
+		Error        bool
+	}{
+		{
+			Input: "",
+			Error: true,
+		},
+		{
+			Input: "Microsoft.Network/virtualNetworks",
+			Error: true,
+		},
+		{
+			Input: "Microsoft.Network/virtualNetworks@",
+			Error: true,
+		},
+		{
+			Input: "Microsoft.Network@2018-08-01",
+			Error: true,
+		},
+		{
+			Input: "Microsoft.Network//subnets@2018-08-01",
+			Error: true,
+		},
+		{
+			Input:        "Microsoft.Network/virtualNetworks@2018-08-01",
+			ResourceType: "Microsoft.Network/virtualNetworks",
+			ApiVersion:   "2018-08-01",
+		},
+		{
+			Input:        "Microsoft.Network/virtualNetworks/subnets@2018-08-01",
+			ResourceType: "Microsoft.Network/virtualNetworks/subnets",
+			ApiVersion:   "2018-08-01",
+		},
+	}
+
+	for _, v := range cases {
+		resourceType, apiVersion, err := parseGenericResourceType(v.Input)
+		if err != nil {
+			if v.Error {
+				continue
+			}
+
+			t.Fatalf("Expected no error for %q but got: %+v", v.Input, err)
+		}
+
+		if v.Error {
+			t.Fatalf("Expected an error for %q but didn't get one", v.Input)
+		}
+
+		if resourceType != v.ResourceType {
+			t.Fatalf("Expected the Resource Type for %q to be %q but got %q", v.Input, v.ResourceType, resourceType)
+		}
+
+		if apiVersion != v.ApiVersion {
+			t.Fatalf("Expected the API Version for %q to be %q but got %q", v.Input, v.ApiVersion, apiVersion)
+		}
+	}
+}
+
+func TestBuildAndParseGenericResourceID(t *testing.T) {
+	cases := []struct {
+		ParentId     string
+		ResourceType string
+		Name         string
+		Expected     string
+	}{
+		{
+			ParentId:     "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1",
+			ResourceType: "Microsoft.Network/virtualNetworks",
+			Name:         "network1",
+			Expected:     "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Network/virtualNetworks/network1",
+		},
+		{
+			ParentId:     "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Network/virtualNetworks/network1",
+			ResourceType: "Microsoft.Network/virtualNetworks/subnets",
+			Name:         "subnet1",
+			Expected:     "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Network/virtualNetworks/network1/subnets/subnet1",
+		},
+		{
+			ParentId:     "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Network/virtualNetworks/network1",
+			ResourceType: "Microsoft.Authorization/locks",
+			Name:         "lock1",
+			Expected:     "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Network/virtualNetworks/network1/providers/Microsoft.Authorization/locks/lock1",
+		},
+	}
+
+	for _, v := range cases {
+		id, err := buildGenericResourceID(v.ParentId, v.ResourceType, v.Name)
+		if err != nil {
+			t.Fatalf("Expected no error building the ID for %q but got: %+v", v.ResourceType, err)
+		}
+
+		if id != v.Expected {
+			t.Fatalf("Expected the ID to be %q but got %q", v.Expected, id)
+		}
+
+		resourceType, parentId, name, err := parseGenericResourceID(id)
+		if err != nil {
+			t.Fatalf("Expected no error parsing the ID %q but got: %+v", id, err)
+		}
+
+		if resourceType != v.ResourceType {
+			t.Fatalf("Expected the Resource Type for %q to be %q but got %q", id, v.ResourceType, resourceType)
+		}
+
+		if parentId != v.ParentId {
+			t.Fatalf("Expected the Parent ID for %q to be %q but got %q", id, v.ParentId, parentId)
+		}
+
+		if name != v.Name {
+			t.Fatalf("Expected the Name for %q to be %q but got %q", id, v.Name, name)
+		}
+	}
+}
+
+func TestUpdatedGenericResourceBody(t *testing.T) {
+	cases := []struct {
+		Config                string
+		Remote                string
+		IgnoreCasing          bool
+		IgnoreMissingProperty bool
+		Expected              string
+	}{
+		{
+			// additional properties returned by the API are ignored
+			Config:   `{"properties": {"enabled": true}}`,
+			Remote:   `{"properties": {"enabled": true, "provisioningState": "Succeeded"}}`,
+			Expected: `{"properties": {"enabled": true}}`,
+		},
+		{
+			// drift on a configured property is detected
+			Config:   `{"properties": {"enabled": true}}`,
+			Remote:   `{"properties": {"enabled": false}}`,
+			Expected: `{"properties": {"enabled": false}}`,
+		},
+		{
+			Config:   `{"properties": {"password": "secret"}}`,
+			Remote:   `{"properties": {}}`,
+			Expected: `{"properties": {}}`,
+		},
+		{
+			Config:                `{"properties": {"password": "secret"}}`,
+			Remote:                `{"properties": {}}`,
+			IgnoreMissingProperty: true,
+			Expected:              `{"properties": {"password": "secret"}}`,
+		},
+		{
+			Config:   `{"sku": {"name": "standard"}}`,
+			Remote:   `{"sku": {"name": "Standard"}}`,
+			Expected: `{"sku": {"name": "Standard"}}`,
+		},
+		{
+			Config:       `{"sku": {"name": "standard"}}`,
+			Remote:       `{"sku": {"name": "Standard"}}`,
+			IgnoreCasing: true,
+			Expected:     `{"sku": {"name": "standard"}}`,
+		},
+		{
+			Config:   `{"properties": {"addressPrefixes": [{"prefix": "10.0.0.0/16"}]}}`,
+			Remote:   `{"properties": {"addressPrefixes": [{"prefix": "10.0.0.0/16", "id": "abc"}]}}`,
+			Expected: `{"properties": {"addressPrefixes": [{"prefix": "10.0.0.0/16"}]}}`,
+		},
+		{
+			Config:   `{"properties": {"addressPrefixes": [{"prefix": "10.0.0.0/16"}]}}`,
+			Remote:   `{"properties": {"addressPrefixes": [{"prefix": "10.0.0.0/16"}, {"prefix": "10.1.0.0/16"}]}}`,
+			Expected: `{"properties": {"addressPrefixes": [{"prefix": "10.0.0.0/16"}, {"prefix": "10.1.0.0/16"}]}}`,
+		},
+	}
+
+	for _, v := range cases {
+		var config, remote, expected interface{}
+		if err := json.Unmarshal([]byte(v.Config), &config); err != nil {
+			t.Fatalf("Error unmarshalling %q: %+v", v.Config, err)
+		}
+		if err := json.Unmarshal([]byte(v.Remote), &remote); err != nil {
+			t.Fatalf("Error unmarshalling %q: %+v", v.Remote, err)
+		}
+		if err := json.Unmarshal([]byte(v.Expected), &expected); err != nil {
+			t.Fatalf("Error unmarshalling %q: %+v", v.Expected, err)
+		}
+
+		actual := updatedGenericResourceBody(config, remote, v.IgnoreCasing, v.IgnoreMissingProperty)
+		if !reflect.DeepEqual(actual, expected) {
+			t.Fatalf("Expected %+v but got %+v for config %q and remote %q", expected, actual, v.Config, v.Remote)
+		}
+	}
+}
+
+func TestAccAzureRMGenericResource_basic(t *testing.T) {
+	resourceName := "azurerm_generic_resource.test"
+	ri := acctest.RandInt()
+	config := testAccAzureRMGenericResource_basic(ri, testLocation())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMGenericResourceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMGenericResourceExists(resourceName),
+					resource.TestCheckResourceAttrSet(resourceName, "output"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateIdFunc:       testAccAzureRMGenericResourceImportStateIdFunc(resourceName),
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"body"},
+			},
+		},
+	})
+}
+
+func TestAccAzureRMGenericResource_update(t *testing.T) {
+	resourceName := "azurerm_generic_resource.test"
+	ri := acctest.RandInt()
+	location := testLocation()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMGenericResourceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMGenericResource_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMGenericResourceExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				Config: testAccAzureRMGenericResource_updated(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMGenericResourceExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.environment", "Production"),
+				),
+			},
+		},
+	})
+}
+
+func testAccAzureRMGenericResourceImportStateIdFunc(resourceName string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		_, apiVersion, err := parseGenericResourceType(rs.Primary.Attributes["type"])
+		if err != nil {
+			return "", err
+		}
+
+		return fmt.Sprintf("%s?api-version=%s", rs.Primary.ID, apiVersion), nil
+	}
+}
+
+func testCheckAzureRMGenericResourceDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*ArmClient).resourcesClient
+	ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_generic_resource" {
+			continue
+		}
+
+		_, apiVersion, err := parseGenericResourceType(rs.Primary.Attributes["type"])
+		if err != nil {
+			return err
+		}
+
+		resp, err := sendGenericResourceRequest(ctx, client.Client, client.BaseURI, http.MethodGet, rs.Primary.ID, apiVersion, nil)
+		if err != nil {
+			return err
+		}
+
+		if resp.StatusCode != http.StatusNotFound {
+			return fmt.Errorf("Generic Resource %q still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testCheckAzureRMGenericResourceExists(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		// Ensure we have enough information in state to look up in API
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+
+		_, apiVersion, err := parseGenericResourceType(rs.Primary.Attributes["type"])
+		if err != nil {
+			return err
+		}
+
+		client := testAccProvider.Meta().(*ArmClient).resourcesClient
+		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+		resp, err := sendGenericResourceRequest(ctx, client.Client, client.BaseURI, http.MethodGet, rs.Primary.ID, apiVersion, nil)
+		if err != nil {
+			return fmt.Errorf("Bad: Get on resourcesClient: %+v", err)
+		}
+
+		if resp.StatusCode != http.StatusOK {
+			return fmt.Errorf("Bad: Generic Resource %q does not exist (Status Code %d)", rs.Primary.ID, resp.StatusCode)
+		}
+
+		return nil
+	}
+}
+
+func testAccAzureRMGenericResource_basic(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_generic_resource" "test" {
+  name      = "acctestvirtnet%d"
+  parent_id = "${azurerm_resource_group.test.id}"
+  type      = "Microsoft.Network/virtualNetworks@2018-08-01"
+  location  = "${azurerm_resource_group.test.location}"
+
+  body = <<BODY
+{
+  "properties": {
+    "addressSpace": {
+      "addressPrefixes": [
+        "10.0.0.0/16"
+      ]
+    }
+  }
+}
+BODY
+}
+`, rInt, location, rInt)
+}
+
+func testAccAzureRMGenericResource_updated(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_generic_resource" "test" {
+  name      = "acctestvirtnet%d"
+  parent_id = "${azurerm_resource_group.test.id}"
+  type      = "Microsoft.Network/virtualNetworks@2018-08-01"
+  location  = "${azurerm_resource_group.test.location}"
+
+  body = <<BODY
+{
+  "properties": {
+    "addressSpace": {
+      "addressPrefixes": [
+        "10.0.0.0/16",
+        "10.1.0.0/16"
+      ]
+    }
+  }
+}
+BODY
+
+  tags {
+    environment = "Production"
+  }
+}
+`, rInt, location, rInt)
+}
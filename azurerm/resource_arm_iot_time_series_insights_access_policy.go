@@ -0,0 +1,191 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/Azure/azure-sdk-for-go/services/timeseriesinsights/mgmt/2017-11-15/timeseriesinsights"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmIoTTimeSeriesInsightsAccessPolicy() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmIoTTimeSeriesInsightsAccessPolicyCreateUpdate,
+		Read:   resourceArmIoTTimeSeriesInsightsAccessPolicyRead,
+		Update: resourceArmIoTTimeSeriesInsightsAccessPolicyCreateUpdate,
+		Delete: resourceArmIoTTimeSeriesInsightsAccessPolicyDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.TimeSeriesInsightsName(),
+			},
+
+			"time_series_insights_environment_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: azure.ValidateResourceID,
+			},
+
+			"principal_object_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.UUID,
+			},
+
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"roles": {
+				Type:     schema.TypeSet,
+				Required: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+					ValidateFunc: validation.StringInSlice([]string{
+						string(timeseriesinsights.Contributor),
+						string(timeseriesinsights.Reader),
+					}, false),
+				},
+			},
+		},
+	}
+}
+
+func resourceArmIoTTimeSeriesInsightsAccessPolicyCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).tsiAccessPoliciesClient
+	ctx := meta.(*ArmClient).StopContext
+
+	name := d.Get("name").(string)
+	environmentId, err := parseAzureResourceID(d.Get("time_series_insights_environment_id").(string))
+	if err != nil {
+		return err
+	}
+	resourceGroup := environmentId.ResourceGroup
+	environmentName := environmentId.Path["environments"]
+
+	policy := timeseriesinsights.AccessPolicyCreateOrUpdateParameters{
+		AccessPolicyResourceProperties: &timeseriesinsights.AccessPolicyResourceProperties{
+			PrincipalObjectID: utils.String(d.Get("principal_object_id").(string)),
+			Description:       utils.String(d.Get("description").(string)),
+			Roles:             expandArmIoTTimeSeriesInsightsAccessPolicyRoles(d.Get("roles").(*schema.Set).List()),
+		},
+	}
+
+	if _, err := client.CreateOrUpdate(ctx, resourceGroup, environmentName, name, policy); err != nil {
+		return fmt.Errorf("Error creating/updating IoT Time Series Insights Access Policy %q (Environment %q / Resource Group %q): %+v", name, environmentName, resourceGroup, err)
+	}
+
+	read, err := client.Get(ctx, resourceGroup, environmentName, name)
+	if err != nil {
+		return fmt.Errorf("Error retrieving IoT Time Series Insights Access Policy %q (Environment %q / Resource Group %q): %+v", name, environmentName, resourceGroup, err)
+	}
+
+	if read.ID == nil {
+		return fmt.Errorf("Cannot read IoT Time Series Insights Access Policy %q (Environment %q / Resource Group %q) ID", name, environmentName, resourceGroup)
+	}
+
+	d.SetId(*read.ID)
+
+	return resourceArmIoTTimeSeriesInsightsAccessPolicyRead(d, meta)
+}
+
+func resourceArmIoTTimeSeriesInsightsAccessPolicyRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).tsiAccessPoliciesClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	environmentName := id.Path["environments"]
+	name := id.Path["accessPolicies"]
+
+	resp, err := client.Get(ctx, resourceGroup, environmentName, name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] IoT Time Series Insights Access Policy %q was not found in Environment %q / Resource Group %q - removing from state!", name, environmentName, resourceGroup)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("Error retrieving IoT Time Series Insights Access Policy %q (Environment %q / Resource Group %q): %+v", name, environmentName, resourceGroup, err)
+	}
+
+	d.Set("name", resp.Name)
+	d.Set("time_series_insights_environment_id", fmt.Sprintf("/subscriptions/%s/resourceGroups/%s/providers/Microsoft.TimeSeriesInsights/environments/%s", id.SubscriptionID, resourceGroup, environmentName))
+
+	if props := resp.AccessPolicyResourceProperties; props != nil {
+		d.Set("principal_object_id", props.PrincipalObjectID)
+		d.Set("description", props.Description)
+
+		if err := d.Set("roles", flattenArmIoTTimeSeriesInsightsAccessPolicyRoles(props.Roles)); err != nil {
+			return fmt.Errorf("Error setting `roles`: %+v", err)
+		}
+	}
+
+	return nil
+}
+
+func resourceArmIoTTimeSeriesInsightsAccessPolicyDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).tsiAccessPoliciesClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	environmentName := id.Path["environments"]
+	name := id.Path["accessPolicies"]
+
+	resp, err := client.Delete(ctx, resourceGroup, environmentName, name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp) {
+			return nil
+		}
+
+		return fmt.Errorf("Error deleting IoT Time Series Insights Access Policy %q (Environment %q / Resource Group %q): %+v", name, environmentName, resourceGroup, err)
+	}
+
+	return nil
+}
+
+func expandArmIoTTimeSeriesInsightsAccessPolicyRoles(input []interface{}) *[]timeseriesinsights.AccessPolicyRole {
+	roles := make([]timeseriesinsights.AccessPolicyRole, 0)
+
+	for _, v := range input {
+		if v == nil {
+			continue
+		}
+		roles = append(roles, timeseriesinsights.AccessPolicyRole(v.(string)))
+	}
+
+	return &roles
+}
+
+func flattenArmIoTTimeSeriesInsightsAccessPolicyRoles(input *[]timeseriesinsights.AccessPolicyRole) []interface{} {
+	result := make([]interface{}, 0)
+	if input == nil {
+		return result
+	}
+
+	for _, item := range *input {
+		result = append(result, string(item))
+	}
+
+	return result
+}
@@ -0,0 +1,142 @@
+package azurerm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func TestAccAzureRMIoTTimeSeriesInsightsAccessPolicy_basic(t *testing.T) {
+	resourceName := "azurerm_iot_time_series_insights_access_policy.test"
+	ri := acctest.RandInt()
+	location := testLocation()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMIoTTimeSeriesInsightsAccessPolicyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMIoTTimeSeriesInsightsAccessPolicy_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMIoTTimeSeriesInsightsAccessPolicyExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "roles.#", "1"),
+				),
+			},
+			{
+				Config: testAccAzureRMIoTTimeSeriesInsightsAccessPolicy_updated(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMIoTTimeSeriesInsightsAccessPolicyExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "description", "Updated Access Policy"),
+					resource.TestCheckResourceAttr(resourceName, "roles.#", "2"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testCheckAzureRMIoTTimeSeriesInsightsAccessPolicyExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		// Ensure we have enough information in state to look up in API
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		id, err := parseAzureResourceID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		resourceGroup := id.ResourceGroup
+		environmentName := id.Path["environments"]
+		name := id.Path["accessPolicies"]
+
+		client := testAccProvider.Meta().(*ArmClient).tsiAccessPoliciesClient
+		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+		resp, err := client.Get(ctx, resourceGroup, environmentName, name)
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return fmt.Errorf("Bad: IoT Time Series Insights Access Policy %q (Environment %q / Resource Group %q) does not exist", name, environmentName, resourceGroup)
+			}
+
+			return fmt.Errorf("Bad: Get on tsiAccessPoliciesClient: %+v", err)
+		}
+
+		return nil
+	}
+}
+
+func testCheckAzureRMIoTTimeSeriesInsightsAccessPolicyDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*ArmClient).tsiAccessPoliciesClient
+	ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_iot_time_series_insights_access_policy" {
+			continue
+		}
+
+		id, err := parseAzureResourceID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		resourceGroup := id.ResourceGroup
+		environmentName := id.Path["environments"]
+		name := id.Path["accessPolicies"]
+
+		resp, err := client.Get(ctx, resourceGroup, environmentName, name)
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return nil
+			}
+
+			return err
+		}
+
+		return fmt.Errorf("IoT Time Series Insights Access Policy %q (Environment %q / Resource Group %q) still exists", name, environmentName, resourceGroup)
+	}
+
+	return nil
+}
+
+func testAccAzureRMIoTTimeSeriesInsightsAccessPolicy_basic(rInt int, location string) string {
+	template := testAccAzureRMIoTTimeSeriesInsightsStandardEnvironment_basic(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_client_config" "current" {}
+
+resource "azurerm_iot_time_series_insights_access_policy" "test" {
+  name                                = "acctest_tsiap%d"
+  time_series_insights_environment_id = "${azurerm_iot_time_series_insights_standard_environment.test.id}"
+  principal_object_id                 = "${data.azurerm_client_config.current.service_principal_object_id}"
+  roles                               = ["Reader"]
+}
+`, template, rInt)
+}
+
+func testAccAzureRMIoTTimeSeriesInsightsAccessPolicy_updated(rInt int, location string) string {
+	template := testAccAzureRMIoTTimeSeriesInsightsStandardEnvironment_basic(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_client_config" "current" {}
+
+resource "azurerm_iot_time_series_insights_access_policy" "test" {
+  name                                = "acctest_tsiap%d"
+  time_series_insights_environment_id = "${azurerm_iot_time_series_insights_standard_environment.test.id}"
+  principal_object_id                 = "${data.azurerm_client_config.current.service_principal_object_id}"
+  description                         = "Updated Access Policy"
+  roles                               = ["Contributor", "Reader"]
+}
+`, template, rInt)
+}
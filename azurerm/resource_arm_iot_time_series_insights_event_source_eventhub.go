@@ -0,0 +1,190 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/Azure/azure-sdk-for-go/services/timeseriesinsights/mgmt/2017-11-15/timeseriesinsights"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmIoTTimeSeriesInsightsEventSourceEventHub() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmIoTTimeSeriesInsightsEventSourceEventHubCreateUpdate,
+		Read:   resourceArmIoTTimeSeriesInsightsEventSourceEventHubRead,
+		Update: resourceArmIoTTimeSeriesInsightsEventSourceEventHubCreateUpdate,
+		Delete: resourceArmIoTTimeSeriesInsightsEventSourceDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.TimeSeriesInsightsName(),
+			},
+
+			"location": locationSchema(),
+
+			"environment_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: azure.ValidateResourceID,
+			},
+
+			"namespace_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+
+			"eventhub_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+
+			"consumer_group_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+
+			"event_source_resource_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: azure.ValidateResourceID,
+			},
+
+			"shared_access_key_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+
+			"shared_access_key": {
+				Type:         schema.TypeString,
+				Required:     true,
+				Sensitive:    true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+
+			"timestamp_property_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"tags": tagsSchema(),
+		},
+	}
+}
+
+func resourceArmIoTTimeSeriesInsightsEventSourceEventHubCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).tsiEventSourcesClient
+	ctx := meta.(*ArmClient).StopContext
+
+	name := d.Get("name").(string)
+	location := azureRMNormalizeLocation(d.Get("location").(string))
+	tags := d.Get("tags").(map[string]interface{})
+
+	environmentId, err := parseAzureResourceID(d.Get("environment_id").(string))
+	if err != nil {
+		return err
+	}
+	resourceGroup := environmentId.ResourceGroup
+	environmentName := environmentId.Path["environments"]
+
+	eventSource := timeseriesinsights.EventHubEventSourceCreateOrUpdateParameters{
+		Location: utils.String(location),
+		EventHubEventSourceCreationProperties: &timeseriesinsights.EventHubEventSourceCreationProperties{
+			ServiceBusNamespace:   utils.String(d.Get("namespace_name").(string)),
+			EventHubName:          utils.String(d.Get("eventhub_name").(string)),
+			ConsumerGroupName:     utils.String(d.Get("consumer_group_name").(string)),
+			EventSourceResourceID: utils.String(d.Get("event_source_resource_id").(string)),
+			KeyName:               utils.String(d.Get("shared_access_key_name").(string)),
+			SharedAccessKey:       utils.String(d.Get("shared_access_key").(string)),
+			TimestampPropertyName: utils.String(d.Get("timestamp_property_name").(string)),
+		},
+		Tags: expandTags(tags),
+	}
+
+	if _, err := client.CreateOrUpdate(ctx, resourceGroup, environmentName, name, eventSource); err != nil {
+		return fmt.Errorf("Error creating/updating IoT Time Series Insights EventHub Event Source %q (Environment %q / Resource Group %q): %+v", name, environmentName, resourceGroup, err)
+	}
+
+	read, err := client.Get(ctx, resourceGroup, environmentName, name)
+	if err != nil {
+		return fmt.Errorf("Error retrieving IoT Time Series Insights EventHub Event Source %q (Environment %q / Resource Group %q): %+v", name, environmentName, resourceGroup, err)
+	}
+
+	eventSourceResource, ok := read.Value.AsEventHubEventSourceResource()
+	if !ok || eventSourceResource.ID == nil {
+		return fmt.Errorf("Cannot read IoT Time Series Insights EventHub Event Source %q (Environment %q / Resource Group %q) ID", name, environmentName, resourceGroup)
+	}
+
+	d.SetId(*eventSourceResource.ID)
+
+	return resourceArmIoTTimeSeriesInsightsEventSourceEventHubRead(d, meta)
+}
+
+func resourceArmIoTTimeSeriesInsightsEventSourceEventHubRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).tsiEventSourcesClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	environmentName := id.Path["environments"]
+	name := id.Path["eventSources"]
+
+	resp, err := client.Get(ctx, resourceGroup, environmentName, name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] IoT Time Series Insights EventHub Event Source %q was not found in Environment %q / Resource Group %q - removing from state!", name, environmentName, resourceGroup)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("Error retrieving IoT Time Series Insights EventHub Event Source %q (Environment %q / Resource Group %q): %+v", name, environmentName, resourceGroup, err)
+	}
+
+	eventSource, ok := resp.Value.AsEventHubEventSourceResource()
+	if !ok {
+		return fmt.Errorf("Error: IoT Time Series Insights Event Source %q (Environment %q / Resource Group %q) is not an EventHub Event Source", name, environmentName, resourceGroup)
+	}
+
+	d.Set("name", eventSource.Name)
+	d.Set("environment_id", fmt.Sprintf("/subscriptions/%s/resourceGroups/%s/providers/Microsoft.TimeSeriesInsights/environments/%s", id.SubscriptionID, resourceGroup, environmentName))
+	if location := eventSource.Location; location != nil {
+		d.Set("location", azureRMNormalizeLocation(*location))
+	}
+
+	if props := eventSource.EventHubEventSourceResourceProperties; props != nil {
+		d.Set("namespace_name", props.ServiceBusNamespace)
+		d.Set("eventhub_name", props.EventHubName)
+		d.Set("consumer_group_name", props.ConsumerGroupName)
+		d.Set("event_source_resource_id", props.EventSourceResourceID)
+		d.Set("shared_access_key_name", props.KeyName)
+		d.Set("timestamp_property_name", props.TimestampPropertyName)
+	}
+
+	// the `shared_access_key` isn't returned from the API
+
+	flattenAndSetTags(d, eventSource.Tags)
+
+	return nil
+}
@@ -0,0 +1,127 @@
+package azurerm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccAzureRMIoTTimeSeriesInsightsEventSourceEventHub_basic(t *testing.T) {
+	resourceName := "azurerm_iot_time_series_insights_event_source_eventhub.test"
+	ri := acctest.RandInt()
+	location := testLocation()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMIoTTimeSeriesInsightsEventSourceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMIoTTimeSeriesInsightsEventSourceEventHub_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMIoTTimeSeriesInsightsEventSourceExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				Config: testAccAzureRMIoTTimeSeriesInsightsEventSourceEventHub_updated(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMIoTTimeSeriesInsightsEventSourceExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "timestamp_property_name", "timestamp"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.environment", "Test"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"shared_access_key"},
+			},
+		},
+	})
+}
+
+func testAccAzureRMIoTTimeSeriesInsightsEventSourceEventHub_template(rInt int, location string) string {
+	environment := testAccAzureRMIoTTimeSeriesInsightsStandardEnvironment_basic(rInt, location)
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azurerm_eventhub_namespace" "test" {
+  name                = "acctesteventhubnamespace-%[2]d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  sku                 = "Standard"
+}
+
+resource "azurerm_eventhub" "test" {
+  name                = "acctesteventhub-%[2]d"
+  namespace_name      = "${azurerm_eventhub_namespace.test.name}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  partition_count     = 2
+  message_retention   = 1
+}
+
+resource "azurerm_eventhub_consumer_group" "test" {
+  name                = "acctesteventhubcg-%[2]d"
+  namespace_name      = "${azurerm_eventhub_namespace.test.name}"
+  eventhub_name       = "${azurerm_eventhub.test.name}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+}
+
+resource "azurerm_eventhub_authorization_rule" "test" {
+  name                = "acctest-%[2]d"
+  namespace_name      = "${azurerm_eventhub_namespace.test.name}"
+  eventhub_name       = "${azurerm_eventhub.test.name}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  listen              = true
+  send                = false
+  manage              = false
+}
+`, environment, rInt)
+}
+
+func testAccAzureRMIoTTimeSeriesInsightsEventSourceEventHub_basic(rInt int, location string) string {
+	template := testAccAzureRMIoTTimeSeriesInsightsEventSourceEventHub_template(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_iot_time_series_insights_event_source_eventhub" "test" {
+  name                     = "acctest_tsies%d"
+  location                 = "${azurerm_resource_group.test.location}"
+  environment_id           = "${azurerm_iot_time_series_insights_standard_environment.test.id}"
+  namespace_name           = "${azurerm_eventhub_namespace.test.name}"
+  eventhub_name            = "${azurerm_eventhub.test.name}"
+  consumer_group_name      = "${azurerm_eventhub_consumer_group.test.name}"
+  event_source_resource_id = "${azurerm_eventhub.test.id}"
+  shared_access_key_name   = "${azurerm_eventhub_authorization_rule.test.name}"
+  shared_access_key        = "${azurerm_eventhub_authorization_rule.test.primary_key}"
+}
+`, template, rInt)
+}
+
+func testAccAzureRMIoTTimeSeriesInsightsEventSourceEventHub_updated(rInt int, location string) string {
+	template := testAccAzureRMIoTTimeSeriesInsightsEventSourceEventHub_template(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_iot_time_series_insights_event_source_eventhub" "test" {
+  name                     = "acctest_tsies%d"
+  location                 = "${azurerm_resource_group.test.location}"
+  environment_id           = "${azurerm_iot_time_series_insights_standard_environment.test.id}"
+  namespace_name           = "${azurerm_eventhub_namespace.test.name}"
+  eventhub_name            = "${azurerm_eventhub.test.name}"
+  consumer_group_name      = "${azurerm_eventhub_consumer_group.test.name}"
+  event_source_resource_id = "${azurerm_eventhub.test.id}"
+  shared_access_key_name   = "${azurerm_eventhub_authorization_rule.test.name}"
+  shared_access_key        = "${azurerm_eventhub_authorization_rule.test.primary_key}"
+  timestamp_property_name  = "timestamp"
+
+  tags {
+    environment = "Test"
+  }
+}
+`, template, rInt)
+}
@@ -0,0 +1,205 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/Azure/azure-sdk-for-go/services/timeseriesinsights/mgmt/2017-11-15/timeseriesinsights"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmIoTTimeSeriesInsightsEventSourceIoTHub() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmIoTTimeSeriesInsightsEventSourceIoTHubCreateUpdate,
+		Read:   resourceArmIoTTimeSeriesInsightsEventSourceIoTHubRead,
+		Update: resourceArmIoTTimeSeriesInsightsEventSourceIoTHubCreateUpdate,
+		Delete: resourceArmIoTTimeSeriesInsightsEventSourceDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.TimeSeriesInsightsName(),
+			},
+
+			"location": locationSchema(),
+
+			"environment_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: azure.ValidateResourceID,
+			},
+
+			"iothub_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+
+			"consumer_group_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+
+			"event_source_resource_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: azure.ValidateResourceID,
+			},
+
+			"shared_access_key_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+
+			"shared_access_key": {
+				Type:         schema.TypeString,
+				Required:     true,
+				Sensitive:    true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+
+			"timestamp_property_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"tags": tagsSchema(),
+		},
+	}
+}
+
+func resourceArmIoTTimeSeriesInsightsEventSourceIoTHubCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).tsiEventSourcesClient
+	ctx := meta.(*ArmClient).StopContext
+
+	name := d.Get("name").(string)
+	location := azureRMNormalizeLocation(d.Get("location").(string))
+	tags := d.Get("tags").(map[string]interface{})
+
+	environmentId, err := parseAzureResourceID(d.Get("environment_id").(string))
+	if err != nil {
+		return err
+	}
+	resourceGroup := environmentId.ResourceGroup
+	environmentName := environmentId.Path["environments"]
+
+	eventSource := timeseriesinsights.IoTHubEventSourceCreateOrUpdateParameters{
+		Location: utils.String(location),
+		IoTHubEventSourceCreationProperties: &timeseriesinsights.IoTHubEventSourceCreationProperties{
+			IotHubName:            utils.String(d.Get("iothub_name").(string)),
+			ConsumerGroupName:     utils.String(d.Get("consumer_group_name").(string)),
+			EventSourceResourceID: utils.String(d.Get("event_source_resource_id").(string)),
+			KeyName:               utils.String(d.Get("shared_access_key_name").(string)),
+			SharedAccessKey:       utils.String(d.Get("shared_access_key").(string)),
+			TimestampPropertyName: utils.String(d.Get("timestamp_property_name").(string)),
+		},
+		Tags: expandTags(tags),
+	}
+
+	if _, err := client.CreateOrUpdate(ctx, resourceGroup, environmentName, name, eventSource); err != nil {
+		return fmt.Errorf("Error creating/updating IoT Time Series Insights IoTHub Event Source %q (Environment %q / Resource Group %q): %+v", name, environmentName, resourceGroup, err)
+	}
+
+	read, err := client.Get(ctx, resourceGroup, environmentName, name)
+	if err != nil {
+		return fmt.Errorf("Error retrieving IoT Time Series Insights IoTHub Event Source %q (Environment %q / Resource Group %q): %+v", name, environmentName, resourceGroup, err)
+	}
+
+	eventSourceResource, ok := read.Value.AsIoTHubEventSourceResource()
+	if !ok || eventSourceResource.ID == nil {
+		return fmt.Errorf("Cannot read IoT Time Series Insights IoTHub Event Source %q (Environment %q / Resource Group %q) ID", name, environmentName, resourceGroup)
+	}
+
+	d.SetId(*eventSourceResource.ID)
+
+	return resourceArmIoTTimeSeriesInsightsEventSourceIoTHubRead(d, meta)
+}
+
+func resourceArmIoTTimeSeriesInsightsEventSourceIoTHubRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).tsiEventSourcesClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	environmentName := id.Path["environments"]
+	name := id.Path["eventSources"]
+
+	resp, err := client.Get(ctx, resourceGroup, environmentName, name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] IoT Time Series Insights IoTHub Event Source %q was not found in Environment %q / Resource Group %q - removing from state!", name, environmentName, resourceGroup)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("Error retrieving IoT Time Series Insights IoTHub Event Source %q (Environment %q / Resource Group %q): %+v", name, environmentName, resourceGroup, err)
+	}
+
+	eventSource, ok := resp.Value.AsIoTHubEventSourceResource()
+	if !ok {
+		return fmt.Errorf("Error: IoT Time Series Insights Event Source %q (Environment %q / Resource Group %q) is not an IoTHub Event Source", name, environmentName, resourceGroup)
+	}
+
+	d.Set("name", eventSource.Name)
+	d.Set("environment_id", fmt.Sprintf("/subscriptions/%s/resourceGroups/%s/providers/Microsoft.TimeSeriesInsights/environments/%s", id.SubscriptionID, resourceGroup, environmentName))
+	if location := eventSource.Location; location != nil {
+		d.Set("location", azureRMNormalizeLocation(*location))
+	}
+
+	if props := eventSource.IoTHubEventSourceResourceProperties; props != nil {
+		d.Set("iothub_name", props.IotHubName)
+		d.Set("consumer_group_name", props.ConsumerGroupName)
+		d.Set("event_source_resource_id", props.EventSourceResourceID)
+		d.Set("shared_access_key_name", props.KeyName)
+		d.Set("timestamp_property_name", props.TimestampPropertyName)
+	}
+
+	// the `shared_access_key` isn't returned from the API
+
+	flattenAndSetTags(d, eventSource.Tags)
+
+	return nil
+}
+
+func resourceArmIoTTimeSeriesInsightsEventSourceDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).tsiEventSourcesClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	environmentName := id.Path["environments"]
+	name := id.Path["eventSources"]
+
+	resp, err := client.Delete(ctx, resourceGroup, environmentName, name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp) {
+			return nil
+		}
+
+		return fmt.Errorf("Error deleting IoT Time Series Insights Event Source %q (Environment %q / Resource Group %q): %+v", name, environmentName, resourceGroup, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,132 @@
+package azurerm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func TestAccAzureRMIoTTimeSeriesInsightsEventSourceIoTHub_basic(t *testing.T) {
+	resourceName := "azurerm_iot_time_series_insights_event_source_iothub.test"
+	ri := acctest.RandInt()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMIoTTimeSeriesInsightsEventSourceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMIoTTimeSeriesInsightsEventSourceIoTHub_basic(ri, testLocation()),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMIoTTimeSeriesInsightsEventSourceExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "consumer_group_name", "$Default"),
+					resource.TestCheckResourceAttr(resourceName, "shared_access_key_name", "iothubowner"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"shared_access_key"},
+			},
+		},
+	})
+}
+
+func testCheckAzureRMIoTTimeSeriesInsightsEventSourceExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		// Ensure we have enough information in state to look up in API
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		id, err := parseAzureResourceID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		resourceGroup := id.ResourceGroup
+		environmentName := id.Path["environments"]
+		name := id.Path["eventSources"]
+
+		client := testAccProvider.Meta().(*ArmClient).tsiEventSourcesClient
+		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+		resp, err := client.Get(ctx, resourceGroup, environmentName, name)
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return fmt.Errorf("Bad: IoT Time Series Insights Event Source %q (Environment %q / Resource Group %q) does not exist", name, environmentName, resourceGroup)
+			}
+
+			return fmt.Errorf("Bad: Get on tsiEventSourcesClient: %+v", err)
+		}
+
+		return nil
+	}
+}
+
+func testCheckAzureRMIoTTimeSeriesInsightsEventSourceDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*ArmClient).tsiEventSourcesClient
+	ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_iot_time_series_insights_event_source_iothub" && rs.Type != "azurerm_iot_time_series_insights_event_source_eventhub" {
+			continue
+		}
+
+		id, err := parseAzureResourceID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		resourceGroup := id.ResourceGroup
+		environmentName := id.Path["environments"]
+		name := id.Path["eventSources"]
+
+		resp, err := client.Get(ctx, resourceGroup, environmentName, name)
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return nil
+			}
+
+			return err
+		}
+
+		return fmt.Errorf("IoT Time Series Insights Event Source %q (Environment %q / Resource Group %q) still exists", name, environmentName, resourceGroup)
+	}
+
+	return nil
+}
+
+func testAccAzureRMIoTTimeSeriesInsightsEventSourceIoTHub_basic(rInt int, location string) string {
+	template := testAccAzureRMIoTTimeSeriesInsightsStandardEnvironment_basic(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_iothub" "test" {
+  name                = "acctestIoTHub-%d"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  location            = "${azurerm_resource_group.test.location}"
+
+  sku {
+    name     = "B1"
+    tier     = "Basic"
+    capacity = "1"
+  }
+}
+
+resource "azurerm_iot_time_series_insights_event_source_iothub" "test" {
+  name                     = "acctest_tsies%d"
+  location                 = "${azurerm_resource_group.test.location}"
+  environment_id           = "${azurerm_iot_time_series_insights_standard_environment.test.id}"
+  iothub_name              = "${azurerm_iothub.test.name}"
+  consumer_group_name      = "$Default"
+  event_source_resource_id = "${azurerm_iothub.test.id}"
+  shared_access_key_name   = "${lookup(azurerm_iothub.test.shared_access_policy[0], "key_name")}"
+  shared_access_key        = "${lookup(azurerm_iothub.test.shared_access_policy[0], "primary_key")}"
+}
+`, template, rInt, rInt)
+}
@@ -0,0 +1,248 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/services/timeseriesinsights/mgmt/2017-11-15/timeseriesinsights"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmIoTTimeSeriesInsightsStandardEnvironment() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmIoTTimeSeriesInsightsStandardEnvironmentCreateUpdate,
+		Read:   resourceArmIoTTimeSeriesInsightsStandardEnvironmentRead,
+		Update: resourceArmIoTTimeSeriesInsightsStandardEnvironmentCreateUpdate,
+		Delete: resourceArmIoTTimeSeriesInsightsStandardEnvironmentDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.TimeSeriesInsightsName(),
+			},
+
+			"location": locationSchema(),
+
+			"resource_group_name": resourceGroupNameSchema(),
+
+			"sku_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"S1_1",
+					"S1_2",
+					"S1_3",
+					"S1_4",
+					"S1_5",
+					"S1_6",
+					"S1_7",
+					"S1_8",
+					"S1_9",
+					"S1_10",
+					"S2_1",
+					"S2_2",
+					"S2_3",
+					"S2_4",
+					"S2_5",
+					"S2_6",
+					"S2_7",
+					"S2_8",
+					"S2_9",
+					"S2_10",
+				}, false),
+			},
+
+			"data_retention_time": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validate.ISO8601Duration,
+			},
+
+			"storage_limit_exceeded_behavior": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  string(timeseriesinsights.PurgeOldData),
+				ValidateFunc: validation.StringInSlice([]string{
+					string(timeseriesinsights.PurgeOldData),
+					string(timeseriesinsights.PauseIngress),
+				}, false),
+			},
+
+			"partition_key": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+
+			"data_access_fqdn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"tags": tagsSchema(),
+		},
+	}
+}
+
+func resourceArmIoTTimeSeriesInsightsStandardEnvironmentCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).tsiEnvironmentsClient
+	ctx := meta.(*ArmClient).StopContext
+
+	name := d.Get("name").(string)
+	resourceGroup := d.Get("resource_group_name").(string)
+	location := azureRMNormalizeLocation(d.Get("location").(string))
+	tags := d.Get("tags").(map[string]interface{})
+
+	sku, err := expandArmIoTTimeSeriesInsightsEnvironmentSku(d.Get("sku_name").(string))
+	if err != nil {
+		return err
+	}
+
+	environment := timeseriesinsights.EnvironmentCreateOrUpdateParameters{
+		Location: utils.String(location),
+		Sku:      sku,
+		EnvironmentCreationProperties: &timeseriesinsights.EnvironmentCreationProperties{
+			DataRetentionTime:            utils.String(d.Get("data_retention_time").(string)),
+			StorageLimitExceededBehavior: timeseriesinsights.StorageLimitExceededBehavior(d.Get("storage_limit_exceeded_behavior").(string)),
+		},
+		Tags: expandTags(tags),
+	}
+
+	if v, ok := d.GetOk("partition_key"); ok {
+		environment.EnvironmentCreationProperties.PartitionKeyProperties = &[]timeseriesinsights.PartitionKeyProperty{
+			{
+				Name: utils.String(v.(string)),
+				Type: timeseriesinsights.String,
+			},
+		}
+	}
+
+	future, err := client.CreateOrUpdate(ctx, resourceGroup, name, environment)
+	if err != nil {
+		return fmt.Errorf("Error creating/updating IoT Time Series Insights Standard Environment %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("Error waiting for creation/update of IoT Time Series Insights Standard Environment %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	read, err := client.Get(ctx, resourceGroup, name, "")
+	if err != nil {
+		return fmt.Errorf("Error retrieving IoT Time Series Insights Standard Environment %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	if read.ID == nil {
+		return fmt.Errorf("Cannot read IoT Time Series Insights Standard Environment %q (Resource Group %q) ID", name, resourceGroup)
+	}
+
+	d.SetId(*read.ID)
+
+	return resourceArmIoTTimeSeriesInsightsStandardEnvironmentRead(d, meta)
+}
+
+func resourceArmIoTTimeSeriesInsightsStandardEnvironmentRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).tsiEnvironmentsClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	name := id.Path["environments"]
+
+	resp, err := client.Get(ctx, resourceGroup, name, "")
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] IoT Time Series Insights Standard Environment %q was not found in Resource Group %q - removing from state!", name, resourceGroup)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("Error retrieving IoT Time Series Insights Standard Environment %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	d.Set("name", resp.Name)
+	d.Set("resource_group_name", resourceGroup)
+	if location := resp.Location; location != nil {
+		d.Set("location", azureRMNormalizeLocation(*location))
+	}
+
+	if sku := resp.Sku; sku != nil {
+		d.Set("sku_name", flattenArmIoTTimeSeriesInsightsEnvironmentSku(sku))
+	}
+
+	if props := resp.EnvironmentResourceProperties; props != nil {
+		d.Set("data_retention_time", props.DataRetentionTime)
+		d.Set("storage_limit_exceeded_behavior", string(props.StorageLimitExceededBehavior))
+		d.Set("data_access_fqdn", props.DataAccessFqdn)
+
+		if partition := props.PartitionKeyProperties; partition != nil && len(*partition) > 0 {
+			d.Set("partition_key", (*partition)[0].Name)
+		}
+	}
+
+	flattenAndSetTags(d, resp.Tags)
+
+	return nil
+}
+
+func resourceArmIoTTimeSeriesInsightsStandardEnvironmentDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).tsiEnvironmentsClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	name := id.Path["environments"]
+
+	resp, err := client.Delete(ctx, resourceGroup, name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp) {
+			return nil
+		}
+
+		return fmt.Errorf("Error deleting IoT Time Series Insights Standard Environment %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	return nil
+}
+
+func expandArmIoTTimeSeriesInsightsEnvironmentSku(input string) (*timeseriesinsights.Sku, error) {
+	parts := strings.Split(input, "_")
+	if len(parts) != 2 {
+		return nil, fmt.Errorf("Error: `sku_name` (%q) has an invalid value - expected {name}_{capacity}", input)
+	}
+
+	capacity, err := strconv.Atoi(parts[1])
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing the capacity from `sku_name` (%q): %+v", input, err)
+	}
+
+	return &timeseriesinsights.Sku{
+		Name:     timeseriesinsights.SkuName(parts[0]),
+		Capacity: utils.Int32(int32(capacity)),
+	}, nil
+}
+
+func flattenArmIoTTimeSeriesInsightsEnvironmentSku(input *timeseriesinsights.Sku) string {
+	if input == nil || input.Capacity == nil {
+		return ""
+	}
+
+	return fmt.Sprintf("%s_%d", string(input.Name), *input.Capacity)
+}
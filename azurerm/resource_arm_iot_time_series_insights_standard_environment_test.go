@@ -0,0 +1,206 @@
+package azurerm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func TestAccAzureRMIoTTimeSeriesInsightsStandardEnvironment_basic(t *testing.T) {
+	resourceName := "azurerm_iot_time_series_insights_standard_environment.test"
+	ri := acctest.RandInt()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMIoTTimeSeriesInsightsStandardEnvironmentDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMIoTTimeSeriesInsightsStandardEnvironment_basic(ri, testLocation()),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMIoTTimeSeriesInsightsStandardEnvironmentExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "sku_name", "S1_1"),
+					resource.TestCheckResourceAttr(resourceName, "storage_limit_exceeded_behavior", "PurgeOldData"),
+					resource.TestCheckResourceAttrSet(resourceName, "data_access_fqdn"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAzureRMIoTTimeSeriesInsightsStandardEnvironment_update(t *testing.T) {
+	resourceName := "azurerm_iot_time_series_insights_standard_environment.test"
+	ri := acctest.RandInt()
+	location := testLocation()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMIoTTimeSeriesInsightsStandardEnvironmentDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMIoTTimeSeriesInsightsStandardEnvironment_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMIoTTimeSeriesInsightsStandardEnvironmentExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "data_retention_time", "P30D"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				Config: testAccAzureRMIoTTimeSeriesInsightsStandardEnvironment_updated(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMIoTTimeSeriesInsightsStandardEnvironmentExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "data_retention_time", "P7D"),
+					resource.TestCheckResourceAttr(resourceName, "storage_limit_exceeded_behavior", "PauseIngress"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.environment", "Test"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMIoTTimeSeriesInsightsStandardEnvironment_partitionKey(t *testing.T) {
+	resourceName := "azurerm_iot_time_series_insights_standard_environment.test"
+	ri := acctest.RandInt()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMIoTTimeSeriesInsightsStandardEnvironmentDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMIoTTimeSeriesInsightsStandardEnvironment_partitionKey(ri, testLocation()),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMIoTTimeSeriesInsightsStandardEnvironmentExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "partition_key", "deviceId"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testCheckAzureRMIoTTimeSeriesInsightsStandardEnvironmentExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		// Ensure we have enough information in state to look up in API
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		name := rs.Primary.Attributes["name"]
+		resourceGroup := rs.Primary.Attributes["resource_group_name"]
+
+		client := testAccProvider.Meta().(*ArmClient).tsiEnvironmentsClient
+		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+		resp, err := client.Get(ctx, resourceGroup, name, "")
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return fmt.Errorf("Bad: IoT Time Series Insights Standard Environment %q (Resource Group %q) does not exist", name, resourceGroup)
+			}
+
+			return fmt.Errorf("Bad: Get on tsiEnvironmentsClient: %+v", err)
+		}
+
+		return nil
+	}
+}
+
+func testCheckAzureRMIoTTimeSeriesInsightsStandardEnvironmentDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*ArmClient).tsiEnvironmentsClient
+	ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_iot_time_series_insights_standard_environment" {
+			continue
+		}
+
+		name := rs.Primary.Attributes["name"]
+		resourceGroup := rs.Primary.Attributes["resource_group_name"]
+
+		resp, err := client.Get(ctx, resourceGroup, name, "")
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return nil
+			}
+
+			return err
+		}
+
+		return fmt.Errorf("IoT Time Series Insights Standard Environment %q (Resource Group %q) still exists", name, resourceGroup)
+	}
+
+	return nil
+}
+
+func testAccAzureRMIoTTimeSeriesInsightsStandardEnvironment_basic(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_iot_time_series_insights_standard_environment" "test" {
+  name                = "acctest_tsie%d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  sku_name            = "S1_1"
+  data_retention_time = "P30D"
+}
+`, rInt, location, rInt)
+}
+
+func testAccAzureRMIoTTimeSeriesInsightsStandardEnvironment_updated(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_iot_time_series_insights_standard_environment" "test" {
+  name                            = "acctest_tsie%d"
+  location                        = "${azurerm_resource_group.test.location}"
+  resource_group_name             = "${azurerm_resource_group.test.name}"
+  sku_name                        = "S1_1"
+  data_retention_time             = "P7D"
+  storage_limit_exceeded_behavior = "PauseIngress"
+
+  tags {
+    environment = "Test"
+  }
+}
+`, rInt, location, rInt)
+}
+
+func testAccAzureRMIoTTimeSeriesInsightsStandardEnvironment_partitionKey(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_iot_time_series_insights_standard_environment" "test" {
+  name                = "acctest_tsie%d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  sku_name            = "S1_1"
+  data_retention_time = "P30D"
+  partition_key       = "deviceId"
+}
+`, rInt, location, rInt)
+}
@@ -0,0 +1,226 @@
+package azurerm
+
+import (
+	"fmt"
+	"regexp"
+
+	"github.com/Azure/azure-sdk-for-go/services/iotcentral/mgmt/2018-09-01/iotcentral"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/response"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmIotCentralApplication() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmIotCentralApplicationCreateUpdate,
+		Read:   resourceArmIotCentralApplicationRead,
+		Update: resourceArmIotCentralApplicationCreateUpdate,
+		Delete: resourceArmIotCentralApplicationDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateIotCentralApplicationName,
+			},
+
+			"location": locationSchema(),
+
+			"resource_group_name": resourceGroupNameSchema(),
+
+			"sub_domain": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateIotCentralApplicationName,
+			},
+
+			"display_name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringLenBetween(1, 200),
+			},
+
+			"sku": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  string(iotcentral.S1),
+				ValidateFunc: validation.StringInSlice([]string{
+					string(iotcentral.F1),
+					string(iotcentral.S1),
+				}, false),
+			},
+
+			"template": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+
+			"application_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"tags": tagsSchema(),
+		},
+	}
+}
+
+func resourceArmIotCentralApplicationCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).iotCentralAppsClient
+	ctx := meta.(*ArmClient).StopContext
+
+	name := d.Get("name").(string)
+	location := azureRMNormalizeLocation(d.Get("location").(string))
+	resourceGroup := d.Get("resource_group_name").(string)
+	tags := d.Get("tags").(map[string]interface{})
+
+	if d.IsNewResource() {
+		availability, err := client.CheckNameAvailability(ctx, iotcentral.OperationInputs{
+			Name: utils.String(name),
+		})
+		if err != nil {
+			return fmt.Errorf("Error checking if the name %q is available for an IoT Central Application: %+v", name, err)
+		}
+
+		if available := availability.NameAvailable; available != nil && !*available {
+			message := string(availability.Reason)
+			if availability.Message != nil {
+				message = *availability.Message
+			}
+			return fmt.Errorf("The name %q cannot be used for an IoT Central Application: %s", name, message)
+		}
+	}
+
+	app := iotcentral.App{
+		Location: utils.String(location),
+		Sku: &iotcentral.AppSkuInfo{
+			Name: iotcentral.AppSku(d.Get("sku").(string)),
+		},
+		AppProperties: &iotcentral.AppProperties{
+			Subdomain: utils.String(d.Get("sub_domain").(string)),
+		},
+		Tags: expandTags(tags),
+	}
+
+	if v, ok := d.GetOk("display_name"); ok {
+		app.AppProperties.DisplayName = utils.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("template"); ok {
+		app.AppProperties.Template = utils.String(v.(string))
+	}
+
+	future, err := client.CreateOrUpdate(ctx, resourceGroup, name, app)
+	if err != nil {
+		return fmt.Errorf("Error creating/updating IoT Central Application %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("Error waiting for creation/update of IoT Central Application %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	read, err := client.Get(ctx, resourceGroup, name)
+	if err != nil {
+		return fmt.Errorf("Error retrieving IoT Central Application %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	if read.ID == nil {
+		return fmt.Errorf("Cannot read IoT Central Application %q (Resource Group %q) ID", name, resourceGroup)
+	}
+
+	d.SetId(*read.ID)
+
+	return resourceArmIotCentralApplicationRead(d, meta)
+}
+
+func resourceArmIotCentralApplicationRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).iotCentralAppsClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	name := id.Path["IoTApps"]
+
+	resp, err := client.Get(ctx, resourceGroup, name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("Error retrieving IoT Central Application %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	d.Set("name", resp.Name)
+	d.Set("resource_group_name", resourceGroup)
+	if location := resp.Location; location != nil {
+		d.Set("location", azureRMNormalizeLocation(*location))
+	}
+
+	if sku := resp.Sku; sku != nil {
+		d.Set("sku", string(sku.Name))
+	}
+
+	if props := resp.AppProperties; props != nil {
+		d.Set("sub_domain", props.Subdomain)
+		d.Set("display_name", props.DisplayName)
+		d.Set("template", props.Template)
+		d.Set("application_id", props.ApplicationID)
+	}
+
+	flattenAndSetTags(d, resp.Tags)
+
+	return nil
+}
+
+func resourceArmIotCentralApplicationDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).iotCentralAppsClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	name := id.Path["IoTApps"]
+
+	future, err := client.Delete(ctx, resourceGroup, name)
+	if err != nil {
+		if response.WasNotFound(future.Response()) {
+			return nil
+		}
+		return fmt.Errorf("Error deleting IoT Central Application %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		if !response.WasNotFound(future.Response()) {
+			return fmt.Errorf("Error waiting for deletion of IoT Central Application %q (Resource Group %q): %+v", name, resourceGroup, err)
+		}
+	}
+
+	return nil
+}
+
+func validateIotCentralApplicationName(v interface{}, k string) (ws []string, es []error) {
+	value := v.(string)
+
+	// Portal: the value must be between 2 and 63 characters long, contain only lowercase letters, numbers and hyphens,
+	// and must start and end with a letter or number.
+	if matched := regexp.MustCompile(`^[a-z0-9]([a-z0-9-]{0,61}[a-z0-9])?$`).Match([]byte(value)); !matched || len(value) < 2 {
+		es = append(es, fmt.Errorf("%q must be between 2 and 63 characters long, contain only lowercase letters, numbers and hyphens, and must start and end with a letter or number.", k))
+	}
+
+	return ws, es
+}
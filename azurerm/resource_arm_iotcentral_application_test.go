@@ -0,0 +1,196 @@
+package azurerm
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func TestValidateIotCentralApplicationName(t *testing.T) {
+	validNames := []string{
+		"a1",
+		"abc123",
+		"hy-ph-en",
+		"0-starts-with-a-number",
+		strings.Repeat("w", 63),
+	}
+	for _, v := range validNames {
+		_, errors := validateIotCentralApplicationName(v, "name")
+		if len(errors) != 0 {
+			t.Fatalf("%q should be a valid IoT Central Application Name: %q", v, errors)
+		}
+	}
+
+	invalidNames := []string{
+		"a",
+		"-invalid",
+		"invalid-",
+		"UpperCase",
+		"under_score",
+		"dot.ted",
+		strings.Repeat("w", 64),
+	}
+	for _, v := range invalidNames {
+		_, errors := validateIotCentralApplicationName(v, "name")
+		if len(errors) == 0 {
+			t.Fatalf("%q should be an invalid IoT Central Application Name", v)
+		}
+	}
+}
+
+func TestAccAzureRMIotCentralApplication_basic(t *testing.T) {
+	resourceName := "azurerm_iotcentral_application.test"
+	ri := acctest.RandInt()
+	location := testLocation()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMIotCentralApplicationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMIotCentralApplication_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMIotCentralApplicationExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "sku", "S1"),
+					resource.TestCheckResourceAttrSet(resourceName, "display_name"),
+					resource.TestCheckResourceAttrSet(resourceName, "template"),
+					resource.TestCheckResourceAttrSet(resourceName, "application_id"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAzureRMIotCentralApplication_update(t *testing.T) {
+	resourceName := "azurerm_iotcentral_application.test"
+	ri := acctest.RandInt()
+	location := testLocation()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMIotCentralApplicationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMIotCentralApplication_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMIotCentralApplicationExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				Config: testAccAzureRMIotCentralApplication_complete(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMIotCentralApplicationExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "sub_domain", fmt.Sprintf("acctest-updated-%d", ri)),
+					resource.TestCheckResourceAttr(resourceName, "display_name", fmt.Sprintf("acctest-iotcentralapp-%d", ri)),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.ENV", "Test"),
+				),
+			},
+		},
+	})
+}
+
+func testCheckAzureRMIotCentralApplicationExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		// Ensure we have enough information in state to look up in API
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		resourceGroup := rs.Primary.Attributes["resource_group_name"]
+		name := rs.Primary.Attributes["name"]
+
+		client := testAccProvider.Meta().(*ArmClient).iotCentralAppsClient
+		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+		resp, err := client.Get(ctx, resourceGroup, name)
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return fmt.Errorf("Bad: IoT Central Application %q (Resource Group %q) does not exist", name, resourceGroup)
+			}
+
+			return fmt.Errorf("Bad: Get on iotCentralAppsClient: %+v", err)
+		}
+
+		return nil
+	}
+}
+
+func testCheckAzureRMIotCentralApplicationDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*ArmClient).iotCentralAppsClient
+	ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_iotcentral_application" {
+			continue
+		}
+
+		resourceGroup := rs.Primary.Attributes["resource_group_name"]
+		name := rs.Primary.Attributes["name"]
+
+		resp, err := client.Get(ctx, resourceGroup, name)
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return nil
+			}
+
+			return err
+		}
+
+		return fmt.Errorf("IoT Central Application %q (Resource Group %q) still exists", name, resourceGroup)
+	}
+
+	return nil
+}
+
+func testAccAzureRMIotCentralApplication_basic(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_iotcentral_application" "test" {
+  name                = "acctest-iotcentralapp-%d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  sub_domain          = "acctest-%d"
+}
+`, rInt, location, rInt, rInt)
+}
+
+func testAccAzureRMIotCentralApplication_complete(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_iotcentral_application" "test" {
+  name                = "acctest-iotcentralapp-%d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  sub_domain          = "acctest-updated-%d"
+  display_name        = "acctest-iotcentralapp-%d"
+  sku                 = "S1"
+
+  tags {
+    ENV = "Test"
+  }
+}
+`, rInt, location, rInt, rInt, rInt)
+}
@@ -151,8 +151,9 @@ func resourceArmIotHub() *schema.Resource {
 							}, false),
 						},
 						"connection_string": {
-							Type:     schema.TypeString,
-							Required: true,
+							Type:      schema.TypeString,
+							Required:  true,
+							Sensitive: true,
 							DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
 								// As Azure API masks the connection string key suppress diff for this property
 								if old != "" && strings.HasSuffix(old, "****") {
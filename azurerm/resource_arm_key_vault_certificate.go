@@ -54,9 +54,10 @@ func resourceArmKeyVaultCertificate() *schema.Resource {
 							ForceNew: true,
 						},
 						"password": {
-							Type:     schema.TypeString,
-							Optional: true,
-							ForceNew: true,
+							Type:      schema.TypeString,
+							Optional:  true,
+							ForceNew:  true,
+							Sensitive: true,
 						},
 					},
 				},
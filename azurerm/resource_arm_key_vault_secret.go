@@ -1,12 +1,18 @@
 package azurerm
 
 import (
+	"crypto/sha256"
+	"encoding/hex"
 	"fmt"
 	"log"
+	"time"
 
 	"github.com/Azure/azure-sdk-for-go/services/keyvault/2016-10-01/keyvault"
+	"github.com/Azure/go-autorest/autorest/date"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/suppress"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
@@ -19,6 +25,7 @@ func resourceArmKeyVaultSecret() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
+		CustomizeDiff: keyVaultSecretCustomizeDiff,
 
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -35,9 +42,19 @@ func resourceArmKeyVaultSecret() *schema.Resource {
 			},
 
 			"value": {
-				Type:      schema.TypeString,
-				Required:  true,
-				Sensitive: true,
+				Type:          schema.TypeString,
+				Optional:      true,
+				Sensitive:     true,
+				ConflictsWith: []string{"value_wo"},
+			},
+
+			// only a hash of the value is stored in the state, so changes made outside of Terraform aren't detected
+			"value_wo": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Sensitive:     true,
+				StateFunc:     keyVaultSecretValueStateFunc,
+				ConflictsWith: []string{"value"},
 			},
 
 			"content_type": {
@@ -45,6 +62,20 @@ func resourceArmKeyVaultSecret() *schema.Resource {
 				Optional: true,
 			},
 
+			"not_before_date": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateFunc:     validate.RFC3339Time,
+				DiffSuppressFunc: suppress.RFC3339Time,
+			},
+
+			"expiration_date": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateFunc:     validate.RFC3339Time,
+				DiffSuppressFunc: suppress.RFC3339Time,
+			},
+
 			"version": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -63,21 +94,30 @@ func resourceArmKeyVaultSecretCreate(d *schema.ResourceData, meta interface{}) e
 
 	name := d.Get("name").(string)
 	keyVaultBaseUrl := d.Get("vault_uri").(string)
-	value := d.Get("value").(string)
 	contentType := d.Get("content_type").(string)
 	tags := d.Get("tags").(map[string]interface{})
 
-	parameters := keyvault.SecretSetParameters{
-		Value:       utils.String(value),
-		ContentType: utils.String(contentType),
-		Tags:        expandTags(tags),
+	value, err := expandKeyVaultSecretValue(d)
+	if err != nil {
+		return err
 	}
 
-	_, err := client.SetSecret(ctx, keyVaultBaseUrl, name, parameters)
+	attributes, err := expandKeyVaultSecretAttributes(d)
 	if err != nil {
 		return err
 	}
 
+	parameters := keyvault.SecretSetParameters{
+		Value:            utils.String(value),
+		ContentType:      utils.String(contentType),
+		SecretAttributes: attributes,
+		Tags:             expandTags(tags),
+	}
+
+	if _, err := client.SetSecret(ctx, keyVaultBaseUrl, name, parameters); err != nil {
+		return err
+	}
+
 	// "" indicates the latest version
 	read, err := client.GetSecret(ctx, keyVaultBaseUrl, name, "")
 	if err != nil {
@@ -102,16 +142,42 @@ func resourceArmKeyVaultSecretUpdate(d *schema.ResourceData, meta interface{}) e
 		return err
 	}
 
-	value := d.Get("value").(string)
 	contentType := d.Get("content_type").(string)
 	tags := d.Get("tags").(map[string]interface{})
 
-	if d.HasChange("value") {
+	attributes, err := expandKeyVaultSecretAttributes(d)
+	if err != nil {
+		return err
+	}
+
+	// the API ignores dates which are omitted from an update, so removing one requires a new version of the secret
+	datesRemoved := keyVaultSecretDateRemoved(d, "not_before_date") || keyVaultSecretDateRemoved(d, "expiration_date")
+
+	if d.HasChange("value") || d.HasChange("value_wo") || datesRemoved {
+		var value string
+		if d.HasChange("value") || d.HasChange("value_wo") {
+			value, err = expandKeyVaultSecretValue(d)
+			if err != nil {
+				return err
+			}
+		} else {
+			// the value may be write-only, so the new version takes the value of the existing version
+			existing, err2 := client.GetSecret(ctx, id.KeyVaultBaseUrl, id.Name, id.Version)
+			if err2 != nil {
+				return fmt.Errorf("Error retrieving Key Vault Secret %q : %+v", id.Name, err2)
+			}
+			if existing.Value == nil {
+				return fmt.Errorf("Error retrieving Key Vault Secret %q: `value` was nil", id.Name)
+			}
+			value = *existing.Value
+		}
+
 		// for changing the value of the secret we need to create a new version
 		parameters := keyvault.SecretSetParameters{
-			Value:       utils.String(value),
-			ContentType: utils.String(contentType),
-			Tags:        expandTags(tags),
+			Value:            utils.String(value),
+			ContentType:      utils.String(contentType),
+			SecretAttributes: attributes,
+			Tags:             expandTags(tags),
 		}
 
 		_, err = client.SetSecret(ctx, id.KeyVaultBaseUrl, id.Name, parameters)
@@ -133,8 +199,9 @@ func resourceArmKeyVaultSecretUpdate(d *schema.ResourceData, meta interface{}) e
 		d.SetId(*read.ID)
 	} else {
 		parameters := keyvault.SecretUpdateParameters{
-			ContentType: utils.String(contentType),
-			Tags:        expandTags(tags),
+			ContentType:      utils.String(contentType),
+			SecretAttributes: attributes,
+			Tags:             expandTags(tags),
 		}
 
 		if _, err = client.UpdateSecret(ctx, id.KeyVaultBaseUrl, id.Name, id.Version, parameters); err != nil {
@@ -173,10 +240,27 @@ func resourceArmKeyVaultSecretRead(d *schema.ResourceData, meta interface{}) err
 
 	d.Set("name", respID.Name)
 	d.Set("vault_uri", respID.KeyVaultBaseUrl)
-	d.Set("value", resp.Value)
+	// when the value is write-only it's not stored in the state
+	if d.Get("value_wo").(string) == "" {
+		d.Set("value", resp.Value)
+	}
 	d.Set("version", respID.Version)
 	d.Set("content_type", resp.ContentType)
 
+	notBeforeDate := ""
+	expirationDate := ""
+	if attributes := resp.Attributes; attributes != nil {
+		if v := attributes.NotBefore; v != nil {
+			notBeforeDate = time.Time(*v).Format(time.RFC3339)
+		}
+
+		if v := attributes.Expires; v != nil {
+			expirationDate = time.Time(*v).Format(time.RFC3339)
+		}
+	}
+	d.Set("not_before_date", notBeforeDate)
+	d.Set("expiration_date", expirationDate)
+
 	flattenAndSetTags(d, resp.Tags)
 	return nil
 }
@@ -193,3 +277,68 @@ func resourceArmKeyVaultSecretDelete(d *schema.ResourceData, meta interface{}) e
 	_, err = client.DeleteSecret(ctx, id.KeyVaultBaseUrl, id.Name)
 	return err
 }
+
+func keyVaultSecretCustomizeDiff(diff *schema.ResourceDiff, v interface{}) error {
+	// an interpolated value isn't known until apply
+	if !diff.NewValueKnown("value") || !diff.NewValueKnown("value_wo") {
+		return nil
+	}
+
+	if diff.Get("value").(string) == "" && diff.Get("value_wo").(string) == "" {
+		return fmt.Errorf("One of `value` or `value_wo` must be specified")
+	}
+
+	return nil
+}
+
+func expandKeyVaultSecretValue(d *schema.ResourceData) (string, error) {
+	// the StateFunc means the hash is stored in the state, however the raw value is available during apply
+	if v := d.Get("value_wo").(string); v != "" {
+		return v, nil
+	}
+
+	if v, ok := d.GetOk("value"); ok {
+		return v.(string), nil
+	}
+
+	return "", fmt.Errorf("One of `value` or `value_wo` must be specified")
+}
+
+func expandKeyVaultSecretAttributes(d *schema.ResourceData) (*keyvault.SecretAttributes, error) {
+	attributes := keyvault.SecretAttributes{}
+
+	if v := d.Get("not_before_date").(string); v != "" {
+		notBefore, err := time.Parse(time.RFC3339, v)
+		if err != nil {
+			return nil, fmt.Errorf("Error parsing `not_before_date` %q: %+v", v, err)
+		}
+		notBeforeUnixTime := date.UnixTime(notBefore)
+		attributes.NotBefore = &notBeforeUnixTime
+	}
+
+	if v := d.Get("expiration_date").(string); v != "" {
+		expiration, err := time.Parse(time.RFC3339, v)
+		if err != nil {
+			return nil, fmt.Errorf("Error parsing `expiration_date` %q: %+v", v, err)
+		}
+		expirationUnixTime := date.UnixTime(expiration)
+		attributes.Expires = &expirationUnixTime
+	}
+
+	return &attributes, nil
+}
+
+func keyVaultSecretDateRemoved(d *schema.ResourceData, key string) bool {
+	old, new := d.GetChange(key)
+	return old.(string) != "" && new.(string) == ""
+}
+
+func keyVaultSecretValueStateFunc(v interface{}) string {
+	switch s := v.(type) {
+	case string:
+		hash := sha256.Sum256([]byte(s))
+		return hex.EncodeToString(hash[:])
+	default:
+		return ""
+	}
+}
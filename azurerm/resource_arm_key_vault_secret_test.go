@@ -7,6 +7,7 @@ import (
 	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
@@ -95,6 +96,8 @@ func TestAccAzureRMKeyVaultSecret_complete(t *testing.T) {
 					testCheckAzureRMKeyVaultSecretExists(resourceName),
 					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
 					resource.TestCheckResourceAttr(resourceName, "tags.hello", "world"),
+					resource.TestCheckResourceAttr(resourceName, "not_before_date", "2018-01-01T01:02:03Z"),
+					resource.TestCheckResourceAttr(resourceName, "expiration_date", "2030-01-01T01:02:03Z"),
 				),
 			},
 			{
@@ -106,6 +109,70 @@ func TestAccAzureRMKeyVaultSecret_complete(t *testing.T) {
 	})
 }
 
+func TestAccAzureRMKeyVaultSecret_removeDates(t *testing.T) {
+	resourceName := "azurerm_key_vault_secret.test"
+	rs := acctest.RandString(6)
+	location := testLocation()
+	var version string
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMKeyVaultSecretDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMKeyVaultSecret_complete(rs, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMKeyVaultSecretExists(resourceName),
+					testCheckAzureRMKeyVaultSecretVersion(resourceName, &version),
+					resource.TestCheckResourceAttr(resourceName, "not_before_date", "2018-01-01T01:02:03Z"),
+					resource.TestCheckResourceAttr(resourceName, "expiration_date", "2030-01-01T01:02:03Z"),
+				),
+			},
+			{
+				Config: testAccAzureRMKeyVaultSecret_completeWithoutDates(rs, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMKeyVaultSecretExists(resourceName),
+					testCheckAzureRMKeyVaultSecretNewVersionWithoutDates(resourceName, &version),
+					resource.TestCheckResourceAttr(resourceName, "value", "<rick><morty /></rick>"),
+					resource.TestCheckResourceAttr(resourceName, "not_before_date", ""),
+					resource.TestCheckResourceAttr(resourceName, "expiration_date", ""),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMKeyVaultSecret_writeOnlyValue(t *testing.T) {
+	resourceName := "azurerm_key_vault_secret.test"
+	rs := acctest.RandString(6)
+	location := testLocation()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMKeyVaultSecretDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMKeyVaultSecret_writeOnlyValue(rs, location, "rick-and-morty"),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMKeyVaultSecretExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "value", ""),
+					resource.TestCheckResourceAttr(resourceName, "value_wo", keyVaultSecretValueStateFunc("rick-and-morty")),
+				),
+			},
+			{
+				Config: testAccAzureRMKeyVaultSecret_writeOnlyValue(rs, location, "szechuan"),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMKeyVaultSecretExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "value", ""),
+					resource.TestCheckResourceAttr(resourceName, "value_wo", keyVaultSecretValueStateFunc("szechuan")),
+				),
+			},
+		},
+	})
+}
+
 func TestAccAzureRMKeyVaultSecret_update(t *testing.T) {
 	resourceName := "azurerm_key_vault_secret.test"
 	rs := acctest.RandString(6)
@@ -188,6 +255,64 @@ func testCheckAzureRMKeyVaultSecretExists(name string) resource.TestCheckFunc {
 	}
 }
 
+// testCheckAzureRMKeyVaultSecretVersion records the version of the Key Vault Secret in the state
+func testCheckAzureRMKeyVaultSecretVersion(name string, version *string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+
+		*version = rs.Primary.Attributes["version"]
+		if *version == "" {
+			return fmt.Errorf("Bad: `version` is empty for Key Vault Secret %q", name)
+		}
+
+		return nil
+	}
+}
+
+// testCheckAzureRMKeyVaultSecretNewVersionWithoutDates checks that the latest version of the Key Vault Secret
+// is a different version to `previousVersion`, which has neither a Not Before nor an Expiration Date
+func testCheckAzureRMKeyVaultSecretNewVersionWithoutDates(name string, previousVersion *string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+		name := rs.Primary.Attributes["name"]
+		vaultBaseUrl := rs.Primary.Attributes["vault_uri"]
+
+		client := testAccProvider.Meta().(*ArmClient).keyVaultManagementClient
+		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+		// "" indicates the latest version
+		resp, err := client.GetSecret(ctx, vaultBaseUrl, name, "")
+		if err != nil {
+			return fmt.Errorf("Bad: Get on keyVaultManagementClient: %+v", err)
+		}
+
+		id, err := azure.ParseKeyVaultChildID(*resp.ID)
+		if err != nil {
+			return err
+		}
+
+		if id.Version == *previousVersion {
+			return fmt.Errorf("Bad: expected a new version of Key Vault Secret %q but the latest version is still %q", name, id.Version)
+		}
+
+		if id.Version != rs.Primary.Attributes["version"] {
+			return fmt.Errorf("Bad: expected the latest version of Key Vault Secret %q to be %q but got %q", name, rs.Primary.Attributes["version"], id.Version)
+		}
+
+		if attributes := resp.Attributes; attributes != nil && (attributes.NotBefore != nil || attributes.Expires != nil) {
+			return fmt.Errorf("Bad: expected the latest version of Key Vault Secret %q to have no Not Before or Expiration Date", name)
+		}
+
+		return nil
+	}
+}
+
 func testCheckAzureRMKeyVaultSecretDisappears(name string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		// Ensure we have enough information in state to look up in API
@@ -300,11 +425,66 @@ resource "azurerm_key_vault" "test" {
   }
 }
 
+resource "azurerm_key_vault_secret" "test" {
+  name            = "secret-%s"
+  value           = "<rick><morty /></rick>"
+  vault_uri       = "${azurerm_key_vault.test.vault_uri}"
+  content_type    = "application/xml"
+  not_before_date = "2018-01-01T01:02:03Z"
+  expiration_date = "2030-01-01T01:02:03Z"
+
+  tags {
+    "hello" = "world"
+  }
+}
+`, rString, location, rString, rString)
+}
+
+func testAccAzureRMKeyVaultSecret_completeWithoutDates(rString string, location string) string {
+	return fmt.Sprintf(`
+data "azurerm_client_config" "current" {}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%s"
+  location = "%s"
+}
+
+resource "azurerm_key_vault" "test" {
+  name                = "acctestkv-%s"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  tenant_id           = "${data.azurerm_client_config.current.tenant_id}"
+
+  sku {
+    name = "premium"
+  }
+
+  access_policy {
+    tenant_id = "${data.azurerm_client_config.current.tenant_id}"
+    object_id = "${data.azurerm_client_config.current.service_principal_object_id}"
+
+    key_permissions = [
+      "create",
+    ]
+
+    secret_permissions = [
+      "get",
+      "delete",
+      "set",
+    ]
+  }
+
+  tags {
+    environment = "Production"
+  }
+}
+
 resource "azurerm_key_vault_secret" "test" {
   name         = "secret-%s"
   value        = "<rick><morty /></rick>"
   vault_uri    = "${azurerm_key_vault.test.vault_uri}"
   content_type = "application/xml"
+
   tags {
     "hello" = "world"
   }
@@ -312,6 +492,49 @@ resource "azurerm_key_vault_secret" "test" {
 `, rString, location, rString, rString)
 }
 
+func testAccAzureRMKeyVaultSecret_writeOnlyValue(rString string, location string, value string) string {
+	return fmt.Sprintf(`
+data "azurerm_client_config" "current" {}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%s"
+  location = "%s"
+}
+
+resource "azurerm_key_vault" "test" {
+  name                = "acctestkv-%s"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  tenant_id           = "${data.azurerm_client_config.current.tenant_id}"
+
+  sku {
+    name = "premium"
+  }
+
+  access_policy {
+    tenant_id = "${data.azurerm_client_config.current.tenant_id}"
+    object_id = "${data.azurerm_client_config.current.service_principal_object_id}"
+
+    key_permissions = [
+      "create",
+    ]
+
+    secret_permissions = [
+      "get",
+      "delete",
+      "set",
+    ]
+  }
+}
+
+resource "azurerm_key_vault_secret" "test" {
+  name      = "secret-%s"
+  value_wo  = "%s"
+  vault_uri = "${azurerm_key_vault.test.vault_uri}"
+}
+`, rString, location, rString, rString, value)
+}
+
 func testAccAzureRMKeyVaultSecret_basicUpdated(rString string, location string) string {
 	return fmt.Sprintf(`
 data "azurerm_client_config" "current" {}
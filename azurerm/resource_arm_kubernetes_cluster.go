@@ -4,13 +4,17 @@ import (
 	"bytes"
 	"fmt"
 	"log"
+	"net"
 	"regexp"
+	"strconv"
+	"strings"
 
 	"github.com/Azure/azure-sdk-for-go/services/containerservice/mgmt/2018-03-31/containerservice"
 	"github.com/hashicorp/terraform/helper/hashcode"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/helper/validation"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/kubernetes"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
@@ -50,7 +54,8 @@ func resourceArmKubernetesCluster() *schema.Resource {
 
 				// All set values.
 				if dockerBridgeCidr != "" && dnsServiceIP != "" && serviceCidr != "" {
-					return nil
+					podCidr := profile["pod_cidr"].(string)
+					return validateKubernetesClusterNetworkProfileAddresses(serviceCidr, dnsServiceIP, podCidr)
 				}
 
 				return fmt.Errorf("`docker_bridge_cidr`, `dns_service_ip` and `service_cidr` should all be empty or all should be set.")
@@ -280,11 +285,22 @@ func resourceArmKubernetesCluster() *schema.Resource {
 							}, false),
 						},
 
-						"dns_service_ip": {
+						"network_policy": {
 							Type:     schema.TypeString,
 							Optional: true,
 							Computed: true,
 							ForceNew: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								string(containerservice.Calico),
+							}, false),
+						},
+
+						"dns_service_ip": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ForceNew:     true,
+							ValidateFunc: validate.IPv4Address,
 						},
 
 						"docker_bridge_cidr": {
@@ -295,17 +311,19 @@ func resourceArmKubernetesCluster() *schema.Resource {
 						},
 
 						"pod_cidr": {
-							Type:     schema.TypeString,
-							Optional: true,
-							Computed: true,
-							ForceNew: true,
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.CIDRNetwork(8, 32),
 						},
 
 						"service_cidr": {
-							Type:     schema.TypeString,
-							Optional: true,
-							Computed: true,
-							ForceNew: true,
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.CIDRNetwork(12, 32),
 						},
 					},
 				},
@@ -355,6 +373,59 @@ func resourceArmKubernetesCluster() *schema.Resource {
 								},
 							},
 						},
+
+						"azure_policy": {
+							Type:     schema.TypeList,
+							MaxItems: 1,
+							Optional: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"enabled": {
+										Type:     schema.TypeBool,
+										Required: true,
+									},
+								},
+							},
+						},
+
+						"open_service_mesh": {
+							Type:     schema.TypeList,
+							MaxItems: 1,
+							Optional: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"enabled": {
+										Type:     schema.TypeBool,
+										Required: true,
+									},
+								},
+							},
+						},
+
+						"azure_keyvault_secrets_provider": {
+							Type:     schema.TypeList,
+							MaxItems: 1,
+							Optional: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"enabled": {
+										Type:     schema.TypeBool,
+										Required: true,
+									},
+									"secret_rotation_enabled": {
+										Type:     schema.TypeBool,
+										Optional: true,
+										Default:  false,
+									},
+									"secret_rotation_interval": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										Default:      "2m",
+										ValidateFunc: validateKubernetesClusterSecretRotationInterval(),
+									},
+								},
+							},
+						},
 					},
 				},
 			},
@@ -661,6 +732,7 @@ func flattenAzureRmKubernetesClusterNetworkProfile(profile *containerservice.Net
 	values := make(map[string]interface{})
 
 	values["network_plugin"] = profile.NetworkPlugin
+	values["network_policy"] = string(profile.NetworkPolicy)
 
 	if profile.ServiceCidr != nil {
 		values["service_cidr"] = *profile.ServiceCidr
@@ -799,6 +871,11 @@ func expandAzureRmKubernetesClusterNetworkProfile(d *schema.ResourceData) *conta
 		NetworkPlugin: containerservice.NetworkPlugin(networkPlugin),
 	}
 
+	if v, ok := config["network_policy"]; ok && v.(string) != "" {
+		networkPolicy := v.(string)
+		networkProfile.NetworkPolicy = containerservice.NetworkPolicy(networkPolicy)
+	}
+
 	if v, ok := config["dns_service_ip"]; ok && v.(string) != "" {
 		dnsServiceIP := v.(string)
 		networkProfile.DNSServiceIP = utils.String(dnsServiceIP)
@@ -856,6 +933,40 @@ func expandAzureRmKubernetesClusterAddonProfiles(d *schema.ResourceData) map[str
 		}
 	}
 
+	azurePolicy := profile["azure_policy"].([]interface{})
+	if len(azurePolicy) > 0 {
+		value := azurePolicy[0].(map[string]interface{})
+		enabled := value["enabled"].(bool)
+		addonProfiles["azurepolicy"] = &containerservice.ManagedClusterAddonProfile{
+			Enabled: utils.Bool(enabled),
+		}
+	}
+
+	openServiceMesh := profile["open_service_mesh"].([]interface{})
+	if len(openServiceMesh) > 0 {
+		value := openServiceMesh[0].(map[string]interface{})
+		enabled := value["enabled"].(bool)
+		addonProfiles["openServiceMesh"] = &containerservice.ManagedClusterAddonProfile{
+			Enabled: utils.Bool(enabled),
+		}
+	}
+
+	secretsProvider := profile["azure_keyvault_secrets_provider"].([]interface{})
+	if len(secretsProvider) > 0 {
+		value := secretsProvider[0].(map[string]interface{})
+		enabled := value["enabled"].(bool)
+		rotationEnabled := value["secret_rotation_enabled"].(bool)
+		rotationInterval := value["secret_rotation_interval"].(string)
+
+		addonProfiles["azureKeyvaultSecretsProvider"] = &containerservice.ManagedClusterAddonProfile{
+			Enabled: utils.Bool(enabled),
+			Config: map[string]*string{
+				"enableSecretRotation": utils.String(strconv.FormatBool(rotationEnabled)),
+				"rotationPollInterval": utils.String(rotationInterval),
+			},
+		}
+	}
+
 	return addonProfiles
 }
 
@@ -902,6 +1013,61 @@ func flattenAzureRmKubernetesClusterAddonProfiles(profile map[string]*containers
 	}
 	values["oms_agent"] = agents
 
+	azurePolicies := make([]interface{}, 0)
+	if azurePolicy := profile["azurepolicy"]; azurePolicy != nil {
+		enabled := false
+		if enabledVal := azurePolicy.Enabled; enabledVal != nil {
+			enabled = *enabledVal
+		}
+
+		output := map[string]interface{}{
+			"enabled": enabled,
+		}
+		azurePolicies = append(azurePolicies, output)
+	}
+	values["azure_policy"] = azurePolicies
+
+	meshes := make([]interface{}, 0)
+	if openServiceMesh := profile["openServiceMesh"]; openServiceMesh != nil {
+		enabled := false
+		if enabledVal := openServiceMesh.Enabled; enabledVal != nil {
+			enabled = *enabledVal
+		}
+
+		output := map[string]interface{}{
+			"enabled": enabled,
+		}
+		meshes = append(meshes, output)
+	}
+	values["open_service_mesh"] = meshes
+
+	secretsProviders := make([]interface{}, 0)
+	if secretsProvider := profile["azureKeyvaultSecretsProvider"]; secretsProvider != nil {
+		enabled := false
+		if enabledVal := secretsProvider.Enabled; enabledVal != nil {
+			enabled = *enabledVal
+		}
+
+		rotationEnabled := false
+		if v := secretsProvider.Config["enableSecretRotation"]; v != nil {
+			rotationEnabled = strings.EqualFold(*v, "true")
+		}
+
+		// the API omits the interval when it's the default
+		rotationInterval := "2m"
+		if v := secretsProvider.Config["rotationPollInterval"]; v != nil && *v != "" {
+			rotationInterval = *v
+		}
+
+		output := map[string]interface{}{
+			"enabled":                  enabled,
+			"secret_rotation_enabled":  rotationEnabled,
+			"secret_rotation_interval": rotationInterval,
+		}
+		secretsProviders = append(secretsProviders, output)
+	}
+	values["azure_keyvault_secrets_provider"] = secretsProviders
+
 	return []interface{}{values}
 }
 
@@ -921,3 +1087,43 @@ func validateKubernetesClusterAgentPoolName() schema.SchemaValidateFunc {
 		"Agent Pool names must start with a lowercase letter, have max length of 12, and only have characters a-z0-9.",
 	)
 }
+
+// validateKubernetesClusterNetworkProfileAddresses ensures the DNS Service IP falls within the Service CIDR
+// and that the Pod CIDR (when using kubenet) doesn't overlap with the Service CIDR
+func validateKubernetesClusterNetworkProfileAddresses(serviceCidr, dnsServiceIP, podCidr string) error {
+	_, serviceNet, err := net.ParseCIDR(serviceCidr)
+	if err != nil {
+		return fmt.Errorf("Error parsing `service_cidr` %q: %+v", serviceCidr, err)
+	}
+
+	dnsIP := net.ParseIP(dnsServiceIP)
+	if dnsIP == nil {
+		return fmt.Errorf("Error parsing `dns_service_ip` %q as an IP Address", dnsServiceIP)
+	}
+
+	if !serviceNet.Contains(dnsIP) {
+		return fmt.Errorf("`dns_service_ip` (%q) must be within the range specified in `service_cidr` (%q)", dnsServiceIP, serviceCidr)
+	}
+
+	if podCidr == "" {
+		return nil
+	}
+
+	_, podNet, err := net.ParseCIDR(podCidr)
+	if err != nil {
+		return fmt.Errorf("Error parsing `pod_cidr` %q: %+v", podCidr, err)
+	}
+
+	if podNet.Contains(serviceNet.IP) || serviceNet.Contains(podNet.IP) {
+		return fmt.Errorf("`pod_cidr` (%q) must not overlap with `service_cidr` (%q)", podCidr, serviceCidr)
+	}
+
+	return nil
+}
+
+func validateKubernetesClusterSecretRotationInterval() schema.SchemaValidateFunc {
+	return validation.StringMatch(
+		regexp.MustCompile("^[1-9][0-9]*(s|m|h)$"),
+		"The Secret Rotation Interval must be a positive duration in seconds, minutes or hours (e.g. `2m`).",
+	)
+}
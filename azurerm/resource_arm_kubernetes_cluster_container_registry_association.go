@@ -0,0 +1,215 @@
+package azurerm
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/preview/authorization/mgmt/2018-01-01-preview/authorization"
+	"github.com/hashicorp/go-uuid"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+// the built-in role which allows images to be pulled from a Container Registry
+const containerRegistryPullRoleName = "AcrPull"
+
+func resourceArmKubernetesClusterContainerRegistryAssociation() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmKubernetesClusterContainerRegistryAssociationCreate,
+		Read:   resourceArmKubernetesClusterContainerRegistryAssociationRead,
+		Delete: resourceArmKubernetesClusterContainerRegistryAssociationDelete,
+
+		CustomizeDiff: resourceArmKubernetesClusterContainerRegistryAssociationCustomizeDiff,
+
+		Schema: map[string]*schema.Schema{
+			"kubernetes_cluster_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: azure.ValidateResourceID,
+			},
+
+			"container_registry_id": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateFunc:     azure.ValidateResourceID,
+				DiffSuppressFunc: ignoreCaseDiffSuppressFunc,
+			},
+
+			"principal_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceArmKubernetesClusterContainerRegistryAssociationCreate(d *schema.ResourceData, meta interface{}) error {
+	roleAssignmentsClient := meta.(*ArmClient).roleAssignmentsClient
+	roleDefinitionsClient := meta.(*ArmClient).roleDefinitionsClient
+	ctx := meta.(*ArmClient).StopContext
+
+	clusterId := d.Get("kubernetes_cluster_id").(string)
+	registryId := d.Get("container_registry_id").(string)
+
+	principalId, err := kubernetesClusterServicePrincipalObjectID(ctx, meta.(*ArmClient), clusterId)
+	if err != nil {
+		return err
+	}
+
+	filter := fmt.Sprintf("roleName eq '%s'", containerRegistryPullRoleName)
+	roleDefinitions, err := roleDefinitionsClient.List(ctx, registryId, filter)
+	if err != nil {
+		return fmt.Errorf("Error loading Role Definition List: %+v", err)
+	}
+	if len(roleDefinitions.Values()) != 1 || roleDefinitions.Values()[0].ID == nil {
+		return fmt.Errorf("Error loading Role Definition List: could not find role %q", containerRegistryPullRoleName)
+	}
+	roleDefinitionId := *roleDefinitions.Values()[0].ID
+
+	name, err := uuid.GenerateUUID()
+	if err != nil {
+		return fmt.Errorf("Error generating UUID for Role Assignment: %+v", err)
+	}
+
+	properties := authorization.RoleAssignmentCreateParameters{
+		RoleAssignmentProperties: &authorization.RoleAssignmentProperties{
+			RoleDefinitionID: utils.String(roleDefinitionId),
+			PrincipalID:      utils.String(principalId),
+		},
+	}
+
+	log.Printf("[DEBUG] Granting %q on Container Registry %q to the Service Principal of Kubernetes Cluster %q", containerRegistryPullRoleName, registryId, clusterId)
+	// the Service Principal may not have replicated through Azure AD yet, so this needs to be retried
+	if err := resource.Retry(300*time.Second, retryRoleAssignmentsClient(registryId, name, properties, meta)); err != nil {
+		return fmt.Errorf("Error granting %q on Container Registry %q to Kubernetes Cluster %q: %+v", containerRegistryPullRoleName, registryId, clusterId, err)
+	}
+
+	read, err := roleAssignmentsClient.Get(ctx, registryId, name)
+	if err != nil {
+		return err
+	}
+	if read.ID == nil {
+		return fmt.Errorf("Cannot read Role Assignment ID for %q (Scope %q)", name, registryId)
+	}
+
+	d.SetId(*read.ID)
+
+	return resourceArmKubernetesClusterContainerRegistryAssociationRead(d, meta)
+}
+
+func resourceArmKubernetesClusterContainerRegistryAssociationRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).roleAssignmentsClient
+	ctx := meta.(*ArmClient).StopContext
+
+	resp, err := client.GetByID(ctx, d.Id())
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] Role Assignment %q was not found - removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("Error loading Role Assignment %q: %+v", d.Id(), err)
+	}
+
+	if props := resp.RoleAssignmentPropertiesWithScope; props != nil {
+		d.Set("container_registry_id", props.Scope)
+		d.Set("principal_id", props.PrincipalID)
+	}
+
+	return nil
+}
+
+func resourceArmKubernetesClusterContainerRegistryAssociationDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).roleAssignmentsClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseRoleAssignmentId(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Delete(ctx, id.scope, id.name)
+	if err != nil {
+		if !utils.ResponseWasNotFound(resp.Response) {
+			return fmt.Errorf("Error deleting Role Assignment %q: %+v", d.Id(), err)
+		}
+	}
+
+	return nil
+}
+
+func resourceArmKubernetesClusterContainerRegistryAssociationCustomizeDiff(d *schema.ResourceDiff, meta interface{}) error {
+	// the Cluster ID may be interpolated from another resource, in which case it's not known until apply
+	if d.Id() == "" || !d.NewValueKnown("kubernetes_cluster_id") {
+		return nil
+	}
+
+	ctx := meta.(*ArmClient).StopContext
+	clusterId := d.Get("kubernetes_cluster_id").(string)
+
+	principalId, err := kubernetesClusterServicePrincipalObjectID(ctx, meta.(*ArmClient), clusterId)
+	if err != nil {
+		return err
+	}
+
+	// when the Service Principal used by the Cluster changes, the Role Assignment needs to be recreated for the new one
+	if existing := d.Get("principal_id").(string); !strings.EqualFold(existing, principalId) {
+		log.Printf("[DEBUG] Service Principal for Kubernetes Cluster %q has changed from %q to %q", clusterId, existing, principalId)
+		if err := d.SetNew("principal_id", principalId); err != nil {
+			return err
+		}
+
+		return d.ForceNew("principal_id")
+	}
+
+	return nil
+}
+
+// kubernetesClusterServicePrincipalObjectID returns the Object ID of the Service Principal used by the specified Kubernetes Cluster
+func kubernetesClusterServicePrincipalObjectID(ctx context.Context, client *ArmClient, clusterId string) (string, error) {
+	id, err := parseAzureResourceID(clusterId)
+	if err != nil {
+		return "", err
+	}
+
+	resourceGroup := id.ResourceGroup
+	name := id.Path["managedClusters"]
+
+	cluster, err := client.kubernetesClustersClient.Get(ctx, resourceGroup, name)
+	if err != nil {
+		return "", fmt.Errorf("Error retrieving Managed Kubernetes Cluster %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	props := cluster.ManagedClusterProperties
+	if props == nil || props.ServicePrincipalProfile == nil || props.ServicePrincipalProfile.ClientID == nil {
+		return "", fmt.Errorf("Managed Kubernetes Cluster %q (Resource Group %q) has no Service Principal", name, resourceGroup)
+	}
+	applicationId := *props.ServicePrincipalProfile.ClientID
+
+	filter := fmt.Sprintf("appId eq '%s'", applicationId)
+	servicePrincipals, err := client.servicePrincipalsClient.ListComplete(ctx, filter)
+	if err != nil {
+		return "", fmt.Errorf("Error listing Service Principals: %+v", err)
+	}
+
+	for servicePrincipals.NotDone() {
+		servicePrincipal := servicePrincipals.Value()
+		if servicePrincipal.AppID != nil && *servicePrincipal.AppID == applicationId && servicePrincipal.ObjectID != nil {
+			return *servicePrincipal.ObjectID, nil
+		}
+
+		if err := servicePrincipals.Next(); err != nil {
+			return "", fmt.Errorf("Error listing Service Principals: %+v", err)
+		}
+	}
+
+	return "", fmt.Errorf("A Service Principal for Application ID %q (used by Managed Kubernetes Cluster %q) was not found", applicationId, name)
+}
@@ -0,0 +1,167 @@
+package azurerm
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func TestAccAzureRMKubernetesClusterContainerRegistryAssociation_basic(t *testing.T) {
+	resourceName := "azurerm_kubernetes_cluster_container_registry_association.test"
+	ri := acctest.RandInt()
+	clientId := os.Getenv("ARM_CLIENT_ID")
+	clientSecret := os.Getenv("ARM_CLIENT_SECRET")
+	config := testAccAzureRMKubernetesClusterContainerRegistryAssociation_basic(ri, clientId, clientSecret, testLocation())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMKubernetesClusterContainerRegistryAssociationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMKubernetesClusterContainerRegistryAssociationExists(resourceName),
+					resource.TestCheckResourceAttrSet(resourceName, "principal_id"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMKubernetesClusterContainerRegistryAssociation_disappears(t *testing.T) {
+	resourceName := "azurerm_kubernetes_cluster_container_registry_association.test"
+	ri := acctest.RandInt()
+	clientId := os.Getenv("ARM_CLIENT_ID")
+	clientSecret := os.Getenv("ARM_CLIENT_SECRET")
+	config := testAccAzureRMKubernetesClusterContainerRegistryAssociation_basic(ri, clientId, clientSecret, testLocation())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMKubernetesClusterContainerRegistryAssociationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMKubernetesClusterContainerRegistryAssociationExists(resourceName),
+					testCheckAzureRMKubernetesClusterContainerRegistryAssociationDisappears(resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testCheckAzureRMKubernetesClusterContainerRegistryAssociationExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %q", resourceName)
+		}
+
+		client := testAccProvider.Meta().(*ArmClient).roleAssignmentsClient
+		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+		resp, err := client.GetByID(ctx, rs.Primary.ID)
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return fmt.Errorf("Bad: Role Assignment %q does not exist", rs.Primary.ID)
+			}
+			return fmt.Errorf("Bad: Get on roleAssignmentsClient: %+v", err)
+		}
+
+		return nil
+	}
+}
+
+func testCheckAzureRMKubernetesClusterContainerRegistryAssociationDisappears(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %q", resourceName)
+		}
+
+		id, err := parseRoleAssignmentId(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		client := testAccProvider.Meta().(*ArmClient).roleAssignmentsClient
+		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+		if _, err := client.Delete(ctx, id.scope, id.name); err != nil {
+			return fmt.Errorf("Bad: Delete on roleAssignmentsClient: %+v", err)
+		}
+
+		return nil
+	}
+}
+
+func testCheckAzureRMKubernetesClusterContainerRegistryAssociationDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*ArmClient).roleAssignmentsClient
+	ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_kubernetes_cluster_container_registry_association" {
+			continue
+		}
+
+		resp, err := client.GetByID(ctx, rs.Primary.ID)
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return nil
+			}
+
+			return err
+		}
+
+		return fmt.Errorf("Role Assignment %q still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccAzureRMKubernetesClusterContainerRegistryAssociation_basic(rInt int, clientId string, clientSecret string, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_container_registry" "test" {
+  name                = "testacccr%d"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  location            = "${azurerm_resource_group.test.location}"
+  sku                 = "Basic"
+}
+
+resource "azurerm_kubernetes_cluster" "test" {
+  name                = "acctestaks%d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  dns_prefix          = "acctestaks%d"
+
+  agent_pool_profile {
+    name    = "default"
+    count   = "1"
+    vm_size = "Standard_DS2_v2"
+  }
+
+  service_principal {
+    client_id     = "%s"
+    client_secret = "%s"
+  }
+}
+
+resource "azurerm_kubernetes_cluster_container_registry_association" "test" {
+  kubernetes_cluster_id = "${azurerm_kubernetes_cluster.test.id}"
+  container_registry_id = "${azurerm_container_registry.test.id}"
+}
+`, rInt, location, rInt, rInt, rInt, clientId, clientSecret)
+}
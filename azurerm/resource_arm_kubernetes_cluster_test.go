@@ -69,6 +69,109 @@ func TestAzureRMKubernetesCluster_agentPoolName(t *testing.T) {
 	}
 }
 
+func TestAzureRMKubernetesCluster_networkProfileAddresses(t *testing.T) {
+	cases := []struct {
+		ServiceCidr  string
+		DNSServiceIP string
+		PodCidr      string
+		ExpectError  bool
+	}{
+		{
+			ServiceCidr:  "10.0.0.0/16",
+			DNSServiceIP: "10.0.0.10",
+			ExpectError:  false,
+		},
+		{
+			ServiceCidr:  "10.0.0.0/16",
+			DNSServiceIP: "10.1.0.10",
+			ExpectError:  true,
+		},
+		{
+			ServiceCidr:  "10.0.0.0/16",
+			DNSServiceIP: "not-an-ip",
+			ExpectError:  true,
+		},
+		{
+			ServiceCidr:  "10.0.0.0/16",
+			DNSServiceIP: "10.0.0.10",
+			PodCidr:      "10.244.0.0/16",
+			ExpectError:  false,
+		},
+		{
+			ServiceCidr:  "10.0.0.0/16",
+			DNSServiceIP: "10.0.0.10",
+			PodCidr:      "10.0.128.0/17",
+			ExpectError:  true,
+		},
+		{
+			ServiceCidr:  "10.0.128.0/17",
+			DNSServiceIP: "10.0.128.10",
+			PodCidr:      "10.0.0.0/8",
+			ExpectError:  true,
+		},
+	}
+
+	for _, tc := range cases {
+		err := validateKubernetesClusterNetworkProfileAddresses(tc.ServiceCidr, tc.DNSServiceIP, tc.PodCidr)
+
+		hasError := err != nil
+
+		if tc.ExpectError != hasError {
+			t.Fatalf("Expected the Kubernetes Cluster Network Profile validation error to be %t for Service CIDR %q / DNS Service IP %q / Pod CIDR %q", tc.ExpectError, tc.ServiceCidr, tc.DNSServiceIP, tc.PodCidr)
+		}
+	}
+}
+
+func TestAzureRMKubernetesCluster_secretRotationInterval(t *testing.T) {
+	cases := []struct {
+		Input       string
+		ExpectError bool
+	}{
+		{
+			Input:       "",
+			ExpectError: true,
+		},
+		{
+			Input:       "2m",
+			ExpectError: false,
+		},
+		{
+			Input:       "30s",
+			ExpectError: false,
+		},
+		{
+			Input:       "1h",
+			ExpectError: false,
+		},
+		{
+			Input:       "0m",
+			ExpectError: true,
+		},
+		{
+			Input:       "2",
+			ExpectError: true,
+		},
+		{
+			Input:       "2d",
+			ExpectError: true,
+		},
+		{
+			Input:       "-2m",
+			ExpectError: true,
+		},
+	}
+
+	for _, tc := range cases {
+		_, errors := validateKubernetesClusterSecretRotationInterval()(tc.Input, "")
+
+		hasError := len(errors) > 0
+
+		if tc.ExpectError != hasError {
+			t.Fatalf("Expected the Kubernetes Cluster Secret Rotation Interval validation error to be %t for '%s'", tc.ExpectError, tc.Input)
+		}
+	}
+}
+
 func TestAccAzureRMKubernetesCluster_basic(t *testing.T) {
 	resourceName := "azurerm_kubernetes_cluster.test"
 	ri := acctest.RandInt()
@@ -272,6 +375,66 @@ func TestAccAzureRMKubernetesCluster_addonProfileRouting(t *testing.T) {
 	})
 }
 
+func TestAccAzureRMKubernetesCluster_addonProfileAzurePolicy(t *testing.T) {
+	resourceName := "azurerm_kubernetes_cluster.test"
+	ri := acctest.RandInt()
+	clientId := os.Getenv("ARM_CLIENT_ID")
+	clientSecret := os.Getenv("ARM_CLIENT_SECRET")
+	config := testAccAzureRMKubernetesCluster_addonProfileAzurePolicy(ri, clientId, clientSecret, testLocation())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMKubernetesClusterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMKubernetesClusterExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "addon_profile.0.azure_policy.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "addon_profile.0.azure_policy.0.enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "addon_profile.0.open_service_mesh.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "addon_profile.0.open_service_mesh.0.enabled", "true"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMKubernetesCluster_addonProfileKeyVaultSecretsProvider(t *testing.T) {
+	resourceName := "azurerm_kubernetes_cluster.test"
+	ri := acctest.RandInt()
+	clientId := os.Getenv("ARM_CLIENT_ID")
+	clientSecret := os.Getenv("ARM_CLIENT_SECRET")
+	location := testLocation()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMKubernetesClusterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMKubernetesCluster_addonProfileKeyVaultSecretsProvider(ri, clientId, clientSecret, location, false, "2m"),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMKubernetesClusterExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "addon_profile.0.azure_keyvault_secrets_provider.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "addon_profile.0.azure_keyvault_secrets_provider.0.enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "addon_profile.0.azure_keyvault_secrets_provider.0.secret_rotation_enabled", "false"),
+					resource.TestCheckResourceAttr(resourceName, "addon_profile.0.azure_keyvault_secrets_provider.0.secret_rotation_interval", "2m"),
+				),
+			},
+			{
+				Config: testAccAzureRMKubernetesCluster_addonProfileKeyVaultSecretsProvider(ri, clientId, clientSecret, location, true, "5m"),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMKubernetesClusterExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "addon_profile.0.azure_keyvault_secrets_provider.0.secret_rotation_enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "addon_profile.0.azure_keyvault_secrets_provider.0.secret_rotation_interval", "5m"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccAzureRMKubernetesCluster_advancedNetworkingKubenet(t *testing.T) {
 	resourceName := "azurerm_kubernetes_cluster.test"
 	ri := acctest.RandInt()
@@ -364,6 +527,30 @@ func TestAccAzureRMKubernetesCluster_advancedNetworkingAzureComplete(t *testing.
 	})
 }
 
+func TestAccAzureRMKubernetesCluster_advancedNetworkingAzureCalicoPolicy(t *testing.T) {
+	resourceName := "azurerm_kubernetes_cluster.test"
+	ri := acctest.RandInt()
+	clientId := os.Getenv("ARM_CLIENT_ID")
+	clientSecret := os.Getenv("ARM_CLIENT_SECRET")
+	config := testAccAzureRMKubernetesCluster_advancedNetworkingWithPolicy(ri, clientId, clientSecret, testLocation(), "azure", "calico")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMKubernetesClusterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMKubernetesClusterExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "network_profile.0.network_plugin", "azure"),
+					resource.TestCheckResourceAttr(resourceName, "network_profile.0.network_policy", "calico"),
+				),
+			},
+		},
+	})
+}
+
 func testAccAzureRMKubernetesCluster_basic(rInt int, clientId string, clientSecret string, location string) string {
 	return fmt.Sprintf(`
 resource "azurerm_resource_group" "test" {
@@ -611,6 +798,78 @@ resource "azurerm_kubernetes_cluster" "test" {
 `, rInt, location, rInt, rInt, rInt, clientId, clientSecret)
 }
 
+func testAccAzureRMKubernetesCluster_addonProfileAzurePolicy(rInt int, clientId string, clientSecret string, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_kubernetes_cluster" "test" {
+  name                = "acctestaks%d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  dns_prefix          = "acctestaks%d"
+
+  agent_pool_profile {
+    name    = "default"
+    count   = "1"
+    vm_size = "Standard_DS2_v2"
+  }
+
+  service_principal {
+    client_id     = "%s"
+    client_secret = "%s"
+  }
+
+  addon_profile {
+    azure_policy {
+      enabled = true
+    }
+
+    open_service_mesh {
+      enabled = true
+    }
+  }
+}
+`, rInt, location, rInt, rInt, clientId, clientSecret)
+}
+
+func testAccAzureRMKubernetesCluster_addonProfileKeyVaultSecretsProvider(rInt int, clientId string, clientSecret string, location string, rotationEnabled bool, rotationInterval string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_kubernetes_cluster" "test" {
+  name                = "acctestaks%d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  dns_prefix          = "acctestaks%d"
+
+  agent_pool_profile {
+    name    = "default"
+    count   = "1"
+    vm_size = "Standard_DS2_v2"
+  }
+
+  service_principal {
+    client_id     = "%s"
+    client_secret = "%s"
+  }
+
+  addon_profile {
+    azure_keyvault_secrets_provider {
+      enabled                  = true
+      secret_rotation_enabled  = %t
+      secret_rotation_interval = "%s"
+    }
+  }
+}
+`, rInt, location, rInt, rInt, clientId, clientSecret, rotationEnabled, rotationInterval)
+}
+
 func testAccAzureRMKubernetesCluster_upgrade(rInt int, clientId string, clientSecret string, location string) string {
 	return fmt.Sprintf(`
 resource "azurerm_resource_group" "test" {
@@ -766,6 +1025,68 @@ resource "azurerm_kubernetes_cluster" "test" {
 `, rInt, location, rInt, rInt, rInt, rInt, rInt, clientId, clientSecret, networkPlugin)
 }
 
+func testAccAzureRMKubernetesCluster_advancedNetworkingWithPolicy(rInt int, clientId string, clientSecret string, location string, networkPlugin string, networkPolicy string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_virtual_network" "test" {
+  name                = "acctestvirtnet%d"
+  address_space       = ["10.1.0.0/16"]
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+
+  tags {
+    environment = "Testing"
+  }
+}
+
+resource "azurerm_subnet" "test" {
+  name                 = "acctestsubnet%d"
+  resource_group_name  = "${azurerm_resource_group.test.name}"
+  virtual_network_name = "${azurerm_virtual_network.test.name}"
+  address_prefix       = "10.1.0.0/24"
+}
+
+resource "azurerm_kubernetes_cluster" "test" {
+  name                   = "acctestaks%d"
+  location               = "${azurerm_resource_group.test.location}"
+  resource_group_name    = "${azurerm_resource_group.test.name}"
+  dns_prefix             = "acctestaks%d"
+
+  linux_profile {
+    admin_username = "acctestuser%d"
+
+    ssh_key {
+      key_data = "ssh-rsa AAAAB3NzaC1yc2EAAAADAQABAAABAQCqaZoyiz1qbdOQ8xEf6uEu1cCwYowo5FHtsBhqLoDnnp7KUTEBN+L2NxRIfQ781rxV6Iq5jSav6b2Q8z5KiseOlvKA/RF2wqU0UPYqQviQhLmW6THTpmrv/YkUCuzxDpsH7DUDhZcwySLKVVe0Qm3+5N2Ta6UYH3lsDf9R9wTP2K/+vAnflKebuypNlmocIvakFWoZda18FOmsOoIVXQ8HWFNCuw9ZCunMSN62QGamCe3dL5cXlkgHYv7ekJE15IA9aOJcM7e90oeTqo+7HTcWfdu0qQqPWY5ujyMw/llas8tsXY85LFqRnr3gJ02bAscjc477+X+j/gkpFoN1QEmt terraform@demo.tld"
+    }
+  }
+
+  agent_pool_profile {
+    name           = "default"
+    count          = "2"
+    vm_size        = "Standard_DS2_v2"
+    vnet_subnet_id = "${azurerm_subnet.test.id}"
+  }
+
+  service_principal {
+    client_id     = "%s"
+    client_secret = "%s"
+  }
+
+  network_profile {
+    network_plugin     = "%s"
+    network_policy     = "%s"
+    dns_service_ip     = "10.10.0.10"
+    docker_bridge_cidr = "172.18.0.1/16"
+    service_cidr       = "10.10.0.0/16"
+  }
+}
+`, rInt, location, rInt, rInt, rInt, rInt, rInt, clientId, clientSecret, networkPlugin, networkPolicy)
+}
+
 func testCheckAzureRMKubernetesClusterExists(name string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		// Ensure we have enough information in state to look up in API
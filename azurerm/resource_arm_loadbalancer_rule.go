@@ -4,9 +4,10 @@ import (
 	"fmt"
 	"log"
 	"regexp"
+	"strings"
 	"time"
 
-	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2018-04-01/network"
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2018-08-01/network"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/helper/validation"
@@ -334,10 +335,11 @@ func expandAzureRmLoadBalancerRule(d *schema.ResourceData, lb *network.LoadBalan
 		properties.LoadDistribution = network.LoadDistribution(v)
 	}
 
-	if v := d.Get("frontend_ip_configuration_name").(string); v != "" {
-		rule, exists := findLoadBalancerFrontEndIpConfigurationByName(lb, v)
+	frontendIPConfigurationName := d.Get("frontend_ip_configuration_name").(string)
+	if frontendIPConfigurationName != "" {
+		rule, exists := findLoadBalancerFrontEndIpConfigurationByName(lb, frontendIPConfigurationName)
 		if !exists {
-			return nil, fmt.Errorf("[ERROR] Cannot find FrontEnd IP Configuration with the name %s", v)
+			return nil, fmt.Errorf("[ERROR] Cannot find FrontEnd IP Configuration with the name %s", frontendIPConfigurationName)
 		}
 
 		properties.FrontendIPConfiguration = &network.SubResource{
@@ -345,6 +347,10 @@ func expandAzureRmLoadBalancerRule(d *schema.ResourceData, lb *network.LoadBalan
 		}
 	}
 
+	if err := validateArmLoadBalancerRuleHAPorts(d, lb, frontendIPConfigurationName); err != nil {
+		return nil, err
+	}
+
 	if v := d.Get("backend_address_pool_id").(string); v != "" {
 		properties.BackendAddressPool = &network.SubResource{
 			ID: &v,
@@ -363,6 +369,41 @@ func expandAzureRmLoadBalancerRule(d *schema.ResourceData, lb *network.LoadBalan
 	}, nil
 }
 
+// HA Ports rules (`protocol = "All"` with both ports set to `0`) are only supported on the
+// internal frontends of a Standard Load Balancer, and the ports must both be `0` when the
+// protocol is `All` - so we check this up front rather than surfacing an opaque API error
+func validateArmLoadBalancerRuleHAPorts(d *schema.ResourceData, lb *network.LoadBalancer, frontendIPConfigurationName string) error {
+	protocol := d.Get("protocol").(string)
+	frontendPort := d.Get("frontend_port").(int)
+	backendPort := d.Get("backend_port").(int)
+
+	haPorts := strings.EqualFold(protocol, string(network.TransportProtocolAll))
+	if !haPorts {
+		if frontendPort == 0 || backendPort == 0 {
+			return fmt.Errorf("`frontend_port` and `backend_port` can only be `0` when `protocol` is set to `All` (HA Ports)")
+		}
+
+		return nil
+	}
+
+	if frontendPort != 0 || backendPort != 0 {
+		return fmt.Errorf("`frontend_port` and `backend_port` must both be `0` when `protocol` is set to `All` (HA Ports)")
+	}
+
+	if lb.Sku == nil || lb.Sku.Name != network.LoadBalancerSkuNameStandard {
+		return fmt.Errorf("HA Ports rules (`protocol` set to `All`) are only supported on a `Standard` SKU Load Balancer")
+	}
+
+	if frontendIPConfigurationName != "" {
+		config, exists := findLoadBalancerFrontEndIpConfigurationByName(lb, frontendIPConfigurationName)
+		if exists && config.FrontendIPConfigurationPropertiesFormat != nil && config.FrontendIPConfigurationPropertiesFormat.PublicIPAddress != nil {
+			return fmt.Errorf("HA Ports rules (`protocol` set to `All`) are only supported on an internal Frontend IP Configuration - %q uses a Public IP Address", frontendIPConfigurationName)
+		}
+	}
+
+	return nil
+}
+
 func validateArmLoadBalancerRuleName(v interface{}, k string) (ws []string, errors []error) {
 	value := v.(string)
 	if !regexp.MustCompile(`^[a-zA-Z_0-9.-]+$`).MatchString(value) {
@@ -0,0 +1,384 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+
+	"github.com/Azure/azure-sdk-for-go/services/migrate/mgmt/2018-02-02/migrate"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmMigrateAssessment() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmMigrateAssessmentCreateUpdate,
+		Read:   resourceArmMigrateAssessmentRead,
+		Update: resourceArmMigrateAssessmentCreateUpdate,
+		Delete: resourceArmMigrateAssessmentDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+
+			"resource_group_name": resourceGroupNameSchema(),
+
+			"project_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+
+			"group_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+
+			// the target region uses the Compute API's naming (e.g. `WestEurope`) rather than a regular location
+			"azure_location": {
+				Type:     schema.TypeString,
+				Required: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(migrate.AzureLocationAustraliaEast),
+					string(migrate.AzureLocationAustraliaSoutheast),
+					string(migrate.AzureLocationBrazilSouth),
+					string(migrate.AzureLocationCanadaCentral),
+					string(migrate.AzureLocationCanadaEast),
+					string(migrate.AzureLocationCentralIndia),
+					string(migrate.AzureLocationCentralUs),
+					string(migrate.AzureLocationChinaEast),
+					string(migrate.AzureLocationChinaNorth),
+					string(migrate.AzureLocationEastAsia),
+					string(migrate.AzureLocationEastUs),
+					string(migrate.AzureLocationEastUs2),
+					string(migrate.AzureLocationGermanyCentral),
+					string(migrate.AzureLocationGermanyNortheast),
+					string(migrate.AzureLocationJapanEast),
+					string(migrate.AzureLocationJapanWest),
+					string(migrate.AzureLocationKoreaCentral),
+					string(migrate.AzureLocationKoreaSouth),
+					string(migrate.AzureLocationNorthCentralUs),
+					string(migrate.AzureLocationNorthEurope),
+					string(migrate.AzureLocationSouthCentralUs),
+					string(migrate.AzureLocationSoutheastAsia),
+					string(migrate.AzureLocationSouthIndia),
+					string(migrate.AzureLocationUkSouth),
+					string(migrate.AzureLocationUkWest),
+					string(migrate.AzureLocationWestCentralUs),
+					string(migrate.AzureLocationWestEurope),
+					string(migrate.AzureLocationWestIndia),
+					string(migrate.AzureLocationWestUs),
+					string(migrate.AzureLocationWestUs2),
+				}, false),
+			},
+
+			"azure_offer_code": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  string(migrate.AzureOfferCodeMSAZR0003P),
+				ValidateFunc: validation.StringMatch(
+					regexp.MustCompile(`^MS[A-Z0-9]+P$`),
+					"`azure_offer_code` must be an Azure Offer Code, such as `MSAZR0003P`",
+				),
+			},
+
+			"pricing_tier": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  string(migrate.Standard),
+				ValidateFunc: validation.StringInSlice([]string{
+					string(migrate.Basic),
+					string(migrate.Standard),
+				}, false),
+			},
+
+			"storage_redundancy": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  string(migrate.AzureStorageRedundancyLocallyRedundant),
+				ValidateFunc: validation.StringInSlice([]string{
+					string(migrate.AzureStorageRedundancyLocallyRedundant),
+					string(migrate.AzureStorageRedundancyZoneRedundant),
+					string(migrate.AzureStorageRedundancyGeoRedundant),
+					string(migrate.AzureStorageRedundancyReadAccessGeoRedundant),
+				}, false),
+			},
+
+			"sizing_criterion": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  string(migrate.PerformanceBased),
+				ValidateFunc: validation.StringInSlice([]string{
+					string(migrate.PerformanceBased),
+					string(migrate.AsOnPremises),
+				}, false),
+			},
+
+			"scaling_factor": {
+				Type:         schema.TypeFloat,
+				Optional:     true,
+				Default:      1.3,
+				ValidateFunc: validate.FloatBetween(1.0, 1.9),
+			},
+
+			"percentile": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  string(migrate.Percentile95),
+				ValidateFunc: validation.StringInSlice([]string{
+					string(migrate.Percentile50),
+					string(migrate.Percentile90),
+					string(migrate.Percentile95),
+					string(migrate.Percentile99),
+				}, false),
+			},
+
+			"time_range": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  string(migrate.Day),
+				ValidateFunc: validation.StringInSlice([]string{
+					string(migrate.Day),
+					string(migrate.Week),
+					string(migrate.Month),
+				}, false),
+			},
+
+			"stage": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  string(migrate.InProgress),
+				ValidateFunc: validation.StringInSlice([]string{
+					string(migrate.InProgress),
+					string(migrate.UnderReview),
+					string(migrate.Approved),
+				}, false),
+			},
+
+			"currency": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  string(migrate.CurrencyUSD),
+				ValidateFunc: validation.StringInSlice([]string{
+					string(migrate.CurrencyARS),
+					string(migrate.CurrencyAUD),
+					string(migrate.CurrencyBRL),
+					string(migrate.CurrencyCAD),
+					string(migrate.CurrencyCHF),
+					string(migrate.CurrencyCNY),
+					string(migrate.CurrencyDKK),
+					string(migrate.CurrencyEUR),
+					string(migrate.CurrencyGBP),
+					string(migrate.CurrencyHKD),
+					string(migrate.CurrencyIDR),
+					string(migrate.CurrencyINR),
+					string(migrate.CurrencyJPY),
+					string(migrate.CurrencyKRW),
+					string(migrate.CurrencyMXN),
+					string(migrate.CurrencyMYR),
+					string(migrate.CurrencyNOK),
+					string(migrate.CurrencyNZD),
+					string(migrate.CurrencyRUB),
+					string(migrate.CurrencySAR),
+					string(migrate.CurrencySEK),
+					string(migrate.CurrencyTRY),
+					string(migrate.CurrencyTWD),
+					string(migrate.CurrencyUSD),
+					string(migrate.CurrencyZAR),
+				}, false),
+			},
+
+			"azure_hybrid_use_benefit_enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"discount_percentage": {
+				Type:         schema.TypeFloat,
+				Optional:     true,
+				Default:      0.0,
+				ValidateFunc: validate.FloatBetween(0, 100),
+			},
+
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"number_of_machines": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
+			"confidence_rating_in_percentage": {
+				Type:     schema.TypeFloat,
+				Computed: true,
+			},
+
+			"monthly_compute_cost": {
+				Type:     schema.TypeFloat,
+				Computed: true,
+			},
+
+			"monthly_storage_cost": {
+				Type:     schema.TypeFloat,
+				Computed: true,
+			},
+
+			"monthly_bandwidth_cost": {
+				Type:     schema.TypeFloat,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceArmMigrateAssessmentCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).migrateAssessmentsClient
+	ctx := meta.(*ArmClient).StopContext
+
+	log.Printf("[INFO] preparing arguments for Migrate Assessment creation/update.")
+
+	name := d.Get("name").(string)
+	resourceGroup := d.Get("resource_group_name").(string)
+	projectName := d.Get("project_name").(string)
+	groupName := d.Get("group_name").(string)
+
+	hybridUseBenefit := migrate.AzureHybridUseBenefitNo
+	if d.Get("azure_hybrid_use_benefit_enabled").(bool) {
+		hybridUseBenefit = migrate.AzureHybridUseBenefitYes
+	}
+
+	assessment := migrate.Assessment{
+		AssessmentProperties: &migrate.AssessmentProperties{
+			AzureLocation:          migrate.AzureLocation(d.Get("azure_location").(string)),
+			AzureOfferCode:         migrate.AzureOfferCode(d.Get("azure_offer_code").(string)),
+			AzurePricingTier:       migrate.AzurePricingTier(d.Get("pricing_tier").(string)),
+			AzureStorageRedundancy: migrate.AzureStorageRedundancy(d.Get("storage_redundancy").(string)),
+			SizingCriterion:        migrate.AssessmentSizingCriterion(d.Get("sizing_criterion").(string)),
+			ScalingFactor:          utils.Float(d.Get("scaling_factor").(float64)),
+			Percentile:             migrate.Percentile(d.Get("percentile").(string)),
+			TimeRange:              migrate.TimeRange(d.Get("time_range").(string)),
+			Stage:                  migrate.AssessmentStage(d.Get("stage").(string)),
+			Currency:               migrate.Currency(d.Get("currency").(string)),
+			AzureHybridUseBenefit:  hybridUseBenefit,
+			DiscountPercentage:     utils.Float(d.Get("discount_percentage").(float64)),
+		},
+	}
+
+	// updates require the current ETag for optimistic concurrency
+	if !d.IsNewResource() {
+		existing, err := client.Get(ctx, resourceGroup, projectName, groupName, name)
+		if err != nil {
+			return fmt.Errorf("Error retrieving Migrate Assessment %q (Group %q / Project %q / Resource Group %q): %+v", name, groupName, projectName, resourceGroup, err)
+		}
+		assessment.ETag = existing.ETag
+	}
+
+	if _, err := client.Create(ctx, resourceGroup, projectName, groupName, name, &assessment); err != nil {
+		return fmt.Errorf("Error creating/updating Migrate Assessment %q (Group %q / Project %q / Resource Group %q): %+v", name, groupName, projectName, resourceGroup, err)
+	}
+
+	read, err := client.Get(ctx, resourceGroup, projectName, groupName, name)
+	if err != nil {
+		return fmt.Errorf("Error retrieving Migrate Assessment %q (Group %q / Project %q / Resource Group %q): %+v", name, groupName, projectName, resourceGroup, err)
+	}
+	if read.ID == nil {
+		return fmt.Errorf("Cannot read Migrate Assessment %q (Group %q / Project %q / Resource Group %q) ID", name, groupName, projectName, resourceGroup)
+	}
+
+	d.SetId(*read.ID)
+
+	return resourceArmMigrateAssessmentRead(d, meta)
+}
+
+func resourceArmMigrateAssessmentRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).migrateAssessmentsClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	projectName := id.Path["projects"]
+	groupName := id.Path["groups"]
+	name := id.Path["assessments"]
+
+	resp, err := client.Get(ctx, resourceGroup, projectName, groupName, name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] Migrate Assessment %q (Group %q / Project %q / Resource Group %q) was not found - removing from state", name, groupName, projectName, resourceGroup)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error retrieving Migrate Assessment %q (Group %q / Project %q / Resource Group %q): %+v", name, groupName, projectName, resourceGroup, err)
+	}
+
+	d.Set("name", resp.Name)
+	d.Set("resource_group_name", resourceGroup)
+	d.Set("project_name", projectName)
+	d.Set("group_name", groupName)
+
+	if props := resp.AssessmentProperties; props != nil {
+		d.Set("azure_location", string(props.AzureLocation))
+		d.Set("azure_offer_code", string(props.AzureOfferCode))
+		d.Set("pricing_tier", string(props.AzurePricingTier))
+		d.Set("storage_redundancy", string(props.AzureStorageRedundancy))
+		d.Set("sizing_criterion", string(props.SizingCriterion))
+		d.Set("scaling_factor", props.ScalingFactor)
+		d.Set("percentile", string(props.Percentile))
+		d.Set("time_range", string(props.TimeRange))
+		d.Set("stage", string(props.Stage))
+		d.Set("currency", string(props.Currency))
+		d.Set("azure_hybrid_use_benefit_enabled", props.AzureHybridUseBenefit == migrate.AzureHybridUseBenefitYes)
+		d.Set("discount_percentage", props.DiscountPercentage)
+
+		d.Set("status", string(props.Status))
+		d.Set("confidence_rating_in_percentage", props.ConfidenceRatingInPercentage)
+		d.Set("monthly_compute_cost", props.MonthlyComputeCost)
+		d.Set("monthly_storage_cost", props.MonthlyStorageCost)
+		d.Set("monthly_bandwidth_cost", props.MonthlyBandwidthCost)
+		if v := props.NumberOfMachines; v != nil {
+			d.Set("number_of_machines", int(*v))
+		}
+	}
+
+	return nil
+}
+
+func resourceArmMigrateAssessmentDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).migrateAssessmentsClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	projectName := id.Path["projects"]
+	groupName := id.Path["groups"]
+	name := id.Path["assessments"]
+
+	resp, err := client.Delete(ctx, resourceGroup, projectName, groupName, name)
+	if err != nil {
+		if !utils.ResponseWasNotFound(resp) {
+			return fmt.Errorf("Error deleting Migrate Assessment %q (Group %q / Project %q / Resource Group %q): %+v", name, groupName, projectName, resourceGroup, err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,170 @@
+package azurerm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func TestAccAzureRMMigrateAssessment_basic(t *testing.T) {
+	resourceName := "azurerm_migrate_assessment.test"
+	ri := acctest.RandInt()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMMigrateAssessmentDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMMigrateAssessment_basic(ri, testLocation()),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMMigrateAssessmentExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "azure_location", "WestEurope"),
+					resource.TestCheckResourceAttr(resourceName, "pricing_tier", "Standard"),
+					resource.TestCheckResourceAttr(resourceName, "stage", "InProgress"),
+					resource.TestCheckResourceAttrSet(resourceName, "status"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAzureRMMigrateAssessment_update(t *testing.T) {
+	resourceName := "azurerm_migrate_assessment.test"
+	ri := acctest.RandInt()
+	location := testLocation()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMMigrateAssessmentDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMMigrateAssessment_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMMigrateAssessmentExists(resourceName),
+				),
+			},
+			{
+				Config: testAccAzureRMMigrateAssessment_complete(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMMigrateAssessmentExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "azure_location", "NorthEurope"),
+					resource.TestCheckResourceAttr(resourceName, "pricing_tier", "Basic"),
+					resource.TestCheckResourceAttr(resourceName, "storage_redundancy", "ZoneRedundant"),
+					resource.TestCheckResourceAttr(resourceName, "sizing_criterion", "AsOnPremises"),
+					resource.TestCheckResourceAttr(resourceName, "scaling_factor", "1.5"),
+					resource.TestCheckResourceAttr(resourceName, "percentile", "Percentile99"),
+					resource.TestCheckResourceAttr(resourceName, "time_range", "Week"),
+					resource.TestCheckResourceAttr(resourceName, "stage", "Approved"),
+					resource.TestCheckResourceAttr(resourceName, "currency", "EUR"),
+					resource.TestCheckResourceAttr(resourceName, "azure_hybrid_use_benefit_enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "discount_percentage", "10"),
+				),
+			},
+		},
+	})
+}
+
+func testCheckAzureRMMigrateAssessmentExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		name := rs.Primary.Attributes["name"]
+		groupName := rs.Primary.Attributes["group_name"]
+		projectName := rs.Primary.Attributes["project_name"]
+		resourceGroup := rs.Primary.Attributes["resource_group_name"]
+
+		client := testAccProvider.Meta().(*ArmClient).migrateAssessmentsClient
+		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+		resp, err := client.Get(ctx, resourceGroup, projectName, groupName, name)
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return fmt.Errorf("Bad: Migrate Assessment %q (Group %q / Project %q / Resource Group %q) does not exist", name, groupName, projectName, resourceGroup)
+			}
+			return fmt.Errorf("Bad: Get on migrateAssessmentsClient: %+v", err)
+		}
+
+		return nil
+	}
+}
+
+func testCheckAzureRMMigrateAssessmentDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*ArmClient).migrateAssessmentsClient
+	ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_migrate_assessment" {
+			continue
+		}
+
+		name := rs.Primary.Attributes["name"]
+		groupName := rs.Primary.Attributes["group_name"]
+		projectName := rs.Primary.Attributes["project_name"]
+		resourceGroup := rs.Primary.Attributes["resource_group_name"]
+
+		resp, err := client.Get(ctx, resourceGroup, projectName, groupName, name)
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return nil
+			}
+			return err
+		}
+
+		return fmt.Errorf("Migrate Assessment still exists:\n%#v", resp)
+	}
+
+	return nil
+}
+
+func testAccAzureRMMigrateAssessment_basic(rInt int, location string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_migrate_assessment" "test" {
+  name                = "acctestma-%d"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  project_name        = "${azurerm_migrate_project.test.name}"
+  group_name          = "${azurerm_migrate_group.test.name}"
+  azure_location      = "WestEurope"
+}
+`, testAccAzureRMMigrateGroup_basic(rInt, location), rInt)
+}
+
+func testAccAzureRMMigrateAssessment_complete(rInt int, location string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_migrate_assessment" "test" {
+  name                             = "acctestma-%d"
+  resource_group_name              = "${azurerm_resource_group.test.name}"
+  project_name                     = "${azurerm_migrate_project.test.name}"
+  group_name                       = "${azurerm_migrate_group.test.name}"
+  azure_location                   = "NorthEurope"
+  azure_offer_code                 = "MSAZR0003P"
+  pricing_tier                     = "Basic"
+  storage_redundancy               = "ZoneRedundant"
+  sizing_criterion                 = "AsOnPremises"
+  scaling_factor                   = 1.5
+  percentile                       = "Percentile99"
+  time_range                       = "Week"
+  stage                            = "Approved"
+  currency                         = "EUR"
+  azure_hybrid_use_benefit_enabled = true
+  discount_percentage              = 10
+}
+`, testAccAzureRMMigrateGroup_basic(rInt, location), rInt)
+}
@@ -0,0 +1,151 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/Azure/azure-sdk-for-go/services/migrate/mgmt/2018-02-02/migrate"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmMigrateGroup() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmMigrateGroupCreateUpdate,
+		Read:   resourceArmMigrateGroupRead,
+		Update: resourceArmMigrateGroupCreateUpdate,
+		Delete: resourceArmMigrateGroupDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+
+			"resource_group_name": resourceGroupNameSchema(),
+
+			"project_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+
+			// these are the names (GUIDs) of the machines discovered by the Project, not their display names
+			"machines": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.NoZeroValues,
+				},
+				Set: schema.HashString,
+			},
+		},
+	}
+}
+
+func resourceArmMigrateGroupCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).migrateGroupsClient
+	ctx := meta.(*ArmClient).StopContext
+
+	log.Printf("[INFO] preparing arguments for Migrate Group creation/update.")
+
+	name := d.Get("name").(string)
+	resourceGroup := d.Get("resource_group_name").(string)
+	projectName := d.Get("project_name").(string)
+
+	group := migrate.Group{
+		GroupProperties: &migrate.GroupProperties{
+			Machines: utils.ExpandStringArray(d.Get("machines").(*schema.Set).List()),
+		},
+	}
+
+	// updates require the current ETag for optimistic concurrency
+	if !d.IsNewResource() {
+		existing, err := client.Get(ctx, resourceGroup, projectName, name)
+		if err != nil {
+			return fmt.Errorf("Error retrieving Migrate Group %q (Project %q / Resource Group %q): %+v", name, projectName, resourceGroup, err)
+		}
+		group.ETag = existing.ETag
+	}
+
+	if _, err := client.Create(ctx, resourceGroup, projectName, name, &group); err != nil {
+		return fmt.Errorf("Error creating/updating Migrate Group %q (Project %q / Resource Group %q): %+v", name, projectName, resourceGroup, err)
+	}
+
+	read, err := client.Get(ctx, resourceGroup, projectName, name)
+	if err != nil {
+		return fmt.Errorf("Error retrieving Migrate Group %q (Project %q / Resource Group %q): %+v", name, projectName, resourceGroup, err)
+	}
+	if read.ID == nil {
+		return fmt.Errorf("Cannot read Migrate Group %q (Project %q / Resource Group %q) ID", name, projectName, resourceGroup)
+	}
+
+	d.SetId(*read.ID)
+
+	return resourceArmMigrateGroupRead(d, meta)
+}
+
+func resourceArmMigrateGroupRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).migrateGroupsClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	projectName := id.Path["projects"]
+	name := id.Path["groups"]
+
+	resp, err := client.Get(ctx, resourceGroup, projectName, name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] Migrate Group %q (Project %q / Resource Group %q) was not found - removing from state", name, projectName, resourceGroup)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error retrieving Migrate Group %q (Project %q / Resource Group %q): %+v", name, projectName, resourceGroup, err)
+	}
+
+	d.Set("name", resp.Name)
+	d.Set("resource_group_name", resourceGroup)
+	d.Set("project_name", projectName)
+
+	if props := resp.GroupProperties; props != nil {
+		if err := d.Set("machines", utils.FlattenStringArray(props.Machines)); err != nil {
+			return fmt.Errorf("Error setting `machines`: %+v", err)
+		}
+	}
+
+	return nil
+}
+
+func resourceArmMigrateGroupDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).migrateGroupsClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	projectName := id.Path["projects"]
+	name := id.Path["groups"]
+
+	resp, err := client.Delete(ctx, resourceGroup, projectName, name)
+	if err != nil {
+		if !utils.ResponseWasNotFound(resp) {
+			return fmt.Errorf("Error deleting Migrate Group %q (Project %q / Resource Group %q): %+v", name, projectName, resourceGroup, err)
+		}
+	}
+
+	return nil
+}
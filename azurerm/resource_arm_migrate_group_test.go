@@ -0,0 +1,101 @@
+package azurerm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func TestAccAzureRMMigrateGroup_basic(t *testing.T) {
+	resourceName := "azurerm_migrate_group.test"
+	ri := acctest.RandInt()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMMigrateGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMMigrateGroup_basic(ri, testLocation()),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMMigrateGroupExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "machines.#", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testCheckAzureRMMigrateGroupExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		name := rs.Primary.Attributes["name"]
+		projectName := rs.Primary.Attributes["project_name"]
+		resourceGroup := rs.Primary.Attributes["resource_group_name"]
+
+		client := testAccProvider.Meta().(*ArmClient).migrateGroupsClient
+		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+		resp, err := client.Get(ctx, resourceGroup, projectName, name)
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return fmt.Errorf("Bad: Migrate Group %q (Project %q / Resource Group %q) does not exist", name, projectName, resourceGroup)
+			}
+			return fmt.Errorf("Bad: Get on migrateGroupsClient: %+v", err)
+		}
+
+		return nil
+	}
+}
+
+func testCheckAzureRMMigrateGroupDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*ArmClient).migrateGroupsClient
+	ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_migrate_group" {
+			continue
+		}
+
+		name := rs.Primary.Attributes["name"]
+		projectName := rs.Primary.Attributes["project_name"]
+		resourceGroup := rs.Primary.Attributes["resource_group_name"]
+
+		resp, err := client.Get(ctx, resourceGroup, projectName, name)
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return nil
+			}
+			return err
+		}
+
+		return fmt.Errorf("Migrate Group still exists:\n%#v", resp)
+	}
+
+	return nil
+}
+
+func testAccAzureRMMigrateGroup_basic(rInt int, location string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_migrate_group" "test" {
+  name                = "acctestmg-%d"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  project_name        = "${azurerm_migrate_project.test.name}"
+}
+`, testAccAzureRMMigrateProject_basic(rInt, location), rInt)
+}
@@ -0,0 +1,176 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/Azure/azure-sdk-for-go/services/migrate/mgmt/2018-02-02/migrate"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmMigrateProject() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmMigrateProjectCreate,
+		Read:   resourceArmMigrateProjectRead,
+		Update: resourceArmMigrateProjectUpdate,
+		Delete: resourceArmMigrateProjectDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+
+			"resource_group_name": resourceGroupNameSchema(),
+
+			"location": locationSchema(),
+
+			"discovery_status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"tags": tagsSchema(),
+		},
+	}
+}
+
+func resourceArmMigrateProjectCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).migrateProjectsClient
+	ctx := meta.(*ArmClient).StopContext
+
+	log.Printf("[INFO] preparing arguments for Migrate Project creation.")
+
+	name := d.Get("name").(string)
+	resourceGroup := d.Get("resource_group_name").(string)
+	location := azureRMNormalizeLocation(d.Get("location").(string))
+	tags := d.Get("tags").(map[string]interface{})
+
+	project := migrate.Project{
+		Location:          utils.String(location),
+		ProjectProperties: &migrate.ProjectProperties{},
+		Tags:              expandTags(tags),
+	}
+
+	if _, err := client.Create(ctx, resourceGroup, name, &project); err != nil {
+		return fmt.Errorf("Error creating Migrate Project %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	read, err := client.Get(ctx, resourceGroup, name)
+	if err != nil {
+		return fmt.Errorf("Error retrieving Migrate Project %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+	if read.ID == nil {
+		return fmt.Errorf("Cannot read Migrate Project %q (Resource Group %q) ID", name, resourceGroup)
+	}
+
+	d.SetId(*read.ID)
+
+	return resourceArmMigrateProjectRead(d, meta)
+}
+
+func resourceArmMigrateProjectUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).migrateProjectsClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	name := id.Path["projects"]
+
+	// only the tags can be updated, the rest of the Project is maintained by Azure Migrate
+	tags := d.Get("tags").(map[string]interface{})
+	project := migrate.Project{
+		Tags: expandTags(tags),
+	}
+
+	if _, err := client.Update(ctx, resourceGroup, name, &project); err != nil {
+		return fmt.Errorf("Error updating Migrate Project %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	return resourceArmMigrateProjectRead(d, meta)
+}
+
+func resourceArmMigrateProjectRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).migrateProjectsClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	name := id.Path["projects"]
+
+	resp, err := client.Get(ctx, resourceGroup, name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] Migrate Project %q (Resource Group %q) was not found - removing from state", name, resourceGroup)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error retrieving Migrate Project %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	d.Set("name", resp.Name)
+	d.Set("resource_group_name", resourceGroup)
+	if location := resp.Location; location != nil {
+		d.Set("location", azureRMNormalizeLocation(*location))
+	}
+
+	if props := resp.ProjectProperties; props != nil {
+		d.Set("discovery_status", string(props.DiscoveryStatus))
+	}
+
+	flattenAndSetTags(d, flattenMigrateTags(resp.Tags))
+
+	return nil
+}
+
+func resourceArmMigrateProjectDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).migrateProjectsClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	name := id.Path["projects"]
+
+	resp, err := client.Delete(ctx, resourceGroup, name)
+	if err != nil {
+		if !utils.ResponseWasNotFound(resp) {
+			return fmt.Errorf("Error deleting Migrate Project %q (Resource Group %q): %+v", name, resourceGroup, err)
+		}
+	}
+
+	return nil
+}
+
+// the Migrate API models the tags as an untyped object, rather than a map of strings
+func flattenMigrateTags(input interface{}) map[string]*string {
+	output := make(map[string]*string)
+
+	tags, ok := input.(map[string]interface{})
+	if !ok {
+		return output
+	}
+
+	for k, v := range tags {
+		if value, ok := v.(string); ok {
+			output[k] = utils.String(value)
+		}
+	}
+
+	return output
+}
@@ -0,0 +1,190 @@
+package azurerm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func TestFlattenMigrateTags(t *testing.T) {
+	testData := []struct {
+		Input    interface{}
+		Expected map[string]string
+	}{
+		{
+			Input:    nil,
+			Expected: map[string]string{},
+		},
+		{
+			Input:    "not-a-map",
+			Expected: map[string]string{},
+		},
+		{
+			Input: map[string]interface{}{
+				"environment": "Production",
+				"cost_center": "MSFT",
+			},
+			Expected: map[string]string{
+				"environment": "Production",
+				"cost_center": "MSFT",
+			},
+		},
+	}
+
+	for _, v := range testData {
+		actual := flattenMigrateTags(v.Input)
+		if len(actual) != len(v.Expected) {
+			t.Fatalf("Expected %d tags but got %d for %+v", len(v.Expected), len(actual), v.Input)
+		}
+
+		for key, value := range v.Expected {
+			if actual[key] == nil || *actual[key] != value {
+				t.Fatalf("Expected tag %q to be %q but got %+v", key, value, actual[key])
+			}
+		}
+	}
+}
+
+func TestAccAzureRMMigrateProject_basic(t *testing.T) {
+	resourceName := "azurerm_migrate_project.test"
+	ri := acctest.RandInt()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMMigrateProjectDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMMigrateProject_basic(ri, testLocation()),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMMigrateProjectExists(resourceName),
+					resource.TestCheckResourceAttrSet(resourceName, "discovery_status"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAzureRMMigrateProject_tags(t *testing.T) {
+	resourceName := "azurerm_migrate_project.test"
+	ri := acctest.RandInt()
+	location := testLocation()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMMigrateProjectDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMMigrateProject_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMMigrateProjectExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				Config: testAccAzureRMMigrateProject_tags(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMMigrateProjectExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.wave", "one"),
+				),
+			},
+		},
+	})
+}
+
+func testCheckAzureRMMigrateProjectExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		name := rs.Primary.Attributes["name"]
+		resourceGroup := rs.Primary.Attributes["resource_group_name"]
+
+		client := testAccProvider.Meta().(*ArmClient).migrateProjectsClient
+		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+		resp, err := client.Get(ctx, resourceGroup, name)
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return fmt.Errorf("Bad: Migrate Project %q (Resource Group %q) does not exist", name, resourceGroup)
+			}
+			return fmt.Errorf("Bad: Get on migrateProjectsClient: %+v", err)
+		}
+
+		return nil
+	}
+}
+
+func testCheckAzureRMMigrateProjectDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*ArmClient).migrateProjectsClient
+	ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_migrate_project" {
+			continue
+		}
+
+		name := rs.Primary.Attributes["name"]
+		resourceGroup := rs.Primary.Attributes["resource_group_name"]
+
+		resp, err := client.Get(ctx, resourceGroup, name)
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return nil
+			}
+			return err
+		}
+
+		return fmt.Errorf("Migrate Project still exists:\n%#v", resp)
+	}
+
+	return nil
+}
+
+func testAccAzureRMMigrateProject_basic(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_migrate_project" "test" {
+  name                = "acctestmp-%d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+}
+`, rInt, location, rInt)
+}
+
+func testAccAzureRMMigrateProject_tags(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_migrate_project" "test" {
+  name                = "acctestmp-%d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+
+  tags {
+    wave = "one"
+  }
+}
+`, rInt, location, rInt)
+}
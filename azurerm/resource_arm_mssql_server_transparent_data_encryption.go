@@ -0,0 +1,306 @@
+package azurerm
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/url"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/services/keyvault/2016-10-01/keyvault"
+	"github.com/Azure/azure-sdk-for-go/services/preview/sql/mgmt/2015-05-01-preview/sql"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+// the name of the Server Key which is used when the Encryption Protector is Service Managed
+const sqlServerServiceManagedKeyName = "ServiceManaged"
+
+func resourceArmMsSqlServerTransparentDataEncryption() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmMsSqlServerTransparentDataEncryptionCreateUpdate,
+		Read:   resourceArmMsSqlServerTransparentDataEncryptionRead,
+		Update: resourceArmMsSqlServerTransparentDataEncryptionCreateUpdate,
+		Delete: resourceArmMsSqlServerTransparentDataEncryptionDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		CustomizeDiff: resourceArmMsSqlServerTransparentDataEncryptionCustomizeDiff,
+
+		Schema: map[string]*schema.Schema{
+			"server_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"resource_group_name": resourceGroupNameSchema(),
+
+			// when omitted the Encryption Protector is Service Managed
+			"key_vault_key_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: azure.ValidateKeyVaultChildIdVersionOptional,
+			},
+
+			"current_key_vault_key_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceArmMsSqlServerTransparentDataEncryptionCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).sqlEncryptionProtectorsClient
+	keysClient := meta.(*ArmClient).sqlServerKeysClient
+	keyVaultClient := meta.(*ArmClient).keyVaultManagementClient
+	ctx := meta.(*ArmClient).StopContext
+
+	serverName := d.Get("server_name").(string)
+	resourceGroup := d.Get("resource_group_name").(string)
+	keyVaultKeyId := d.Get("key_vault_key_id").(string)
+
+	parameters := sql.EncryptionProtector{
+		EncryptionProtectorProperties: &sql.EncryptionProtectorProperties{
+			ServerKeyName: utils.String(sqlServerServiceManagedKeyName),
+			ServerKeyType: sql.ServiceManaged,
+		},
+	}
+
+	if keyVaultKeyId != "" {
+		// when no version is specified this is the latest version of the Key
+		keyId, err := resolveKeyVaultKeyID(ctx, keyVaultClient, keyVaultKeyId)
+		if err != nil {
+			return fmt.Errorf("Error resolving Key Vault Key %q: %+v", keyVaultKeyId, err)
+		}
+
+		keyName, err := sqlServerKeyNameFromKeyVaultKeyID(keyId)
+		if err != nil {
+			return err
+		}
+
+		serverKey := sql.ServerKey{
+			ServerKeyProperties: &sql.ServerKeyProperties{
+				ServerKeyType: sql.AzureKeyVault,
+				URI:           utils.String(keyId),
+			},
+		}
+
+		log.Printf("[DEBUG] Adding Key %q to SQL Server %q (Resource Group %q)", keyName, serverName, resourceGroup)
+		keyFuture, err := keysClient.CreateOrUpdate(ctx, resourceGroup, serverName, keyName, serverKey)
+		if err != nil {
+			return fmt.Errorf("Error adding Key %q to SQL Server %q (Resource Group %q): %+v", keyName, serverName, resourceGroup, err)
+		}
+
+		if err = keyFuture.WaitForCompletionRef(ctx, keysClient.Client); err != nil {
+			return fmt.Errorf("Error waiting for Key %q to be added to SQL Server %q (Resource Group %q): %+v", keyName, serverName, resourceGroup, err)
+		}
+
+		parameters.EncryptionProtectorProperties.ServerKeyName = utils.String(keyName)
+		parameters.EncryptionProtectorProperties.ServerKeyType = sql.AzureKeyVault
+	}
+
+	future, err := client.CreateOrUpdate(ctx, resourceGroup, serverName, parameters)
+	if err != nil {
+		return fmt.Errorf("Error updating Encryption Protector for SQL Server %q (Resource Group %q): %+v", serverName, resourceGroup, err)
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("Error waiting for Encryption Protector for SQL Server %q (Resource Group %q) to be updated: %+v", serverName, resourceGroup, err)
+	}
+
+	resp, err := client.Get(ctx, resourceGroup, serverName)
+	if err != nil {
+		return fmt.Errorf("Error retrieving Encryption Protector for SQL Server %q (Resource Group %q): %+v", serverName, resourceGroup, err)
+	}
+
+	if resp.ID == nil {
+		return fmt.Errorf("Cannot read Encryption Protector for SQL Server %q (Resource Group %q) ID", serverName, resourceGroup)
+	}
+
+	d.SetId(*resp.ID)
+
+	return resourceArmMsSqlServerTransparentDataEncryptionRead(d, meta)
+}
+
+func resourceArmMsSqlServerTransparentDataEncryptionRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).sqlEncryptionProtectorsClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resourceGroup := id.ResourceGroup
+	serverName := id.Path["servers"]
+
+	resp, err := client.Get(ctx, resourceGroup, serverName)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[INFO] Encryption Protector for SQL Server %q was not found - removing from state", serverName)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("Error retrieving Encryption Protector for SQL Server %q (Resource Group %q): %+v", serverName, resourceGroup, err)
+	}
+
+	d.Set("server_name", serverName)
+	d.Set("resource_group_name", resourceGroup)
+
+	keyVaultKeyId := ""
+	currentKeyVaultKeyId := ""
+	if props := resp.EncryptionProtectorProperties; props != nil && props.ServerKeyType == sql.AzureKeyVault && props.URI != nil {
+		currentKeyVaultKeyId = *props.URI
+		keyVaultKeyId = currentKeyVaultKeyId
+
+		// a version-less Key ID is kept as configured, providing it still refers to the same Key
+		if configured := d.Get("key_vault_key_id").(string); keyVaultKeyIDMatches(configured, currentKeyVaultKeyId) {
+			keyVaultKeyId = configured
+		}
+	}
+
+	d.Set("key_vault_key_id", keyVaultKeyId)
+	d.Set("current_key_vault_key_id", currentKeyVaultKeyId)
+
+	return nil
+}
+
+func resourceArmMsSqlServerTransparentDataEncryptionDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).sqlEncryptionProtectorsClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resourceGroup := id.ResourceGroup
+	serverName := id.Path["servers"]
+
+	// the Encryption Protector can't be removed, so instead we revert to a Service Managed Key
+	parameters := sql.EncryptionProtector{
+		EncryptionProtectorProperties: &sql.EncryptionProtectorProperties{
+			ServerKeyName: utils.String(sqlServerServiceManagedKeyName),
+			ServerKeyType: sql.ServiceManaged,
+		},
+	}
+
+	future, err := client.CreateOrUpdate(ctx, resourceGroup, serverName, parameters)
+	if err != nil {
+		return fmt.Errorf("Error reverting Encryption Protector for SQL Server %q (Resource Group %q): %+v", serverName, resourceGroup, err)
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("Error waiting for Encryption Protector for SQL Server %q (Resource Group %q) to be reverted: %+v", serverName, resourceGroup, err)
+	}
+
+	return nil
+}
+
+func resourceArmMsSqlServerTransparentDataEncryptionCustomizeDiff(d *schema.ResourceDiff, meta interface{}) error {
+	if d.HasChange("key_vault_key_id") {
+		return d.SetNewComputed("current_key_vault_key_id")
+	}
+
+	// the Key ID may be interpolated from another resource, in which case it's not known until apply
+	if d.Id() == "" || !d.NewValueKnown("key_vault_key_id") {
+		return nil
+	}
+
+	keyVaultKeyId := d.Get("key_vault_key_id").(string)
+	if keyVaultKeyId == "" {
+		return nil
+	}
+
+	id, err := azure.ParseKeyVaultChildIDVersionOptional(keyVaultKeyId)
+	if err != nil {
+		return err
+	}
+
+	// when a version-less Key ID is used, a new version of the Key rotates the Encryption Protector
+	if id.Version != "" {
+		return nil
+	}
+
+	client := meta.(*ArmClient).keyVaultManagementClient
+	ctx := meta.(*ArmClient).StopContext
+
+	latestKeyId, err := resolveKeyVaultKeyID(ctx, client, keyVaultKeyId)
+	if err != nil {
+		return fmt.Errorf("Error resolving Key Vault Key %q: %+v", keyVaultKeyId, err)
+	}
+
+	if !strings.EqualFold(latestKeyId, d.Get("current_key_vault_key_id").(string)) {
+		log.Printf("[DEBUG] Key Vault Key %q has a newer version %q - rotating the Encryption Protector", keyVaultKeyId, latestKeyId)
+		return d.SetNew("current_key_vault_key_id", latestKeyId)
+	}
+
+	return nil
+}
+
+// resolveKeyVaultKeyID returns the versioned ID of the specified Key Vault Key, which is the
+// latest version when the ID doesn't contain a version
+func resolveKeyVaultKeyID(ctx context.Context, client keyvault.BaseClient, keyVaultKeyId string) (string, error) {
+	id, err := azure.ParseKeyVaultChildIDVersionOptional(keyVaultKeyId)
+	if err != nil {
+		return "", err
+	}
+
+	resp, err := client.GetKey(ctx, id.KeyVaultBaseUrl, id.Name, id.Version)
+	if err != nil {
+		return "", err
+	}
+
+	if resp.Key == nil || resp.Key.Kid == nil {
+		return "", fmt.Errorf("Key %q in Key Vault at URI %q was returned without an ID", id.Name, id.KeyVaultBaseUrl)
+	}
+
+	return *resp.Key.Kid, nil
+}
+
+// keyVaultKeyIDMatches determines if the (optionally version-less) Key Vault Key ID `configured`
+// refers to the same Key as the versioned Key Vault Key ID `current`
+func keyVaultKeyIDMatches(configured string, current string) bool {
+	if configured == "" {
+		return false
+	}
+
+	configuredId, err := azure.ParseKeyVaultChildIDVersionOptional(configured)
+	if err != nil {
+		return false
+	}
+
+	currentId, err := azure.ParseKeyVaultChildIDVersionOptional(current)
+	if err != nil {
+		return false
+	}
+
+	if !strings.EqualFold(configuredId.KeyVaultBaseUrl, currentId.KeyVaultBaseUrl) || !strings.EqualFold(configuredId.Name, currentId.Name) {
+		return false
+	}
+
+	return configuredId.Version == "" || strings.EqualFold(configuredId.Version, currentId.Version)
+}
+
+// sqlServerKeyNameFromKeyVaultKeyID returns the name of the SQL Server Key for a versioned Key Vault Key ID
+// which the API requires to be in the format `{vaultName}_{keyName}_{keyVersion}`
+func sqlServerKeyNameFromKeyVaultKeyID(keyVaultKeyId string) (string, error) {
+	id, err := azure.ParseKeyVaultChildID(keyVaultKeyId)
+	if err != nil {
+		return "", err
+	}
+
+	vaultUrl, err := url.Parse(id.KeyVaultBaseUrl)
+	if err != nil {
+		return "", fmt.Errorf("Error parsing Key Vault URI %q: %+v", id.KeyVaultBaseUrl, err)
+	}
+
+	vaultName := strings.Split(vaultUrl.Host, ".")[0]
+
+	return fmt.Sprintf("%s_%s_%s", vaultName, id.Name, id.Version), nil
+}
@@ -0,0 +1,348 @@
+package azurerm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/services/preview/sql/mgmt/2015-05-01-preview/sql"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func TestKeyVaultKeyIDMatches(t *testing.T) {
+	current := "https://acctestkv.vault.azure.net/keys/castle/1492"
+
+	cases := []struct {
+		Configured string
+		Expected   bool
+	}{
+		{
+			Configured: "",
+			Expected:   false,
+		},
+		{
+			Configured: "https://acctestkv.vault.azure.net/keys/castle",
+			Expected:   true,
+		},
+		{
+			Configured: "https://ACCTESTKV.vault.azure.net/keys/Castle",
+			Expected:   true,
+		},
+		{
+			Configured: "https://acctestkv.vault.azure.net/keys/castle/1492",
+			Expected:   true,
+		},
+		{
+			Configured: "https://acctestkv.vault.azure.net/keys/castle/1066",
+			Expected:   false,
+		},
+		{
+			Configured: "https://acctestkv.vault.azure.net/keys/moat",
+			Expected:   false,
+		},
+		{
+			Configured: "https://other.vault.azure.net/keys/castle",
+			Expected:   false,
+		},
+	}
+
+	for _, tc := range cases {
+		if actual := keyVaultKeyIDMatches(tc.Configured, current); actual != tc.Expected {
+			t.Fatalf("Expected %t for %q but got %t", tc.Expected, tc.Configured, actual)
+		}
+	}
+}
+
+func TestSqlServerKeyNameFromKeyVaultKeyID(t *testing.T) {
+	cases := []struct {
+		Input       string
+		Expected    string
+		ExpectError bool
+	}{
+		{
+			Input:       "https://acctestkv.vault.azure.net/keys/castle",
+			ExpectError: true,
+		},
+		{
+			Input:    "https://acctestkv.vault.azure.net/keys/castle/1492",
+			Expected: "acctestkv_castle_1492",
+		},
+	}
+
+	for _, tc := range cases {
+		actual, err := sqlServerKeyNameFromKeyVaultKeyID(tc.Input)
+		if err != nil {
+			if !tc.ExpectError {
+				t.Fatalf("Got error for %q: %+v", tc.Input, err)
+			}
+
+			continue
+		}
+
+		if tc.ExpectError {
+			t.Fatalf("Expected an error for %q but didn't get one", tc.Input)
+		}
+
+		if actual != tc.Expected {
+			t.Fatalf("Expected %q for %q but got %q", tc.Expected, tc.Input, actual)
+		}
+	}
+}
+
+func TestAccAzureRMMsSqlServerTransparentDataEncryption_keyVault(t *testing.T) {
+	resourceName := "azurerm_mssql_server_transparent_data_encryption.test"
+	ri := acctest.RandInt()
+	rs := acctest.RandString(6)
+	config := testAccAzureRMMsSqlServerTransparentDataEncryption_keyVault(ri, rs, testLocation())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMMsSqlServerTransparentDataEncryptionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMMsSqlServerTransparentDataEncryptionExists(resourceName, sql.AzureKeyVault),
+					resource.TestCheckResourceAttrPair(resourceName, "key_vault_key_id", "azurerm_key_vault_key.test", "id"),
+					resource.TestCheckResourceAttrPair(resourceName, "current_key_vault_key_id", "azurerm_key_vault_key.test", "id"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAzureRMMsSqlServerTransparentDataEncryption_latestKeyVersion(t *testing.T) {
+	resourceName := "azurerm_mssql_server_transparent_data_encryption.test"
+	ri := acctest.RandInt()
+	rs := acctest.RandString(6)
+	config := testAccAzureRMMsSqlServerTransparentDataEncryption_latestKeyVersion(ri, rs, testLocation())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMMsSqlServerTransparentDataEncryptionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMMsSqlServerTransparentDataEncryptionExists(resourceName, sql.AzureKeyVault),
+					resource.TestCheckResourceAttr(resourceName, "key_vault_key_id", fmt.Sprintf("https://acctestkv-%s.vault.azure.net/keys/key-%s", rs, rs)),
+					resource.TestCheckResourceAttrPair(resourceName, "current_key_vault_key_id", "azurerm_key_vault_key.test", "id"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMMsSqlServerTransparentDataEncryption_serviceManaged(t *testing.T) {
+	resourceName := "azurerm_mssql_server_transparent_data_encryption.test"
+	ri := acctest.RandInt()
+	rs := acctest.RandString(6)
+	location := testLocation()
+	preConfig := testAccAzureRMMsSqlServerTransparentDataEncryption_keyVault(ri, rs, location)
+	postConfig := testAccAzureRMMsSqlServerTransparentDataEncryption_serviceManaged(ri, rs, location)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMMsSqlServerTransparentDataEncryptionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: preConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMMsSqlServerTransparentDataEncryptionExists(resourceName, sql.AzureKeyVault),
+				),
+			},
+			{
+				Config: postConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMMsSqlServerTransparentDataEncryptionExists(resourceName, sql.ServiceManaged),
+					resource.TestCheckResourceAttr(resourceName, "key_vault_key_id", ""),
+					resource.TestCheckResourceAttr(resourceName, "current_key_vault_key_id", ""),
+				),
+			},
+		},
+	})
+}
+
+func testCheckAzureRMMsSqlServerTransparentDataEncryptionExists(resourceName string, keyType sql.ServerKeyType) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		// Ensure we have enough information in state to look up in API
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		serverName := rs.Primary.Attributes["server_name"]
+		resourceGroup := rs.Primary.Attributes["resource_group_name"]
+
+		client := testAccProvider.Meta().(*ArmClient).sqlEncryptionProtectorsClient
+		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+		resp, err := client.Get(ctx, resourceGroup, serverName)
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return fmt.Errorf("Bad: Encryption Protector for SQL Server %q (Resource Group %q) does not exist", serverName, resourceGroup)
+			}
+
+			return fmt.Errorf("Bad: Get on sqlEncryptionProtectorsClient: %+v", err)
+		}
+
+		if props := resp.EncryptionProtectorProperties; props == nil || props.ServerKeyType != keyType {
+			return fmt.Errorf("Bad: Encryption Protector for SQL Server %q (Resource Group %q) is not of type %q", serverName, resourceGroup, string(keyType))
+		}
+
+		return nil
+	}
+}
+
+func testCheckAzureRMMsSqlServerTransparentDataEncryptionDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*ArmClient).sqlEncryptionProtectorsClient
+	ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_mssql_server_transparent_data_encryption" {
+			continue
+		}
+
+		serverName := rs.Primary.Attributes["server_name"]
+		resourceGroup := rs.Primary.Attributes["resource_group_name"]
+
+		resp, err := client.Get(ctx, resourceGroup, serverName)
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return nil
+			}
+
+			return fmt.Errorf("Bad: Get on sqlEncryptionProtectorsClient: %+v", err)
+		}
+
+		// the Encryption Protector can't be removed, only reverted to being Service Managed
+		if props := resp.EncryptionProtectorProperties; props != nil && props.ServerKeyType != sql.ServiceManaged {
+			return fmt.Errorf("Encryption Protector for SQL Server %q (Resource Group %q) is still using a Key Vault Key", serverName, resourceGroup)
+		}
+	}
+
+	return nil
+}
+
+func testAccAzureRMMsSqlServerTransparentDataEncryption_template(rInt int, rString string, location string) string {
+	return fmt.Sprintf(`
+data "azurerm_client_config" "current" {}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_sql_server" "test" {
+  name                         = "acctestsqlserver%d"
+  resource_group_name          = "${azurerm_resource_group.test.name}"
+  location                     = "${azurerm_resource_group.test.location}"
+  version                      = "12.0"
+  administrator_login          = "mradministrator"
+  administrator_login_password = "thisIsDog11"
+
+  identity {
+    type = "SystemAssigned"
+  }
+}
+
+resource "azurerm_key_vault" "test" {
+  name                = "acctestkv-%s"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  tenant_id           = "${data.azurerm_client_config.current.tenant_id}"
+
+  sku {
+    name = "standard"
+  }
+
+  access_policy {
+    tenant_id = "${data.azurerm_client_config.current.tenant_id}"
+    object_id = "${data.azurerm_client_config.current.service_principal_object_id}"
+
+    key_permissions = [
+      "create",
+      "delete",
+      "get",
+    ]
+  }
+}
+
+resource "azurerm_key_vault_access_policy" "test" {
+  vault_name          = "${azurerm_key_vault.test.name}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  tenant_id           = "${azurerm_sql_server.test.identity.0.tenant_id}"
+  object_id           = "${azurerm_sql_server.test.identity.0.principal_id}"
+
+  key_permissions = [
+    "get",
+    "unwrapKey",
+    "wrapKey",
+  ]
+}
+
+resource "azurerm_key_vault_key" "test" {
+  name      = "key-%s"
+  vault_uri = "${azurerm_key_vault.test.vault_uri}"
+  key_type  = "RSA"
+  key_size  = 2048
+
+  key_opts = [
+    "unwrapKey",
+    "wrapKey",
+  ]
+}
+`, rInt, location, rInt, rString, rString)
+}
+
+func testAccAzureRMMsSqlServerTransparentDataEncryption_keyVault(rInt int, rString string, location string) string {
+	template := testAccAzureRMMsSqlServerTransparentDataEncryption_template(rInt, rString, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_mssql_server_transparent_data_encryption" "test" {
+  server_name         = "${azurerm_sql_server.test.name}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  key_vault_key_id    = "${azurerm_key_vault_key.test.id}"
+
+  depends_on = ["azurerm_key_vault_access_policy.test"]
+}
+`, template)
+}
+
+func testAccAzureRMMsSqlServerTransparentDataEncryption_latestKeyVersion(rInt int, rString string, location string) string {
+	template := testAccAzureRMMsSqlServerTransparentDataEncryption_template(rInt, rString, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_mssql_server_transparent_data_encryption" "test" {
+  server_name         = "${azurerm_sql_server.test.name}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  key_vault_key_id    = "${azurerm_key_vault.test.vault_uri}keys/${azurerm_key_vault_key.test.name}"
+
+  depends_on = ["azurerm_key_vault_access_policy.test"]
+}
+`, template)
+}
+
+func testAccAzureRMMsSqlServerTransparentDataEncryption_serviceManaged(rInt int, rString string, location string) string {
+	template := testAccAzureRMMsSqlServerTransparentDataEncryption_template(rInt, rString, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_mssql_server_transparent_data_encryption" "test" {
+  server_name         = "${azurerm_sql_server.test.name}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+}
+`, template)
+}
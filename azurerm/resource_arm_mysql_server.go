@@ -4,10 +4,14 @@ import (
 	"fmt"
 	"log"
 	"strings"
+	"time"
 
 	"github.com/Azure/azure-sdk-for-go/services/mysql/mgmt/2017-12-01/mysql"
+	"github.com/Azure/go-autorest/autorest/date"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
@@ -103,16 +107,44 @@ func resourceArmMySqlServer() *schema.Resource {
 
 			"administrator_login": {
 				Type:     schema.TypeString,
-				Required: true,
+				Optional: true,
+				Computed: true,
 				ForceNew: true,
 			},
 
 			"administrator_login_password": {
 				Type:      schema.TypeString,
-				Required:  true,
+				Optional:  true,
 				Sensitive: true,
 			},
 
+			"create_mode": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Default:  string(mysql.CreateModeDefault),
+				ValidateFunc: validation.StringInSlice([]string{
+					string(mysql.CreateModeDefault),
+					string(mysql.CreateModeGeoRestore),
+					string(mysql.CreateModePointInTimeRestore),
+					string(mysql.CreateModeReplica),
+				}, false),
+			},
+
+			"creation_source_server_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: azure.ValidateResourceID,
+			},
+
+			"restore_point_in_time": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.RFC3339Time,
+			},
+
 			"version": {
 				Type:     schema.TypeString,
 				Required: true,
@@ -199,24 +231,45 @@ func resourceArmMySqlServerCreate(d *schema.ResourceData, meta interface{}) erro
 	adminLoginPassword := d.Get("administrator_login_password").(string)
 	sslEnforcement := d.Get("ssl_enforcement").(string)
 	version := d.Get("version").(string)
-	createMode := "Default"
+	createMode := d.Get("create_mode").(string)
 	tags := d.Get("tags").(map[string]interface{})
 
 	sku := expandMySQLServerSku(d)
 	storageProfile := expandMySQLStorageProfile(d)
 
-	properties := mysql.ServerForCreate{
-		Location: &location,
-		Properties: &mysql.ServerPropertiesForDefaultCreate{
+	var serverProperties mysql.BasicServerPropertiesForCreate
+	switch mysql.CreateMode(createMode) {
+	case mysql.CreateModeDefault:
+		if adminLogin == "" || adminLoginPassword == "" {
+			return fmt.Errorf("`administrator_login` and `administrator_login_password` must be specified when `create_mode` is `Default`")
+		}
+
+		serverProperties = &mysql.ServerPropertiesForDefaultCreate{
 			AdministratorLogin:         utils.String(adminLogin),
 			AdministratorLoginPassword: utils.String(adminLoginPassword),
 			Version:                    mysql.ServerVersion(version),
 			SslEnforcement:             mysql.SslEnforcementEnum(sslEnforcement),
 			StorageProfile:             storageProfile,
 			CreateMode:                 mysql.CreateMode(createMode),
-		},
-		Sku:  sku,
-		Tags: expandTags(tags),
+		}
+	default:
+		sourceServerId := d.Get("creation_source_server_id").(string)
+		if sourceServerId == "" {
+			return fmt.Errorf("`creation_source_server_id` must be specified when `create_mode` is %q", createMode)
+		}
+
+		restoreProperties, err := expandMySQLServerPropertiesForRestore(d, createMode, sourceServerId, storageProfile)
+		if err != nil {
+			return err
+		}
+		serverProperties = restoreProperties
+	}
+
+	properties := mysql.ServerForCreate{
+		Location:   &location,
+		Properties: serverProperties,
+		Sku:        sku,
+		Tags:       expandTags(tags),
 	}
 
 	future, err := client.Create(ctx, resourceGroup, name, properties)
@@ -261,15 +314,18 @@ func resourceArmMySqlServerUpdate(d *schema.ResourceData, meta interface{}) erro
 
 	properties := mysql.ServerUpdateParameters{
 		ServerUpdateParametersProperties: &mysql.ServerUpdateParametersProperties{
-			StorageProfile:             storageProfile,
-			AdministratorLoginPassword: utils.String(adminLoginPassword),
-			Version:                    mysql.ServerVersion(version),
-			SslEnforcement:             mysql.SslEnforcementEnum(sslEnforcement),
+			StorageProfile: storageProfile,
+			Version:        mysql.ServerVersion(version),
+			SslEnforcement: mysql.SslEnforcementEnum(sslEnforcement),
 		},
 		Sku:  sku,
 		Tags: expandTags(tags),
 	}
 
+	if adminLoginPassword != "" {
+		properties.ServerUpdateParametersProperties.AdministratorLoginPassword = utils.String(adminLoginPassword)
+	}
+
 	future, err := client.Update(ctx, resourceGroup, name, properties)
 	if err != nil {
 		return fmt.Errorf("Error updating MySQL Server %q (Resource Group %q): %+v", name, resourceGroup, err)
@@ -323,6 +379,12 @@ func resourceArmMySqlServerRead(d *schema.ResourceData, meta interface{}) error
 	}
 
 	d.Set("administrator_login", resp.AdministratorLogin)
+
+	// `create_mode` isn't returned by the API, so default it when importing
+	if _, ok := d.GetOk("create_mode"); !ok {
+		d.Set("create_mode", string(mysql.CreateModeDefault))
+	}
+
 	d.Set("version", string(resp.Version))
 	d.Set("ssl_enforcement", string(resp.SslEnforcement))
 
@@ -433,3 +495,48 @@ func flattenMySQLStorageProfile(resp *mysql.StorageProfile) []interface{} {
 
 	return []interface{}{values}
 }
+
+func expandMySQLServerPropertiesForRestore(d *schema.ResourceData, createMode string, sourceServerId string, storageProfile *mysql.StorageProfile) (mysql.BasicServerPropertiesForCreate, error) {
+	version := mysql.ServerVersion(d.Get("version").(string))
+	sslEnforcement := mysql.SslEnforcementEnum(d.Get("ssl_enforcement").(string))
+
+	switch mysql.CreateMode(createMode) {
+	case mysql.CreateModePointInTimeRestore:
+		v := d.Get("restore_point_in_time").(string)
+		if v == "" {
+			return nil, fmt.Errorf("`restore_point_in_time` must be specified when `create_mode` is `PointInTimeRestore`")
+		}
+
+		restorePointInTime, err := date.ParseTime(time.RFC3339, v)
+		if err != nil {
+			return nil, fmt.Errorf("Error parsing `restore_point_in_time` %q: %+v", v, err)
+		}
+
+		return &mysql.ServerPropertiesForRestore{
+			SourceServerID:     utils.String(sourceServerId),
+			RestorePointInTime: &date.Time{Time: restorePointInTime},
+			Version:            version,
+			SslEnforcement:     sslEnforcement,
+			StorageProfile:     storageProfile,
+			CreateMode:         mysql.CreateModePointInTimeRestore,
+		}, nil
+	case mysql.CreateModeGeoRestore:
+		return &mysql.ServerPropertiesForGeoRestore{
+			SourceServerID: utils.String(sourceServerId),
+			Version:        version,
+			SslEnforcement: sslEnforcement,
+			StorageProfile: storageProfile,
+			CreateMode:     mysql.CreateModeGeoRestore,
+		}, nil
+	case mysql.CreateModeReplica:
+		return &mysql.ServerPropertiesForReplica{
+			SourceServerID: utils.String(sourceServerId),
+			Version:        version,
+			SslEnforcement: sslEnforcement,
+			StorageProfile: storageProfile,
+			CreateMode:     mysql.CreateModeReplica,
+		}, nil
+	}
+
+	return nil, fmt.Errorf("Unsupported `create_mode` %q", createMode)
+}
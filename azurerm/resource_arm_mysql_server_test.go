@@ -207,6 +207,29 @@ func TestAccAzureRMMySQLServer_updateSKU(t *testing.T) {
 
 //
 
+func TestAccAzureRMMySQLServer_createReplica(t *testing.T) {
+	resourceName := "azurerm_mysql_server.replica"
+	ri := acctest.RandInt()
+	config := testAccAzureRMMySQLServer_createReplica(ri, testLocation())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMMySQLServerDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMMySQLServerExists("azurerm_mysql_server.test"),
+					testCheckAzureRMMySQLServerExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "create_mode", "Replica"),
+					resource.TestCheckResourceAttr(resourceName, "administrator_login", "acctestun"),
+				),
+			},
+		},
+	})
+}
+
 func testCheckAzureRMMySQLServerExists(name string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		// Ensure we have enough information in state to look up in API
@@ -459,3 +482,60 @@ resource "azurerm_mysql_server" "test" {
 }
 `, rInt, location, rInt)
 }
+
+func testAccAzureRMMySQLServer_createReplica(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_mysql_server" "test" {
+  name                = "acctestmysqlsvr-%d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+
+  sku {
+    name     = "GP_Gen5_2"
+    capacity = 2
+    tier     = "GeneralPurpose"
+    family   = "Gen5"
+  }
+
+  storage_profile {
+    storage_mb            = 51200
+    backup_retention_days = 7
+    geo_redundant_backup  = "Disabled"
+  }
+
+  administrator_login          = "acctestun"
+  administrator_login_password = "H@Sh1CoR3!"
+  version                      = "5.7"
+  ssl_enforcement              = "Enabled"
+}
+
+resource "azurerm_mysql_server" "replica" {
+  name                = "acctestmysqlsvr-%d-replica"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+
+  sku {
+    name     = "GP_Gen5_2"
+    capacity = 2
+    tier     = "GeneralPurpose"
+    family   = "Gen5"
+  }
+
+  storage_profile {
+    storage_mb            = 51200
+    backup_retention_days = 7
+    geo_redundant_backup  = "Disabled"
+  }
+
+  create_mode               = "Replica"
+  creation_source_server_id = "${azurerm_mysql_server.test.id}"
+  version                   = "5.7"
+  ssl_enforcement           = "Enabled"
+}
+`, rInt, location, rInt, rInt)
+}
@@ -0,0 +1,173 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2018-08-01/network"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/response"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+var ddosProtectionPlanResourceName = "azurerm_network_ddos_protection_plan"
+
+func resourceArmNetworkDDoSProtectionPlan() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmNetworkDDoSProtectionPlanCreateUpdate,
+		Read:   resourceArmNetworkDDoSProtectionPlanRead,
+		Update: resourceArmNetworkDDoSProtectionPlanCreateUpdate,
+		Delete: resourceArmNetworkDDoSProtectionPlanDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"location": locationSchema(),
+
+			"resource_group_name": resourceGroupNameSchema(),
+
+			"virtual_network_ids": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+
+			"tags": tagsSchema(),
+		},
+	}
+}
+
+func resourceArmNetworkDDoSProtectionPlanCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).ddosProtectionPlanClient
+	ctx := meta.(*ArmClient).StopContext
+
+	log.Printf("[INFO] preparing arguments for DDoS Protection Plan creation")
+
+	name := d.Get("name").(string)
+	resourceGroup := d.Get("resource_group_name").(string)
+	location := azureRMNormalizeLocation(d.Get("location").(string))
+	tags := d.Get("tags").(map[string]interface{})
+
+	azureRMLockByName(name, ddosProtectionPlanResourceName)
+	defer azureRMUnlockByName(name, ddosProtectionPlanResourceName)
+
+	parameters := network.DdosProtectionPlan{
+		Location: &location,
+		Tags:     expandTags(tags),
+	}
+
+	future, err := client.CreateOrUpdate(ctx, resourceGroup, name, parameters)
+	if err != nil {
+		return fmt.Errorf("Error creating/updating DDoS Protection Plan %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("Error waiting for creation/update of DDoS Protection Plan %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	plan, err := client.Get(ctx, resourceGroup, name)
+	if err != nil {
+		return fmt.Errorf("Error retrieving DDoS Protection Plan %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	if plan.ID == nil {
+		return fmt.Errorf("Cannot read DDoS Protection Plan %q (Resource Group %q) ID", name, resourceGroup)
+	}
+
+	d.SetId(*plan.ID)
+
+	return resourceArmNetworkDDoSProtectionPlanRead(d, meta)
+}
+
+func resourceArmNetworkDDoSProtectionPlanRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).ddosProtectionPlanClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	name := id.Path["ddosProtectionPlans"]
+
+	plan, err := client.Get(ctx, resourceGroup, name)
+	if err != nil {
+		if utils.ResponseWasNotFound(plan.Response) {
+			log.Printf("[DEBUG] DDoS Protection Plan %q was not found in Resource Group %q - removing from state!", name, resourceGroup)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("Error making Read request on DDoS Protection Plan %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	d.Set("name", plan.Name)
+	d.Set("resource_group_name", resourceGroup)
+	if location := plan.Location; location != nil {
+		d.Set("location", azureRMNormalizeLocation(*location))
+	}
+
+	if props := plan.DdosProtectionPlanPropertiesFormat; props != nil {
+		vNetIDs := flattenArmNetworkDDoSProtectionPlanVirtualNetworkIDs(props.VirtualNetworks)
+		if err := d.Set("virtual_network_ids", vNetIDs); err != nil {
+			return fmt.Errorf("Error setting `virtual_network_ids`: %+v", err)
+		}
+	}
+
+	flattenAndSetTags(d, plan.Tags)
+
+	return nil
+}
+
+func resourceArmNetworkDDoSProtectionPlanDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).ddosProtectionPlanClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	name := id.Path["ddosProtectionPlans"]
+
+	azureRMLockByName(name, ddosProtectionPlanResourceName)
+	defer azureRMUnlockByName(name, ddosProtectionPlanResourceName)
+
+	future, err := client.Delete(ctx, resourceGroup, name)
+	if err != nil {
+		if response.WasNotFound(future.Response()) {
+			return nil
+		}
+		return fmt.Errorf("Error deleting DDoS Protection Plan %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		if !response.WasNotFound(future.Response()) {
+			return fmt.Errorf("Error waiting for the deletion of DDoS Protection Plan %q (Resource Group %q): %+v", name, resourceGroup, err)
+		}
+	}
+
+	return nil
+}
+
+func flattenArmNetworkDDoSProtectionPlanVirtualNetworkIDs(input *[]network.SubResource) []string {
+	vnetIDs := make([]string, 0)
+	if input == nil {
+		return vnetIDs
+	}
+
+	for _, subRes := range *input {
+		if subRes.ID != nil {
+			vnetIDs = append(vnetIDs, *subRes.ID)
+		}
+	}
+
+	return vnetIDs
+}
@@ -53,6 +53,15 @@ func testSweepNetworkInterfaces(region string) error {
 			continue
 		}
 
+		inUse, err := sweeperResourceGroupInUse(ctx, armClient, resourceGroupName)
+		if err != nil {
+			return err
+		}
+
+		if inUse {
+			continue
+		}
+
 		log.Printf("Deleting Network Interfaces %q", name)
 		future, err := client.Delete(ctx, resourceGroupName, name)
 		if err != nil {
@@ -4,11 +4,15 @@ import (
 	"fmt"
 	"log"
 	"strings"
+	"time"
 
 	"github.com/Azure/azure-sdk-for-go/services/postgresql/mgmt/2017-12-01/postgresql"
+	"github.com/Azure/go-autorest/autorest/date"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/response"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
@@ -104,16 +108,43 @@ func resourceArmPostgreSQLServer() *schema.Resource {
 
 			"administrator_login": {
 				Type:     schema.TypeString,
-				Required: true,
+				Optional: true,
+				Computed: true,
 				ForceNew: true,
 			},
 
 			"administrator_login_password": {
 				Type:      schema.TypeString,
-				Required:  true,
+				Optional:  true,
 				Sensitive: true,
 			},
 
+			"create_mode": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Default:  string(postgresql.CreateModeDefault),
+				ValidateFunc: validation.StringInSlice([]string{
+					string(postgresql.CreateModeDefault),
+					string(postgresql.CreateModeGeoRestore),
+					string(postgresql.CreateModePointInTimeRestore),
+				}, false),
+			},
+
+			"creation_source_server_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: azure.ValidateResourceID,
+			},
+
+			"restore_point_in_time": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.RFC3339Time,
+			},
+
 			"version": {
 				Type:     schema.TypeString,
 				Required: true,
@@ -204,24 +235,45 @@ func resourceArmPostgreSQLServerCreate(d *schema.ResourceData, meta interface{})
 	adminLoginPassword := d.Get("administrator_login_password").(string)
 	sslEnforcement := d.Get("ssl_enforcement").(string)
 	version := d.Get("version").(string)
-	createMode := "Default"
+	createMode := d.Get("create_mode").(string)
 	tags := d.Get("tags").(map[string]interface{})
 
 	sku := expandAzureRmPostgreSQLServerSku(d)
 	storageProfile := expandAzureRmPostgreSQLStorageProfile(d)
 
-	properties := postgresql.ServerForCreate{
-		Location: &location,
-		Properties: &postgresql.ServerPropertiesForDefaultCreate{
+	var serverProperties postgresql.BasicServerPropertiesForCreate
+	switch postgresql.CreateMode(createMode) {
+	case postgresql.CreateModeDefault:
+		if adminLogin == "" || adminLoginPassword == "" {
+			return fmt.Errorf("`administrator_login` and `administrator_login_password` must be specified when `create_mode` is `Default`")
+		}
+
+		serverProperties = &postgresql.ServerPropertiesForDefaultCreate{
 			AdministratorLogin:         utils.String(adminLogin),
 			AdministratorLoginPassword: utils.String(adminLoginPassword),
 			Version:                    postgresql.ServerVersion(version),
 			SslEnforcement:             postgresql.SslEnforcementEnum(sslEnforcement),
 			StorageProfile:             storageProfile,
 			CreateMode:                 postgresql.CreateMode(createMode),
-		},
-		Sku:  sku,
-		Tags: expandTags(tags),
+		}
+	default:
+		sourceServerId := d.Get("creation_source_server_id").(string)
+		if sourceServerId == "" {
+			return fmt.Errorf("`creation_source_server_id` must be specified when `create_mode` is %q", createMode)
+		}
+
+		restoreProperties, err := expandPostgreSQLServerPropertiesForRestore(d, createMode, sourceServerId, storageProfile)
+		if err != nil {
+			return err
+		}
+		serverProperties = restoreProperties
+	}
+
+	properties := postgresql.ServerForCreate{
+		Location:   &location,
+		Properties: serverProperties,
+		Sku:        sku,
+		Tags:       expandTags(tags),
 	}
 
 	future, err := client.Create(ctx, resourceGroup, name, properties)
@@ -266,15 +318,18 @@ func resourceArmPostgreSQLServerUpdate(d *schema.ResourceData, meta interface{})
 
 	properties := postgresql.ServerUpdateParameters{
 		ServerUpdateParametersProperties: &postgresql.ServerUpdateParametersProperties{
-			StorageProfile:             storageProfile,
-			AdministratorLoginPassword: utils.String(adminLoginPassword),
-			Version:                    postgresql.ServerVersion(version),
-			SslEnforcement:             postgresql.SslEnforcementEnum(sslEnforcement),
+			StorageProfile: storageProfile,
+			Version:        postgresql.ServerVersion(version),
+			SslEnforcement: postgresql.SslEnforcementEnum(sslEnforcement),
 		},
 		Sku:  sku,
 		Tags: expandTags(tags),
 	}
 
+	if adminLoginPassword != "" {
+		properties.ServerUpdateParametersProperties.AdministratorLoginPassword = utils.String(adminLoginPassword)
+	}
+
 	future, err := client.Update(ctx, resourceGroup, name, properties)
 	if err != nil {
 		return fmt.Errorf("Error updating PostgreSQL Server %q (Resource Group %q): %+v", name, resourceGroup, err)
@@ -329,6 +384,12 @@ func resourceArmPostgreSQLServerRead(d *schema.ResourceData, meta interface{}) e
 	}
 
 	d.Set("administrator_login", resp.AdministratorLogin)
+
+	// `create_mode` isn't returned by the API, so default it when importing
+	if _, ok := d.GetOk("create_mode"); !ok {
+		d.Set("create_mode", string(postgresql.CreateModeDefault))
+	}
+
 	d.Set("version", string(resp.Version))
 	d.Set("ssl_enforcement", string(resp.SslEnforcement))
 
@@ -447,3 +508,40 @@ func flattenPostgreSQLStorageProfile(resp *postgresql.StorageProfile) []interfac
 
 	return []interface{}{values}
 }
+
+func expandPostgreSQLServerPropertiesForRestore(d *schema.ResourceData, createMode string, sourceServerId string, storageProfile *postgresql.StorageProfile) (postgresql.BasicServerPropertiesForCreate, error) {
+	version := postgresql.ServerVersion(d.Get("version").(string))
+	sslEnforcement := postgresql.SslEnforcementEnum(d.Get("ssl_enforcement").(string))
+
+	switch postgresql.CreateMode(createMode) {
+	case postgresql.CreateModePointInTimeRestore:
+		v := d.Get("restore_point_in_time").(string)
+		if v == "" {
+			return nil, fmt.Errorf("`restore_point_in_time` must be specified when `create_mode` is `PointInTimeRestore`")
+		}
+
+		restorePointInTime, err := date.ParseTime(time.RFC3339, v)
+		if err != nil {
+			return nil, fmt.Errorf("Error parsing `restore_point_in_time` %q: %+v", v, err)
+		}
+
+		return &postgresql.ServerPropertiesForRestore{
+			SourceServerID:     utils.String(sourceServerId),
+			RestorePointInTime: &date.Time{Time: restorePointInTime},
+			Version:            version,
+			SslEnforcement:     sslEnforcement,
+			StorageProfile:     storageProfile,
+			CreateMode:         postgresql.CreateModePointInTimeRestore,
+		}, nil
+	case postgresql.CreateModeGeoRestore:
+		return &postgresql.ServerPropertiesForGeoRestore{
+			SourceServerID: utils.String(sourceServerId),
+			Version:        version,
+			SslEnforcement: sslEnforcement,
+			StorageProfile: storageProfile,
+			CreateMode:     postgresql.CreateModeGeoRestore,
+		}, nil
+	}
+
+	return nil, fmt.Errorf("Unsupported `create_mode` %q", createMode)
+}
@@ -3,6 +3,7 @@ package azurerm
 import (
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
@@ -295,6 +296,40 @@ func TestAccAzureRMPostgreSQLServer_updateSKU(t *testing.T) {
 
 //
 
+func TestAccAzureRMPostgreSQLServer_createPointInTimeRestore(t *testing.T) {
+	resourceName := "azurerm_postgresql_server.test"
+	restoreResourceName := "azurerm_postgresql_server.restore"
+	ri := acctest.RandInt()
+	location := testLocation()
+	preConfig := testAccAzureRMPostgreSQLServer_basicNinePointSix(ri, location)
+	restoreTime := time.Now().Add(11 * time.Minute)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMPostgreSQLServerDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: preConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMPostgreSQLServerExists(resourceName),
+				),
+			},
+			{
+				// the restore point must be after the server has been created and a backup has been taken
+				PreConfig: func() { time.Sleep(time.Until(restoreTime.Add(5 * time.Minute))) },
+				Config:    testAccAzureRMPostgreSQLServer_createPointInTimeRestore(ri, location, restoreTime.UTC().Format(time.RFC3339)),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMPostgreSQLServerExists(resourceName),
+					testCheckAzureRMPostgreSQLServerExists(restoreResourceName),
+					resource.TestCheckResourceAttr(restoreResourceName, "create_mode", "PointInTimeRestore"),
+					resource.TestCheckResourceAttr(restoreResourceName, "administrator_login", "acctestun"),
+				),
+			},
+		},
+	})
+}
+
 func testCheckAzureRMPostgreSQLServerExists(name string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		// Ensure we have enough information in state to look up in API
@@ -650,3 +685,35 @@ resource "azurerm_postgresql_server" "test" {
 }
 `, rInt, location, rInt)
 }
+
+func testAccAzureRMPostgreSQLServer_createPointInTimeRestore(rInt int, location, restoreTime string) string {
+	template := testAccAzureRMPostgreSQLServer_basicNinePointSix(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_postgresql_server" "restore" {
+  name                = "acctestpsqlsvr-%d-restore"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+
+  sku {
+    name     = "B_Gen4_2"
+    capacity = 2
+    tier     = "Basic"
+    family   = "Gen4"
+  }
+
+  storage_profile {
+    storage_mb            = 51200
+    backup_retention_days = 7
+    geo_redundant_backup  = "Disabled"
+  }
+
+  create_mode               = "PointInTimeRestore"
+  creation_source_server_id = "${azurerm_postgresql_server.test.id}"
+  restore_point_in_time     = "%s"
+  version                   = "9.6"
+  ssl_enforcement           = "Enabled"
+}
+`, template, rInt, restoreTime)
+}
@@ -4,12 +4,14 @@ import (
 	"fmt"
 	"log"
 	"net/http"
+	"strings"
 	"testing"
 
+	"github.com/Azure/azure-sdk-for-go/services/resources/mgmt/2017-05-10/resources"
+	multierror "github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
 func init() {
@@ -28,31 +30,72 @@ func testSweepResourceGroups(region string) error {
 	client := (*armClient).resourceGroupsClient
 	ctx := (*armClient).StopContext
 
+	minimumAge, err := sweeperMinimumAge()
+	if err != nil {
+		return err
+	}
+
 	log.Printf("Retrieving the Resource Groups..")
-	results, err := client.List(ctx, "", utils.Int32(int32(1000)))
+	results, err := client.ListComplete(ctx, "", nil)
 	if err != nil {
 		return fmt.Errorf("Error Listing on Resource Groups: %+v", err)
 	}
 
-	for _, resourceGroup := range results.Values() {
-		if !shouldSweepAcceptanceTestResource(*resourceGroup.Name, *resourceGroup.Location, region) {
+	// deleting a Resource Group can take a long time (e.g. when it contains an App Service Environment),
+	// so the deletions are all started before waiting on any of them to complete
+	futures := make(map[string]resources.GroupsDeleteFuture)
+	var result *multierror.Error
+	for results.NotDone() {
+		resourceGroup := results.Value()
+		if err := results.Next(); err != nil {
+			return fmt.Errorf("Error Listing on Resource Groups: %+v", err)
+		}
+
+		if resourceGroup.Name == nil || resourceGroup.Location == nil {
 			continue
 		}
 
 		name := *resourceGroup.Name
+		if !shouldSweepAcceptanceTestResource(name, *resourceGroup.Location, region) {
+			continue
+		}
+
+		if props := resourceGroup.Properties; props != nil && props.ProvisioningState != nil && strings.EqualFold(*props.ProvisioningState, "Deleting") {
+			log.Printf("Resource Group %q is already being deleted - skipping", name)
+			continue
+		}
+
+		if minimumAge > 0 {
+			inUse, err := resourceGroupModifiedWithin(ctx, armClient, name, minimumAge)
+			if err != nil {
+				result = multierror.Append(result, err)
+				continue
+			}
+
+			if inUse {
+				log.Printf("Resource Group %q has been modified within the last %s - skipping", name, minimumAge)
+				continue
+			}
+		}
+
 		log.Printf("Deleting Resource Group %q", name)
-		deleteFuture, err := client.Delete(ctx, name)
+		future, err := client.Delete(ctx, name)
 		if err != nil {
-			return err
+			result = multierror.Append(result, fmt.Errorf("Error deleting Resource Group %q: %+v", name, err))
+			continue
 		}
 
-		err = deleteFuture.WaitForCompletionRef(ctx, client.Client)
-		if err != nil {
-			return err
+		futures[name] = future
+	}
+
+	for name, future := range futures {
+		log.Printf("Waiting for the deletion of Resource Group %q", name)
+		if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+			result = multierror.Append(result, fmt.Errorf("Error waiting for the deletion of Resource Group %q: %+v", name, err))
 		}
 	}
 
-	return nil
+	return result.ErrorOrNil()
 }
 
 func TestAccAzureRMResourceGroup_basic(t *testing.T) {
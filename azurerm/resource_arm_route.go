@@ -2,11 +2,13 @@ package azurerm
 
 import (
 	"fmt"
+	"strings"
 
-	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2018-04-01/network"
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2018-08-01/network"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/helper/validation"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/suppress"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
@@ -21,6 +23,17 @@ func resourceArmRoute() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		CustomizeDiff: func(diff *schema.ResourceDiff, v interface{}) error {
+			// the IP Address may be interpolated from another resource, in which case it's not known until apply
+			if !diff.NewValueKnown("next_hop_in_ip_address") {
+				return nil
+			}
+
+			nextHopType := diff.Get("next_hop_type").(string)
+			nextHopInIPAddress := diff.Get("next_hop_in_ip_address").(string)
+			return validateRouteNextHopInIPAddress(nextHopType, nextHopInIPAddress)
+		},
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:         schema.TypeString,
@@ -57,9 +70,10 @@ func resourceArmRoute() *schema.Resource {
 			},
 
 			"next_hop_in_ip_address": {
-				Type:     schema.TypeString,
-				Optional: true,
-				Computed: true,
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validate.IPv4Address,
 			},
 		},
 	}
@@ -176,3 +190,11 @@ func resourceArmRouteDelete(d *schema.ResourceData, meta interface{}) error {
 
 	return nil
 }
+
+func validateRouteNextHopInIPAddress(nextHopType string, nextHopInIPAddress string) error {
+	if strings.EqualFold(nextHopType, string(network.RouteNextHopTypeVirtualAppliance)) && nextHopInIPAddress == "" {
+		return fmt.Errorf("`next_hop_in_ip_address` must be specified when `next_hop_type` is `VirtualAppliance`")
+	}
+
+	return nil
+}
@@ -4,11 +4,12 @@ import (
 	"fmt"
 	"log"
 
-	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2018-04-01/network"
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2018-08-01/network"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/helper/validation"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/response"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/suppress"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
@@ -25,6 +26,29 @@ func resourceArmRouteTable() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		CustomizeDiff: func(diff *schema.ResourceDiff, v interface{}) error {
+			routes := diff.Get("route").([]interface{})
+			for i, raw := range routes {
+				if raw == nil {
+					continue
+				}
+
+				// the IP Address may be interpolated from another resource, in which case it's not known until apply
+				if !diff.NewValueKnown(fmt.Sprintf("route.%d.next_hop_in_ip_address", i)) {
+					continue
+				}
+
+				route := raw.(map[string]interface{})
+				nextHopType := route["next_hop_type"].(string)
+				nextHopInIPAddress := route["next_hop_in_ip_address"].(string)
+				if err := validateRouteNextHopInIPAddress(nextHopType, nextHopInIPAddress); err != nil {
+					return fmt.Errorf("Error validating Route %q: %+v", route["name"].(string), err)
+				}
+			}
+
+			return nil
+		},
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:         schema.TypeString,
@@ -72,7 +96,7 @@ func resourceArmRouteTable() *schema.Resource {
 							Type:         schema.TypeString,
 							Optional:     true,
 							Computed:     true,
-							ValidateFunc: validation.NoZeroValues,
+							ValidateFunc: validate.IPv4Address,
 						},
 					},
 				},
@@ -11,6 +11,49 @@ import (
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
+func TestValidateRouteNextHopInIPAddress(t *testing.T) {
+	cases := []struct {
+		NextHopType        string
+		NextHopInIPAddress string
+		ShouldError        bool
+	}{
+		{
+			NextHopType:        "VirtualAppliance",
+			NextHopInIPAddress: "10.10.1.1",
+			ShouldError:        false,
+		},
+		{
+			NextHopType:        "virtualappliance",
+			NextHopInIPAddress: "",
+			ShouldError:        true,
+		},
+		{
+			NextHopType:        "VirtualAppliance",
+			NextHopInIPAddress: "",
+			ShouldError:        true,
+		},
+		{
+			NextHopType:        "VnetLocal",
+			NextHopInIPAddress: "",
+			ShouldError:        false,
+		},
+		{
+			NextHopType:        "Internet",
+			NextHopInIPAddress: "",
+			ShouldError:        false,
+		},
+	}
+
+	for _, tc := range cases {
+		err := validateRouteNextHopInIPAddress(tc.NextHopType, tc.NextHopInIPAddress)
+
+		hasError := err != nil
+		if hasError != tc.ShouldError {
+			t.Fatalf("Expected Next Hop Type %q with IP Address %q to return an error %t but got %+v", tc.NextHopType, tc.NextHopInIPAddress, tc.ShouldError, err)
+		}
+	}
+}
+
 func TestAccAzureRMRoute_basic(t *testing.T) {
 	ri := acctest.RandInt()
 	config := testAccAzureRMRoute_basic(ri, testLocation())
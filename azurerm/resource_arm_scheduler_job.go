@@ -421,6 +421,7 @@ func resourceArmSchedulerJobActionStorageSchema() *schema.Resource {
 
 			"sas_token": {
 				Type:         schema.TypeString,
+				Sensitive:    true,
 				Required:     true,
 				ValidateFunc: validation.NoZeroValues,
 			},
@@ -47,6 +47,15 @@ func testSweepServiceBusNamespace(region string) error {
 		resourceGroup := resourceId.ResourceGroup
 		name := resourceId.Path["namespaces"]
 
+		inUse, err := sweeperResourceGroupInUse(ctx, armClient, resourceGroup)
+		if err != nil {
+			return err
+		}
+
+		if inUse {
+			continue
+		}
+
 		log.Printf("Deleting Servicebus Namespace %q in Resource Group %q", name, resourceGroup)
 		deleteFuture, err := client.Delete(ctx, resourceGroup, name)
 		if err != nil {
@@ -7,6 +7,7 @@ import (
 	"time"
 
 	"github.com/Azure/azure-sdk-for-go/services/preview/sql/mgmt/2015-05-01-preview/sql"
+	sqlBackup "github.com/Azure/azure-sdk-for-go/services/preview/sql/mgmt/2017-10-01-preview/sql"
 	"github.com/Azure/go-autorest/autorest/date"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/helper/validation"
@@ -285,10 +286,48 @@ func resourceArmSqlDatabase() *schema.Resource {
 				},
 			},
 
+			// not supported for DataWarehouse databases
+			"short_term_retention_policy": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"retention_days": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntBetween(7, 35),
+						},
+					},
+				},
+			},
+
+			// only supported for DataWarehouse databases
+			"geo_backup_enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+
 			"tags": tagsSchema(),
 		},
 
 		CustomizeDiff: func(diff *schema.ResourceDiff, v interface{}) error {
+			// the edition may be Computed from the requested Service Objective, in which case it's not known until apply
+			if edition := diff.Get("edition").(string); edition != "" && diff.NewValueKnown("edition") {
+				isDataWarehouse := strings.EqualFold(edition, string(sql.DataWarehouse))
+
+				if isDataWarehouse && diff.HasChange("short_term_retention_policy") {
+					if policies := diff.Get("short_term_retention_policy").([]interface{}); len(policies) > 0 {
+						return fmt.Errorf("`short_term_retention_policy` is not supported for DataWarehouse databases")
+					}
+				}
+
+				if !isDataWarehouse && !diff.Get("geo_backup_enabled").(bool) {
+					return fmt.Errorf("`geo_backup_enabled` can only be disabled for DataWarehouse databases")
+				}
+			}
 
 			threatDetection, hasThreatDetection := diff.GetOk("threat_detection_policy")
 			if hasThreatDetection {
@@ -444,6 +483,26 @@ func resourceArmSqlDatabaseCreateUpdate(d *schema.ResourceData, meta interface{}
 		return fmt.Errorf("Error setting database threat detection policy: %+v", err)
 	}
 
+	if props := resp.DatabaseProperties; props != nil && props.Edition == sql.DataWarehouse {
+		geoBackupClient := meta.(*ArmClient).sqlGeoBackupPoliciesClient
+		geoBackupPolicy := expandArmSqlDatabaseGeoBackupPolicy(d)
+		if _, err = geoBackupClient.CreateOrUpdate(ctx, resourceGroup, serverName, name, geoBackupPolicy); err != nil {
+			return fmt.Errorf("Error setting Geo Backup Policy for SQL Database %q (Server %q / Resource Group %q): %+v", name, serverName, resourceGroup, err)
+		}
+	} else if d.HasChange("short_term_retention_policy") {
+		if shortTermRetentionPolicy := expandArmSqlDatabaseShortTermRetentionPolicy(d); shortTermRetentionPolicy != nil {
+			retentionClient := meta.(*ArmClient).sqlShortTermRetentionPoliciesClient
+			retentionFuture, err := retentionClient.CreateOrUpdate(ctx, resourceGroup, serverName, name, *shortTermRetentionPolicy)
+			if err != nil {
+				return fmt.Errorf("Error setting Short Term Retention Policy for SQL Database %q (Server %q / Resource Group %q): %+v", name, serverName, resourceGroup, err)
+			}
+
+			if err = retentionFuture.WaitForCompletionRef(ctx, retentionClient.Client); err != nil {
+				return fmt.Errorf("Error waiting for Short Term Retention Policy for SQL Database %q (Server %q / Resource Group %q) to be set: %+v", name, serverName, resourceGroup, err)
+			}
+		}
+	}
+
 	return resourceArmSqlDatabaseRead(d, meta)
 }
 
@@ -516,6 +575,38 @@ func resourceArmSqlDatabaseRead(d *schema.ResourceData, meta interface{}) error
 		}
 
 		d.Set("encryption", flattenEncryptionStatus(props.TransparentDataEncryption))
+
+		// these policies are optional extras on top of the database, so failing to retrieve them (e.g. as the
+		// API version isn't available in this region) shouldn't prevent the database itself from being refreshed
+		if props.Edition == sql.DataWarehouse {
+			geoBackupClient := meta.(*ArmClient).sqlGeoBackupPoliciesClient
+			geoBackupPolicy, err := geoBackupClient.Get(ctx, resourceGroup, serverName, name)
+			if err != nil {
+				if !utils.ResponseWasNotFound(geoBackupPolicy.Response) && !utils.ResponseWasBadRequest(geoBackupPolicy.Response) {
+					return fmt.Errorf("Error retrieving Geo Backup Policy for SQL Database %q (Server %q / Resource Group %q): %+v", name, serverName, resourceGroup, err)
+				}
+
+				log.Printf("[WARN] Unable to retrieve Geo Backup Policy for SQL Database %q (Server %q / Resource Group %q) - skipping: %+v", name, serverName, resourceGroup, err)
+			} else {
+				geoBackupEnabled := true
+				if policyProps := geoBackupPolicy.GeoBackupPolicyProperties; policyProps != nil {
+					geoBackupEnabled = policyProps.State == sql.GeoBackupPolicyStateEnabled
+				}
+				d.Set("geo_backup_enabled", geoBackupEnabled)
+			}
+		} else {
+			retentionClient := meta.(*ArmClient).sqlShortTermRetentionPoliciesClient
+			shortTermRetentionPolicy, err := retentionClient.Get(ctx, resourceGroup, serverName, name)
+			if err != nil {
+				if !utils.ResponseWasNotFound(shortTermRetentionPolicy.Response) && !utils.ResponseWasBadRequest(shortTermRetentionPolicy.Response) {
+					return fmt.Errorf("Error retrieving Short Term Retention Policy for SQL Database %q (Server %q / Resource Group %q): %+v", name, serverName, resourceGroup, err)
+				}
+
+				log.Printf("[WARN] Unable to retrieve Short Term Retention Policy for SQL Database %q (Server %q / Resource Group %q) - skipping: %+v", name, serverName, resourceGroup, err)
+			} else if err := d.Set("short_term_retention_policy", flattenArmSqlDatabaseShortTermRetentionPolicy(shortTermRetentionPolicy)); err != nil {
+				return fmt.Errorf("Error setting `short_term_retention_policy`: %+v", err)
+			}
+		}
 	}
 
 	flattenAndSetTags(d, resp.Tags)
@@ -685,3 +776,44 @@ func expandArmSqlServerThreatDetectionPolicy(d *schema.ResourceData, location st
 
 	return &policy, nil
 }
+
+func expandArmSqlDatabaseShortTermRetentionPolicy(d *schema.ResourceData) *sqlBackup.BackupShortTermRetentionPolicy {
+	policies := d.Get("short_term_retention_policy").([]interface{})
+	if len(policies) == 0 || policies[0] == nil {
+		return nil
+	}
+
+	policy := policies[0].(map[string]interface{})
+
+	return &sqlBackup.BackupShortTermRetentionPolicy{
+		BackupShortTermRetentionPolicyProperties: &sqlBackup.BackupShortTermRetentionPolicyProperties{
+			RetentionDays: utils.Int32(int32(policy["retention_days"].(int))),
+		},
+	}
+}
+
+func flattenArmSqlDatabaseShortTermRetentionPolicy(input sqlBackup.BackupShortTermRetentionPolicy) []interface{} {
+	props := input.BackupShortTermRetentionPolicyProperties
+	if props == nil || props.RetentionDays == nil {
+		return []interface{}{}
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"retention_days": int(*props.RetentionDays),
+		},
+	}
+}
+
+func expandArmSqlDatabaseGeoBackupPolicy(d *schema.ResourceData) sql.GeoBackupPolicy {
+	state := sql.GeoBackupPolicyStateDisabled
+	if d.Get("geo_backup_enabled").(bool) {
+		state = sql.GeoBackupPolicyStateEnabled
+	}
+
+	return sql.GeoBackupPolicy{
+		GeoBackupPolicyProperties: &sql.GeoBackupPolicyProperties{
+			State: state,
+		},
+	}
+}
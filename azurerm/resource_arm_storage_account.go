@@ -7,7 +7,7 @@ import (
 	"regexp"
 	"strings"
 
-	"github.com/Azure/azure-sdk-for-go/services/storage/mgmt/2017-10-01/storage"
+	"github.com/Azure/azure-sdk-for-go/services/storage/mgmt/2018-07-01/storage"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/helper/validation"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/response"
@@ -144,6 +144,24 @@ func resourceArmStorageAccount() *schema.Resource {
 				Optional: true,
 			},
 
+			"azure_files_authentication": {
+				Type:     schema.TypeList,
+				MaxItems: 1,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						// only Azure AD Domain Services is supported by the 2018-07-01 API
+						"directory_type": {
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								"AADDS",
+							}, false),
+						},
+					},
+				},
+			},
+
 			"network_rules": {
 				Type:     schema.TypeList,
 				MaxItems: 1,
@@ -361,8 +379,9 @@ func resourceArmStorageAccountCreate(d *schema.ResourceData, meta interface{}) e
 					}},
 				KeySource: storage.KeySource(storageAccountEncryptionSource),
 			},
-			EnableHTTPSTrafficOnly: &enableHTTPSTrafficOnly,
-			NetworkRuleSet:         networkRules,
+			EnableHTTPSTrafficOnly:         &enableHTTPSTrafficOnly,
+			NetworkRuleSet:                 networkRules,
+			EnableAzureFilesAadIntegration: expandStorageAccountAzureFilesAuthentication(d),
 		},
 	}
 
@@ -558,6 +577,20 @@ func resourceArmStorageAccountUpdate(d *schema.ResourceData, meta interface{}) e
 		d.SetPartial("enable_https_traffic_only")
 	}
 
+	if d.HasChange("azure_files_authentication") {
+		opts := storage.AccountUpdateParameters{
+			AccountPropertiesUpdateParameters: &storage.AccountPropertiesUpdateParameters{
+				EnableAzureFilesAadIntegration: expandStorageAccountAzureFilesAuthentication(d),
+			},
+		}
+		_, err := client.Update(ctx, resourceGroupName, storageAccountName, opts)
+		if err != nil {
+			return fmt.Errorf("Error updating Azure Storage Account azure_files_authentication %q: %+v", storageAccountName, err)
+		}
+
+		d.SetPartial("azure_files_authentication")
+	}
+
 	if d.HasChange("identity") {
 		storageAccountIdentity := expandAzureRmStorageAccountIdentity(d)
 
@@ -634,6 +667,10 @@ func resourceArmStorageAccountRead(d *schema.ResourceData, meta interface{}) err
 		d.Set("access_tier", props.AccessTier)
 		d.Set("enable_https_traffic_only", props.EnableHTTPSTrafficOnly)
 
+		if err := d.Set("azure_files_authentication", flattenStorageAccountAzureFilesAuthentication(props.EnableAzureFilesAadIntegration)); err != nil {
+			return fmt.Errorf("Error flattening `azure_files_authentication`: %+v", err)
+		}
+
 		if customDomain := props.CustomDomain; customDomain != nil {
 			if err := d.Set("custom_domain", flattenStorageAccountCustomDomain(customDomain)); err != nil {
 				return fmt.Errorf("Error flattening `custom_domain`: %+v", err)
@@ -805,6 +842,23 @@ func flattenStorageAccountCustomDomain(input *storage.CustomDomain) []interface{
 	return []interface{}{domain}
 }
 
+func expandStorageAccountAzureFilesAuthentication(d *schema.ResourceData) *bool {
+	authentications := d.Get("azure_files_authentication").([]interface{})
+	return utils.Bool(len(authentications) > 0)
+}
+
+func flattenStorageAccountAzureFilesAuthentication(input *bool) []interface{} {
+	if input == nil || !*input {
+		return []interface{}{}
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"directory_type": "AADDS",
+		},
+	}
+}
+
 func expandStorageAccountNetworkRules(d *schema.ResourceData) *storage.NetworkRuleSet {
 	networkRules := d.Get("network_rules").([]interface{})
 	if len(networkRules) == 0 {
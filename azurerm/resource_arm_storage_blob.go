@@ -2,8 +2,10 @@ package azurerm
 
 import (
 	"bytes"
+	"crypto/md5"
 	"crypto/rand"
 	"encoding/base64"
+	"encoding/hex"
 	"fmt"
 	"io"
 	"log"
@@ -13,6 +15,7 @@ import (
 	"strings"
 	"sync"
 
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 
 	"github.com/Azure/azure-sdk-for-go/storage"
@@ -20,6 +23,17 @@ import (
 	"github.com/hashicorp/terraform/helper/schema"
 )
 
+const (
+	// the default size of each block when uploading a Block Blob from a `source` file
+	storageBlobDefaultBlockSize = 4 * 1024 * 1024
+
+	// the maximum size of a single block which can be uploaded (API Version 2016-05-31)
+	storageBlobMaxBlockSize = 100 * 1024 * 1024
+
+	// the maximum number of blocks which can be committed to a single Block Blob
+	storageBlobMaxBlockCount = 50000
+)
+
 func resourceArmStorageBlob() *schema.Resource {
 	return &schema.Resource{
 		Create:        resourceArmStorageBlobCreate,
@@ -72,13 +86,33 @@ func resourceArmStorageBlob() *schema.Resource {
 				Type:          schema.TypeString,
 				Optional:      true,
 				ForceNew:      true,
-				ConflictsWith: []string{"source_uri"},
+				ConflictsWith: []string{"source_uri", "source_content"},
+			},
+			"source_content": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"source", "source_uri"},
 			},
 			"source_uri": {
 				Type:          schema.TypeString,
 				Optional:      true,
 				ForceNew:      true,
-				ConflictsWith: []string{"source"},
+				ConflictsWith: []string{"source", "source_content"},
+			},
+			"content_md5": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"source_uri"},
+				ValidateFunc:  validateArmStorageBlobContentMD5,
+			},
+			"metadata": {
+				Type:         schema.TypeMap,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validate.StorageMetaData,
 			},
 			"url": {
 				Type:     schema.TypeString,
@@ -98,6 +132,13 @@ func resourceArmStorageBlob() *schema.Resource {
 				ForceNew:     true,
 				ValidateFunc: validateArmStorageBlobAttempts,
 			},
+			"block_size": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      storageBlobDefaultBlockSize,
+				ForceNew:     true,
+				ValidateFunc: validateArmStorageBlobBlockSize,
+			},
 		},
 	}
 }
@@ -106,7 +147,7 @@ func validateArmStorageBlobParallelism(v interface{}, _ string) (ws []string, er
 	value := v.(int)
 
 	if value <= 0 {
-		errors = append(errors, fmt.Errorf("Blob Parallelism %q is invalid, must be greater than 0", value))
+		errors = append(errors, fmt.Errorf("Blob Parallelism %d is invalid, must be greater than 0", value))
 	}
 
 	return ws, errors
@@ -116,7 +157,7 @@ func validateArmStorageBlobAttempts(v interface{}, _ string) (ws []string, error
 	value := v.(int)
 
 	if value <= 0 {
-		errors = append(errors, fmt.Errorf("Blob Attempts %q is invalid, must be greater than 0", value))
+		errors = append(errors, fmt.Errorf("Blob Attempts %d is invalid, must be greater than 0", value))
 	}
 
 	return ws, errors
@@ -126,7 +167,27 @@ func validateArmStorageBlobSize(v interface{}, _ string) (ws []string, errors []
 	value := v.(int)
 
 	if value%512 != 0 {
-		errors = append(errors, fmt.Errorf("Blob Size %q is invalid, must be a multiple of 512", value))
+		errors = append(errors, fmt.Errorf("Blob Size %d is invalid, must be a multiple of 512", value))
+	}
+
+	return ws, errors
+}
+
+func validateArmStorageBlobBlockSize(v interface{}, _ string) (ws []string, errors []error) {
+	value := v.(int)
+
+	if value <= 0 || value > storageBlobMaxBlockSize {
+		errors = append(errors, fmt.Errorf("Blob Block Size %d is invalid, must be between 1 and %d bytes", value, storageBlobMaxBlockSize))
+	}
+
+	return ws, errors
+}
+
+func validateArmStorageBlobContentMD5(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+
+	if _, err := expandArmStorageBlobContentMD5(value); err != nil {
+		errors = append(errors, fmt.Errorf("%q must be a hex-encoded MD5 hash (such as the output of the `md5` function): %s", k, err))
 	}
 
 	return ws, errors
@@ -166,6 +227,12 @@ func resourceArmStorageBlobCreate(d *schema.ResourceData, meta interface{}) erro
 	containerName := d.Get("storage_container_name").(string)
 	sourceUri := d.Get("source_uri").(string)
 	contentType := d.Get("content_type").(string)
+	metaData := expandStorageBlobMetaData(d.Get("metadata").(map[string]interface{}))
+
+	contentMD5, err := expandArmStorageBlobContentMD5(d.Get("content_md5").(string))
+	if err != nil {
+		return err
+	}
 
 	log.Printf("[INFO] Creating blob %q in container %q within storage account %q", name, containerName, storageAccountName)
 	container := blobClient.GetContainerReference(containerName)
@@ -178,30 +245,43 @@ func resourceArmStorageBlobCreate(d *schema.ResourceData, meta interface{}) erro
 			return fmt.Errorf("Error creating storage blob on Azure: %s", err)
 		}
 	} else {
+		source := d.Get("source").(string)
+		sourceContent := d.Get("source_content").(string)
+
 		switch strings.ToLower(blobType) {
 		case "block":
+			if sourceContent != "" {
+				if err := resourceArmStorageBlobBlockUploadFromContent(blob, sourceContent, contentType, contentMD5); err != nil {
+					return fmt.Errorf("Error creating storage blob on Azure: %s", err)
+				}
+				break
+			}
+
 			options := &storage.PutBlobOptions{}
 			err := blob.CreateBlockBlob(options)
 			if err != nil {
 				return fmt.Errorf("Error creating storage blob on Azure: %s", err)
 			}
 
-			source := d.Get("source").(string)
 			if source != "" {
 				parallelism := d.Get("parallelism").(int)
 				attempts := d.Get("attempts").(int)
+				blockSize := int64(d.Get("block_size").(int))
 
-				if err := resourceArmStorageBlobBlockUploadFromSource(containerName, name, source, contentType, blobClient, parallelism, attempts); err != nil {
+				if err := resourceArmStorageBlobBlockUploadFromSource(containerName, name, source, contentType, contentMD5, blobClient, parallelism, attempts, blockSize); err != nil {
 					return fmt.Errorf("Error creating storage blob on Azure: %s", err)
 				}
 			}
 		case "page":
-			source := d.Get("source").(string)
+			if sourceContent != "" {
+				return fmt.Errorf("`source_content` can only be specified for `block` blobs")
+			}
+
 			if source != "" {
 				parallelism := d.Get("parallelism").(int)
 				attempts := d.Get("attempts").(int)
 
-				if err := resourceArmStorageBlobPageUploadFromSource(containerName, name, source, contentType, blobClient, parallelism, attempts); err != nil {
+				if err := resourceArmStorageBlobPageUploadFromSource(containerName, name, source, contentType, contentMD5, blobClient, parallelism, attempts); err != nil {
 					return fmt.Errorf("Error creating storage blob on Azure: %s", err)
 				}
 			} else {
@@ -210,6 +290,7 @@ func resourceArmStorageBlobCreate(d *schema.ResourceData, meta interface{}) erro
 
 				blob.Properties.ContentLength = size
 				blob.Properties.ContentType = contentType
+				blob.Properties.ContentMD5 = contentMD5
 				err := blob.PutPageBlob(options)
 				if err != nil {
 					return fmt.Errorf("Error creating storage blob on Azure: %s", err)
@@ -218,6 +299,13 @@ func resourceArmStorageBlobCreate(d *schema.ResourceData, meta interface{}) erro
 		}
 	}
 
+	if len(metaData) > 0 {
+		blob.Metadata = metaData
+		if err := blob.SetMetadata(&storage.SetBlobMetadataOptions{}); err != nil {
+			return fmt.Errorf("Error setting MetaData for storage blob %q (container %q / storage account %q): %s", name, containerName, storageAccountName, err)
+		}
+	}
+
 	// gives us https://example.blob.core.windows.net/container/file.vhd
 	id := fmt.Sprintf("https://%s.blob.%s/%s/%s", storageAccountName, env.StorageEndpointSuffix, containerName, name)
 	d.SetId(id)
@@ -229,7 +317,7 @@ type resourceArmStorageBlobPage struct {
 	section *io.SectionReader
 }
 
-func resourceArmStorageBlobPageUploadFromSource(container, name, source, contentType string, client *storage.BlobStorageClient, parallelism, attempts int) error {
+func resourceArmStorageBlobPageUploadFromSource(container, name, source, contentType, contentMD5 string, client *storage.BlobStorageClient, parallelism, attempts int) error {
 	workerCount := parallelism * runtime.NumCPU()
 
 	file, err := os.Open(source)
@@ -248,6 +336,7 @@ func resourceArmStorageBlobPageUploadFromSource(container, name, source, content
 	blob := containerRef.GetBlobReference(name)
 	blob.Properties.ContentLength = blobSize
 	blob.Properties.ContentType = contentType
+	blob.Properties.ContentMD5 = contentMD5
 	err = blob.PutPageBlob(options)
 	if err != nil {
 		return fmt.Errorf("Error creating storage blob on Azure: %s", err)
@@ -407,7 +496,24 @@ type resourceArmStorageBlobBlock struct {
 	id      string
 }
 
-func resourceArmStorageBlobBlockUploadFromSource(container, name, source, contentType string, client *storage.BlobStorageClient, parallelism, attempts int) error {
+func resourceArmStorageBlobBlockUploadFromContent(blob *storage.Blob, content, contentType, contentMD5 string) error {
+	if contentMD5 == "" {
+		hash := md5.Sum([]byte(content))
+		contentMD5 = base64.StdEncoding.EncodeToString(hash[:])
+	}
+
+	blob.Properties.ContentType = contentType
+	blob.Properties.ContentMD5 = contentMD5
+
+	options := &storage.PutBlobOptions{}
+	if err := blob.CreateBlockBlobFromReader(strings.NewReader(content), options); err != nil {
+		return fmt.Errorf("Error uploading content: %s", err)
+	}
+
+	return nil
+}
+
+func resourceArmStorageBlobBlockUploadFromSource(container, name, source, contentType, contentMD5 string, client *storage.BlobStorageClient, parallelism, attempts int, blockSize int64) error {
 	workerCount := parallelism * runtime.NumCPU()
 
 	file, err := os.Open(source)
@@ -416,11 +522,19 @@ func resourceArmStorageBlobBlockUploadFromSource(container, name, source, conten
 	}
 	defer utils.IoCloseAndLogError(file, fmt.Sprintf("Error closing Storage Blob `%s` file `%s` after upload", name, source))
 
-	blockList, parts, err := resourceArmStorageBlobBlockSplit(file)
+	blockList, parts, err := resourceArmStorageBlobBlockSplit(file, blockSize)
 	if err != nil {
 		return fmt.Errorf("Error reading and splitting source file for upload %q: %s", source, err)
 	}
 
+	if contentMD5 == "" {
+		hash := md5.New()
+		if _, err := io.Copy(hash, file); err != nil {
+			return fmt.Errorf("Error computing the MD5 hash of source file %q: %s", source, err)
+		}
+		contentMD5 = base64.StdEncoding.EncodeToString(hash.Sum(nil))
+	}
+
 	wg := &sync.WaitGroup{}
 	blocks := make(chan resourceArmStorageBlobBlock, len(parts))
 	errors := make(chan error, len(parts))
@@ -453,6 +567,7 @@ func resourceArmStorageBlobBlockUploadFromSource(container, name, source, conten
 	containerReference := client.GetContainerReference(container)
 	blobReference := containerReference.GetBlobReference(name)
 	blobReference.Properties.ContentType = contentType
+	blobReference.Properties.ContentMD5 = contentMD5
 	options := &storage.PutBlockListOptions{}
 	err = blobReference.PutBlockList(blockList, options)
 	if err != nil {
@@ -462,11 +577,8 @@ func resourceArmStorageBlobBlockUploadFromSource(container, name, source, conten
 	return nil
 }
 
-func resourceArmStorageBlobBlockSplit(file *os.File) ([]storage.Block, []resourceArmStorageBlobBlock, error) {
-	const (
-		idSize          = 64
-		blockSize int64 = 4 * 1024 * 1024
-	)
+func resourceArmStorageBlobBlockSplit(file *os.File, blockSize int64) ([]storage.Block, []resourceArmStorageBlobBlock, error) {
+	const idSize = 64
 	var parts []resourceArmStorageBlobBlock
 	var blockList []storage.Block
 
@@ -475,6 +587,10 @@ func resourceArmStorageBlobBlockSplit(file *os.File) ([]storage.Block, []resourc
 		return nil, nil, fmt.Errorf("Error stating source file %q: %s", file.Name(), err)
 	}
 
+	if blockCount := (info.Size() + blockSize - 1) / blockSize; blockCount > storageBlobMaxBlockCount {
+		return nil, nil, fmt.Errorf("Source file %q would be split into %d blocks, however a Blob can contain at most %d blocks - increase `block_size` to upload this file", file.Name(), blockCount, storageBlobMaxBlockCount)
+	}
+
 	for i := int64(0); i < info.Size(); i = i + blockSize {
 		entropy := make([]byte, idSize)
 		_, err = rand.Read(entropy)
@@ -575,16 +691,31 @@ func resourceArmStorageBlobUpdate(d *schema.ResourceData, meta interface{}) erro
 	blob := container.GetBlobReference(id.blobName)
 
 	if d.HasChange("content_type") {
+		// Set Blob Properties clears any properties which aren't specified, so we need to retrieve the existing ones
+		// first to ensure the Content MD5 is retained
+		if err := blob.GetProperties(&storage.GetBlobPropertiesOptions{}); err != nil {
+			return fmt.Errorf("Error getting properties of blob %s (container %s, storage account %s): %+v", id.blobName, id.containerName, id.storageAccountName, err)
+		}
+
 		blob.Properties.ContentType = d.Get("content_type").(string)
+
+		options := &storage.SetBlobPropertiesOptions{}
+		err = blob.SetProperties(options)
+		if err != nil {
+			return fmt.Errorf("Error setting properties of blob %s (container %s, storage account %s): %+v", id.blobName, id.containerName, id.storageAccountName, err)
+		}
 	}
 
-	options := &storage.SetBlobPropertiesOptions{}
-	err = blob.SetProperties(options)
-	if err != nil {
-		return fmt.Errorf("Error setting properties of blob %s (container %s, storage account %s): %+v", id.blobName, id.containerName, id.storageAccountName, err)
+	if d.HasChange("metadata") {
+		blob.Metadata = expandStorageBlobMetaData(d.Get("metadata").(map[string]interface{}))
+
+		options := &storage.SetBlobMetadataOptions{}
+		if err := blob.SetMetadata(options); err != nil {
+			return fmt.Errorf("Error setting MetaData of blob %s (container %s, storage account %s): %+v", id.blobName, id.containerName, id.storageAccountName, err)
+		}
 	}
 
-	return nil
+	return resourceArmStorageBlobRead(d, meta)
 }
 
 func resourceArmStorageBlobRead(d *schema.ResourceData, meta interface{}) error {
@@ -641,6 +772,7 @@ func resourceArmStorageBlobRead(d *schema.ResourceData, meta interface{}) error
 	d.Set("resource_group_name", resourceGroup)
 
 	d.Set("content_type", blob.Properties.ContentType)
+	d.Set("content_md5", flattenArmStorageBlobContentMD5(blob.Properties.ContentMD5))
 
 	d.Set("source_uri", blob.Properties.CopySource)
 
@@ -653,6 +785,14 @@ func resourceArmStorageBlobRead(d *schema.ResourceData, meta interface{}) error
 	}
 	d.Set("url", url)
 
+	if err := blob.GetMetadata(&storage.GetBlobMetadataOptions{}); err != nil {
+		return fmt.Errorf("Error getting MetaData of blob %s (container %s, storage account %s): %+v", id.blobName, id.containerName, id.storageAccountName, err)
+	}
+
+	if err := d.Set("metadata", flattenStorageBlobMetaData(blob.Metadata)); err != nil {
+		return fmt.Errorf("Error setting `metadata`: %+v", err)
+	}
+
 	return nil
 }
 
@@ -695,6 +835,55 @@ func resourceArmStorageBlobDelete(d *schema.ResourceData, meta interface{}) erro
 	return nil
 }
 
+// expandArmStorageBlobContentMD5 converts a hex-encoded MD5 hash (as returned by Terraform's `md5` function)
+// into the base64-encoded form used by the Storage API
+func expandArmStorageBlobContentMD5(input string) (string, error) {
+	if input == "" {
+		return "", nil
+	}
+
+	hash, err := hex.DecodeString(input)
+	if err != nil {
+		return "", err
+	}
+
+	if len(hash) != md5.Size {
+		return "", fmt.Errorf("expected a %d byte hash but got %d bytes", md5.Size, len(hash))
+	}
+
+	return base64.StdEncoding.EncodeToString(hash), nil
+}
+
+func flattenArmStorageBlobContentMD5(input string) string {
+	hash, err := base64.StdEncoding.DecodeString(input)
+	if err != nil {
+		log.Printf("[DEBUG] Unable to decode Content MD5 %q: %s", input, err)
+		return ""
+	}
+
+	return hex.EncodeToString(hash)
+}
+
+func expandStorageBlobMetaData(input map[string]interface{}) storage.BlobMetadata {
+	output := make(storage.BlobMetadata)
+
+	for k, v := range input {
+		output[k] = v.(string)
+	}
+
+	return output
+}
+
+func flattenStorageBlobMetaData(input storage.BlobMetadata) map[string]interface{} {
+	output := make(map[string]interface{})
+
+	for k, v := range input {
+		output[k] = v
+	}
+
+	return output
+}
+
 type storageBlobId struct {
 	storageAccountName string
 	containerName      string
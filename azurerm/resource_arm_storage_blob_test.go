@@ -143,6 +143,91 @@ func TestResourceAzureRMStorageBlobAttempts_validation(t *testing.T) {
 	}
 }
 
+func TestResourceAzureRMStorageBlobBlockSize_validation(t *testing.T) {
+	cases := []struct {
+		Value    int
+		ErrCount int
+	}{
+		{
+			Value:    0,
+			ErrCount: 1,
+		},
+		{
+			Value:    1,
+			ErrCount: 0,
+		},
+		{
+			Value:    4 * 1024 * 1024,
+			ErrCount: 0,
+		},
+		{
+			Value:    100 * 1024 * 1024,
+			ErrCount: 0,
+		},
+		{
+			Value:    100*1024*1024 + 1,
+			ErrCount: 1,
+		},
+	}
+
+	for _, tc := range cases {
+		_, errors := validateArmStorageBlobBlockSize(tc.Value, "azurerm_storage_blob")
+
+		if len(errors) != tc.ErrCount {
+			t.Fatalf("Expected the Azure RM Storage Blob block size to trigger a validation error")
+		}
+	}
+}
+
+func TestResourceAzureRMStorageBlobContentMD5_validation(t *testing.T) {
+	cases := []struct {
+		Value    string
+		ErrCount int
+	}{
+		{
+			Value:    "",
+			ErrCount: 0,
+		},
+		{
+			Value:    "5eb63bbbe01eeed093cb22bb8f5acdc3",
+			ErrCount: 0,
+		},
+		{
+			Value:    "5eb63bbbe01eeed093cb22bb8f5acd",
+			ErrCount: 1,
+		},
+		{
+			Value:    "XrY7u+Ae7tCTyyK7j1rNww==",
+			ErrCount: 1,
+		},
+	}
+
+	for _, tc := range cases {
+		_, errors := validateArmStorageBlobContentMD5(tc.Value, "content_md5")
+
+		if len(errors) != tc.ErrCount {
+			t.Fatalf("Expected the Azure RM Storage Blob Content MD5 %q to trigger %d validation errors but got %d", tc.Value, tc.ErrCount, len(errors))
+		}
+	}
+}
+
+func TestResourceAzureRMStorageBlobContentMD5_roundTrip(t *testing.T) {
+	input := "5eb63bbbe01eeed093cb22bb8f5acdc3"
+
+	expanded, err := expandArmStorageBlobContentMD5(input)
+	if err != nil {
+		t.Fatalf("Expected no error but got: %s", err)
+	}
+
+	if expanded != "XrY7u+Ae7tCTyyK7j1rNww==" {
+		t.Fatalf("Expected the expanded Content MD5 to be %q but got %q", "XrY7u+Ae7tCTyyK7j1rNww==", expanded)
+	}
+
+	if flattened := flattenArmStorageBlobContentMD5(expanded); flattened != input {
+		t.Fatalf("Expected the flattened Content MD5 to be %q but got %q", input, flattened)
+	}
+}
+
 func TestAccAzureRMStorageBlob_basic(t *testing.T) {
 	resourceName := "azurerm_storage_blob.test"
 	ri := acctest.RandInt()
@@ -375,6 +460,67 @@ func TestAccAzureRMStorageBlobBlock_blockContentType(t *testing.T) {
 	})
 }
 
+func TestAccAzureRMStorageBlobBlock_sourceContent(t *testing.T) {
+	resourceName := "azurerm_storage_blob.test"
+	ri := acctest.RandInt()
+	rs := strings.ToLower(acctest.RandString(11))
+	config := testAccAzureRMStorageBlobBlock_sourceContent(ri, rs, testLocation())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMStorageBlobDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMStorageBlobExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "content_type", "text/plain"),
+					resource.TestCheckResourceAttr(resourceName, "content_md5", "5eb63bbbe01eeed093cb22bb8f5acdc3"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"attempts", "block_size", "parallelism", "size", "source_content"},
+			},
+		},
+	})
+}
+
+func TestAccAzureRMStorageBlob_metaData(t *testing.T) {
+	resourceName := "azurerm_storage_blob.test"
+	ri := acctest.RandInt()
+	rs := strings.ToLower(acctest.RandString(11))
+	location := testLocation()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMStorageBlobDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMStorageBlob_metaData(ri, rs, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMStorageBlobExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "metadata.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "metadata.hello", "world"),
+				),
+			},
+			{
+				Config: testAccAzureRMStorageBlob_metaDataUpdated(ri, rs, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMStorageBlobExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "metadata.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "metadata.hello", "world"),
+					resource.TestCheckResourceAttr(resourceName, "metadata.rick", "morty"),
+				),
+			},
+		},
+	})
+}
+
 func testCheckAzureRMStorageBlobExists(name string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 
@@ -766,3 +912,95 @@ resource "azurerm_storage_blob" "source" {
 }
 `, rInt, location, rString, sourceBlobName, contentType)
 }
+
+func testAccAzureRMStorageBlob_template(rInt int, rString string, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+    name = "acctestRG-%d"
+    location = "%s"
+}
+
+resource "azurerm_storage_account" "test" {
+    name                     = "acctestacc%s"
+    resource_group_name      = "${azurerm_resource_group.test.name}"
+    location                 = "${azurerm_resource_group.test.location}"
+    account_tier             = "Standard"
+    account_replication_type = "LRS"
+
+    tags {
+        environment = "staging"
+    }
+}
+
+resource "azurerm_storage_container" "test" {
+    name = "blobs"
+    resource_group_name = "${azurerm_resource_group.test.name}"
+    storage_account_name = "${azurerm_storage_account.test.name}"
+    container_access_type = "private"
+}
+`, rInt, location, rString)
+}
+
+func testAccAzureRMStorageBlobBlock_sourceContent(rInt int, rString string, location string) string {
+	template := testAccAzureRMStorageBlob_template(rInt, rString, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_storage_blob" "test" {
+    name = "example.txt"
+
+    resource_group_name = "${azurerm_resource_group.test.name}"
+    storage_account_name = "${azurerm_storage_account.test.name}"
+    storage_container_name = "${azurerm_storage_container.test.name}"
+
+    type = "block"
+    source_content = "hello world"
+    content_type = "text/plain"
+}
+`, template)
+}
+
+func testAccAzureRMStorageBlob_metaData(rInt int, rString string, location string) string {
+	template := testAccAzureRMStorageBlob_template(rInt, rString, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_storage_blob" "test" {
+    name = "herpderp1.vhd"
+
+    resource_group_name = "${azurerm_resource_group.test.name}"
+    storage_account_name = "${azurerm_storage_account.test.name}"
+    storage_container_name = "${azurerm_storage_container.test.name}"
+
+    type = "page"
+    size = 5120
+
+    metadata {
+        hello = "world"
+    }
+}
+`, template)
+}
+
+func testAccAzureRMStorageBlob_metaDataUpdated(rInt int, rString string, location string) string {
+	template := testAccAzureRMStorageBlob_template(rInt, rString, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_storage_blob" "test" {
+    name = "herpderp1.vhd"
+
+    resource_group_name = "${azurerm_resource_group.test.name}"
+    storage_account_name = "${azurerm_storage_account.test.name}"
+    storage_container_name = "${azurerm_storage_container.test.name}"
+
+    type = "page"
+    size = 5120
+
+    metadata {
+        hello = "world"
+        rick  = "morty"
+    }
+}
+`, template)
+}
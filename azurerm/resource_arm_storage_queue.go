@@ -9,12 +9,14 @@ import (
 
 	"github.com/Azure/azure-sdk-for-go/storage"
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
 )
 
 func resourceArmStorageQueue() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceArmStorageQueueCreate,
 		Read:   resourceArmStorageQueueRead,
+		Update: resourceArmStorageQueueUpdate,
 		Delete: resourceArmStorageQueueDelete,
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
@@ -35,6 +37,11 @@ func resourceArmStorageQueue() *schema.Resource {
 				Required: true,
 				ForceNew: true,
 			},
+			"metadata": {
+				Type:         schema.TypeMap,
+				Optional:     true,
+				ValidateFunc: validate.StorageMetaData,
+			},
 		},
 	}
 }
@@ -76,6 +83,7 @@ func resourceArmStorageQueueCreate(d *schema.ResourceData, meta interface{}) err
 	name := d.Get("name").(string)
 	resourceGroupName := d.Get("resource_group_name").(string)
 	storageAccountName := d.Get("storage_account_name").(string)
+	metaData := d.Get("metadata").(map[string]interface{})
 
 	queueClient, accountExists, err := armClient.getQueueServiceClientForStorageAccount(ctx, resourceGroupName, storageAccountName)
 	if err != nil {
@@ -87,6 +95,7 @@ func resourceArmStorageQueueCreate(d *schema.ResourceData, meta interface{}) err
 
 	log.Printf("[INFO] Creating queue %q in storage account %q", name, storageAccountName)
 	queueReference := queueClient.GetQueueReference(name)
+	queueReference.Metadata = expandStorageQueueMetaData(metaData)
 	options := &storage.QueueServiceOptions{}
 	err = queueReference.Create(options)
 	if err != nil {
@@ -145,9 +154,49 @@ func resourceArmStorageQueueRead(d *schema.ResourceData, meta interface{}) error
 	d.Set("storage_account_name", id.storageAccountName)
 	d.Set("resource_group_name", *resourceGroup)
 
+	if err := queueReference.GetMetadata(&storage.QueueServiceOptions{}); err != nil {
+		return fmt.Errorf("Error retrieving MetaData for storage queue %q: %s", id.queueName, err)
+	}
+
+	if err := d.Set("metadata", flattenStorageQueueMetaData(queueReference.Metadata)); err != nil {
+		return fmt.Errorf("Error setting `metadata`: %+v", err)
+	}
+
 	return nil
 }
 
+func resourceArmStorageQueueUpdate(d *schema.ResourceData, meta interface{}) error {
+	armClient := meta.(*ArmClient)
+	ctx := armClient.StopContext
+
+	id, err := parseStorageQueueID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resourceGroupName := d.Get("resource_group_name").(string)
+	queueClient, accountExists, err := armClient.getQueueServiceClientForStorageAccount(ctx, resourceGroupName, id.storageAccountName)
+	if err != nil {
+		return err
+	}
+	if !accountExists {
+		return fmt.Errorf("Storage Account %q Not Found", id.storageAccountName)
+	}
+
+	if d.HasChange("metadata") {
+		metaData := d.Get("metadata").(map[string]interface{})
+
+		log.Printf("[INFO] Updating MetaData for storage queue %q", id.queueName)
+		queueReference := queueClient.GetQueueReference(id.queueName)
+		queueReference.Metadata = expandStorageQueueMetaData(metaData)
+		if err := queueReference.SetMetadata(&storage.QueueServiceOptions{}); err != nil {
+			return fmt.Errorf("Error updating MetaData for storage queue %q: %s", id.queueName, err)
+		}
+	}
+
+	return resourceArmStorageQueueRead(d, meta)
+}
+
 func resourceArmStorageQueueDelete(d *schema.ResourceData, meta interface{}) error {
 	armClient := meta.(*ArmClient)
 	ctx := armClient.StopContext
@@ -186,6 +235,26 @@ func resourceArmStorageQueueDelete(d *schema.ResourceData, meta interface{}) err
 	return nil
 }
 
+func expandStorageQueueMetaData(input map[string]interface{}) map[string]string {
+	output := make(map[string]string)
+
+	for k, v := range input {
+		output[k] = v.(string)
+	}
+
+	return output
+}
+
+func flattenStorageQueueMetaData(input map[string]string) map[string]interface{} {
+	output := make(map[string]interface{})
+
+	for k, v := range input {
+		output[k] = v
+	}
+
+	return output
+}
+
 type storageQueueId struct {
 	storageAccountName string
 	queueName          string
@@ -0,0 +1,271 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+	"net/url"
+	"regexp"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/storage"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceArmStorageTableEntity() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmStorageTableEntityCreateUpdate,
+		Read:   resourceArmStorageTableEntityRead,
+		Update: resourceArmStorageTableEntityCreateUpdate,
+		Delete: resourceArmStorageTableEntityDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"table_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateArmStorageTableName,
+			},
+
+			"resource_group_name": resourceGroupNameSchema(),
+
+			"storage_account_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"partition_key": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateArmStorageTableEntityKey,
+			},
+
+			"row_key": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateArmStorageTableEntityKey,
+			},
+
+			"entity": {
+				Type:     schema.TypeMap,
+				Required: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.NoZeroValues,
+				},
+			},
+		},
+	}
+}
+
+func validateArmStorageTableEntityKey(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+
+	if len(value) > 1024 {
+		errors = append(errors, fmt.Errorf("%q cannot be longer than 1024 characters", k))
+	}
+
+	if regexp.MustCompile(`[/\\#?\x00-\x1F\x7F-\x9F]`).MatchString(value) {
+		errors = append(errors, fmt.Errorf("%q cannot contain forward/back slashes, `#`, `?` or control characters", k))
+	}
+
+	return ws, errors
+}
+
+func resourceArmStorageTableEntityCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	armClient := meta.(*ArmClient)
+	ctx := armClient.StopContext
+	environment := armClient.environment
+
+	tableName := d.Get("table_name").(string)
+	resourceGroupName := d.Get("resource_group_name").(string)
+	storageAccountName := d.Get("storage_account_name").(string)
+	partitionKey := d.Get("partition_key").(string)
+	rowKey := d.Get("row_key").(string)
+	entity := d.Get("entity").(map[string]interface{})
+
+	tableClient, accountExists, err := armClient.getTableServiceClientForStorageAccount(ctx, resourceGroupName, storageAccountName)
+	if err != nil {
+		return err
+	}
+	if !accountExists {
+		return fmt.Errorf("Storage Account %q Not Found", storageAccountName)
+	}
+
+	table := tableClient.GetTableReference(tableName)
+	tableEntity := table.GetEntityReference(partitionKey, rowKey)
+
+	tableEntity.Properties = entity
+
+	log.Printf("[INFO] Upserting Entity (Partition Key %q / Row Key %q) in Table %q (Storage Account %q)", partitionKey, rowKey, tableName, storageAccountName)
+	if err := tableEntity.InsertOrReplace(&storage.EntityOptions{}); err != nil {
+		return fmt.Errorf("Error upserting Entity (Partition Key %q / Row Key %q) in Table %q (Storage Account %q): %s", partitionKey, rowKey, tableName, storageAccountName, err)
+	}
+
+	id := fmt.Sprintf("https://%s.table.%s/%s(PartitionKey='%s',RowKey='%s')", storageAccountName, environment.StorageEndpointSuffix, tableName, partitionKey, rowKey)
+	d.SetId(id)
+
+	return resourceArmStorageTableEntityRead(d, meta)
+}
+
+func resourceArmStorageTableEntityRead(d *schema.ResourceData, meta interface{}) error {
+	armClient := meta.(*ArmClient)
+	ctx := armClient.StopContext
+
+	id, err := parseStorageTableEntityID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resourceGroup, err := determineResourceGroupForStorageAccount(id.storageAccountName, armClient)
+	if err != nil {
+		return err
+	}
+
+	if resourceGroup == nil {
+		log.Printf("[WARN] Unable to determine Resource Group for Storage Account %q (assuming removed) - removing from state", id.storageAccountName)
+		d.SetId("")
+		return nil
+	}
+
+	tableClient, accountExists, err := armClient.getTableServiceClientForStorageAccount(ctx, *resourceGroup, id.storageAccountName)
+	if err != nil {
+		return err
+	}
+	if !accountExists {
+		log.Printf("[DEBUG] Storage Account %q not found, removing Entity from state", id.storageAccountName)
+		d.SetId("")
+		return nil
+	}
+
+	table := tableClient.GetTableReference(id.tableName)
+	tableEntity := table.GetEntityReference(id.partitionKey, id.rowKey)
+
+	timeout := uint(60)
+	if err := tableEntity.Get(timeout, storage.MinimalMetadata, &storage.GetEntityOptions{}); err != nil {
+		if storageTableEntityWasNotFound(err) {
+			log.Printf("[INFO] Entity (Partition Key %q / Row Key %q) was not found in Table %q - removing from state", id.partitionKey, id.rowKey, id.tableName)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("Error retrieving Entity (Partition Key %q / Row Key %q) in Table %q (Storage Account %q): %s", id.partitionKey, id.rowKey, id.tableName, id.storageAccountName, err)
+	}
+
+	d.Set("table_name", id.tableName)
+	d.Set("resource_group_name", *resourceGroup)
+	d.Set("storage_account_name", id.storageAccountName)
+	d.Set("partition_key", id.partitionKey)
+	d.Set("row_key", id.rowKey)
+
+	if err := d.Set("entity", flattenStorageTableEntityProperties(tableEntity.Properties)); err != nil {
+		return fmt.Errorf("Error setting `entity`: %+v", err)
+	}
+
+	return nil
+}
+
+func resourceArmStorageTableEntityDelete(d *schema.ResourceData, meta interface{}) error {
+	armClient := meta.(*ArmClient)
+	ctx := armClient.StopContext
+
+	id, err := parseStorageTableEntityID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resourceGroup, err := determineResourceGroupForStorageAccount(id.storageAccountName, armClient)
+	if err != nil {
+		return err
+	}
+
+	if resourceGroup == nil {
+		log.Printf("[WARN] Unable to determine Resource Group for Storage Account %q (assuming removed)", id.storageAccountName)
+		return nil
+	}
+
+	tableClient, accountExists, err := armClient.getTableServiceClientForStorageAccount(ctx, *resourceGroup, id.storageAccountName)
+	if err != nil {
+		return err
+	}
+	if !accountExists {
+		log.Printf("[INFO] Storage Account %q doesn't exist so the Entity won't exist", id.storageAccountName)
+		return nil
+	}
+
+	table := tableClient.GetTableReference(id.tableName)
+	tableEntity := table.GetEntityReference(id.partitionKey, id.rowKey)
+
+	log.Printf("[INFO] Deleting Entity (Partition Key %q / Row Key %q) from Table %q (Storage Account %q)", id.partitionKey, id.rowKey, id.tableName, id.storageAccountName)
+	if err := tableEntity.Delete(true, &storage.EntityOptions{}); err != nil {
+		if storageTableEntityWasNotFound(err) {
+			return nil
+		}
+
+		return fmt.Errorf("Error deleting Entity (Partition Key %q / Row Key %q) from Table %q (Storage Account %q): %s", id.partitionKey, id.rowKey, id.tableName, id.storageAccountName, err)
+	}
+
+	return nil
+}
+
+func flattenStorageTableEntityProperties(input map[string]interface{}) map[string]interface{} {
+	output := make(map[string]interface{})
+
+	for k, v := range input {
+		// the OData type annotations aren't part of the Entity itself
+		if strings.HasSuffix(k, "@odata.type") {
+			continue
+		}
+
+		output[k] = fmt.Sprintf("%v", v)
+	}
+
+	return output
+}
+
+func storageTableEntityWasNotFound(err error) bool {
+	if storageErr, ok := err.(storage.AzureStorageServiceError); ok {
+		return storageErr.StatusCode == 404
+	}
+
+	return false
+}
+
+type storageTableEntityId struct {
+	storageAccountName string
+	tableName          string
+	partitionKey       string
+	rowKey             string
+}
+
+func parseStorageTableEntityID(input string) (*storageTableEntityId, error) {
+	// https://myaccount.table.core.windows.net/table1(PartitionKey='pk',RowKey='rk')
+	uri, err := url.Parse(input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a URI: %+v", input, err)
+	}
+
+	segments := strings.Split(uri.Host, ".")
+	if len(segments) == 0 || segments[0] == "" {
+		return nil, fmt.Errorf("Unable to determine the Storage Account Name from %q", input)
+	}
+
+	matches := regexp.MustCompile(`^/([A-Za-z0-9]+)\(PartitionKey='(.*)',RowKey='(.*)'\)$`).FindStringSubmatch(uri.Path)
+	if len(matches) != 4 {
+		return nil, fmt.Errorf("Expected the path of %q to be in the format `/table(PartitionKey='pk',RowKey='rk')`", input)
+	}
+
+	id := storageTableEntityId{
+		storageAccountName: segments[0],
+		tableName:          matches[1],
+		partitionKey:       matches[2],
+		rowKey:             matches[3],
+	}
+	return &id, nil
+}
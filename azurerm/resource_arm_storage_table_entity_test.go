@@ -0,0 +1,257 @@
+package azurerm
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/storage"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAzureRMStorageTableEntity_basic(t *testing.T) {
+	resourceName := "azurerm_storage_table_entity.test"
+	ri := acctest.RandInt()
+	rs := strings.ToLower(acctest.RandString(11))
+	config := testAccAzureRMStorageTableEntity_basic(ri, rs, testLocation())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMStorageTableEntityDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMStorageTableEntityExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "entity.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "entity.Foo", "Bar"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAzureRMStorageTableEntity_update(t *testing.T) {
+	resourceName := "azurerm_storage_table_entity.test"
+	ri := acctest.RandInt()
+	rs := strings.ToLower(acctest.RandString(11))
+	location := testLocation()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMStorageTableEntityDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMStorageTableEntity_basic(ri, rs, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMStorageTableEntityExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "entity.%", "1"),
+				),
+			},
+			{
+				Config: testAccAzureRMStorageTableEntity_updated(ri, rs, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMStorageTableEntityExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "entity.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "entity.Foo", "Bar"),
+					resource.TestCheckResourceAttr(resourceName, "entity.Test", "Updated"),
+				),
+			},
+		},
+	})
+}
+
+func TestParseStorageTableEntityID(t *testing.T) {
+	cases := []struct {
+		Input        string
+		Table        string
+		PartitionKey string
+		RowKey       string
+		ExpectError  bool
+	}{
+		{
+			Input:       "https://account1.table.core.windows.net/table1",
+			ExpectError: true,
+		},
+		{
+			Input:        "https://account1.table.core.windows.net/table1(PartitionKey='partition1',RowKey='row1')",
+			Table:        "table1",
+			PartitionKey: "partition1",
+			RowKey:       "row1",
+		},
+		{
+			Input:        "https://account1.table.core.windows.net/table1(PartitionKey='',RowKey='')",
+			Table:        "table1",
+			PartitionKey: "",
+			RowKey:       "",
+		},
+	}
+
+	for _, tc := range cases {
+		id, err := parseStorageTableEntityID(tc.Input)
+		if err != nil {
+			if tc.ExpectError {
+				continue
+			}
+
+			t.Fatalf("Expected no error for %q but got: %+v", tc.Input, err)
+		}
+
+		if tc.ExpectError {
+			t.Fatalf("Expected an error for %q but didn't get one", tc.Input)
+		}
+
+		if id.storageAccountName != "account1" {
+			t.Fatalf("Expected the Storage Account Name to be `account1` but got %q", id.storageAccountName)
+		}
+
+		if id.tableName != tc.Table || id.partitionKey != tc.PartitionKey || id.rowKey != tc.RowKey {
+			t.Fatalf("Expected %q / %q / %q but got %q / %q / %q", tc.Table, tc.PartitionKey, tc.RowKey, id.tableName, id.partitionKey, id.rowKey)
+		}
+	}
+}
+
+func testCheckAzureRMStorageTableEntityExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		tableName := rs.Primary.Attributes["table_name"]
+		storageAccountName := rs.Primary.Attributes["storage_account_name"]
+		resourceGroup := rs.Primary.Attributes["resource_group_name"]
+		partitionKey := rs.Primary.Attributes["partition_key"]
+		rowKey := rs.Primary.Attributes["row_key"]
+
+		armClient := testAccProvider.Meta().(*ArmClient)
+		ctx := armClient.StopContext
+		tableClient, accountExists, err := armClient.getTableServiceClientForStorageAccount(ctx, resourceGroup, storageAccountName)
+		if err != nil {
+			return err
+		}
+		if !accountExists {
+			return fmt.Errorf("Bad: Storage Account %q does not exist", storageAccountName)
+		}
+
+		table := tableClient.GetTableReference(tableName)
+		entity := table.GetEntityReference(partitionKey, rowKey)
+		if err := entity.Get(uint(60), storage.MinimalMetadata, &storage.GetEntityOptions{}); err != nil {
+			return fmt.Errorf("Bad: Entity (Partition Key %q / Row Key %q) in Table %q (Storage Account %q) does not exist: %+v", partitionKey, rowKey, tableName, storageAccountName, err)
+		}
+
+		return nil
+	}
+}
+
+func testCheckAzureRMStorageTableEntityDestroy(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_storage_table_entity" {
+			continue
+		}
+
+		tableName := rs.Primary.Attributes["table_name"]
+		storageAccountName := rs.Primary.Attributes["storage_account_name"]
+		resourceGroup := rs.Primary.Attributes["resource_group_name"]
+		partitionKey := rs.Primary.Attributes["partition_key"]
+		rowKey := rs.Primary.Attributes["row_key"]
+
+		armClient := testAccProvider.Meta().(*ArmClient)
+		ctx := armClient.StopContext
+		tableClient, accountExists, err := armClient.getTableServiceClientForStorageAccount(ctx, resourceGroup, storageAccountName)
+		if err != nil {
+			// If we can't get keys then the entity can't exist
+			return nil
+		}
+		if !accountExists {
+			return nil
+		}
+
+		table := tableClient.GetTableReference(tableName)
+		entity := table.GetEntityReference(partitionKey, rowKey)
+		if err := entity.Get(uint(60), storage.MinimalMetadata, &storage.GetEntityOptions{}); err != nil {
+			if storageTableEntityWasNotFound(err) {
+				return nil
+			}
+
+			return err
+		}
+
+		return fmt.Errorf("Bad: Entity (Partition Key %q / Row Key %q) in Table %q (Storage Account %q) still exists", partitionKey, rowKey, tableName, storageAccountName)
+	}
+
+	return nil
+}
+
+func testAccAzureRMStorageTableEntity_template(rInt int, rString string, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_storage_account" "test" {
+  name                     = "acctestacc%s"
+  resource_group_name      = "${azurerm_resource_group.test.name}"
+  location                 = "${azurerm_resource_group.test.location}"
+  account_tier             = "Standard"
+  account_replication_type = "LRS"
+}
+
+resource "azurerm_storage_table" "test" {
+  name                 = "acctestst%d"
+  resource_group_name  = "${azurerm_resource_group.test.name}"
+  storage_account_name = "${azurerm_storage_account.test.name}"
+}
+`, rInt, location, rString, rInt)
+}
+
+func testAccAzureRMStorageTableEntity_basic(rInt int, rString string, location string) string {
+	template := testAccAzureRMStorageTableEntity_template(rInt, rString, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_storage_table_entity" "test" {
+  resource_group_name  = "${azurerm_resource_group.test.name}"
+  storage_account_name = "${azurerm_storage_account.test.name}"
+  table_name           = "${azurerm_storage_table.test.name}"
+
+  partition_key = "test_partition%d"
+  row_key       = "test_row%d"
+
+  entity = {
+    Foo = "Bar"
+  }
+}
+`, template, rInt, rInt)
+}
+
+func testAccAzureRMStorageTableEntity_updated(rInt int, rString string, location string) string {
+	template := testAccAzureRMStorageTableEntity_template(rInt, rString, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_storage_table_entity" "test" {
+  resource_group_name  = "${azurerm_resource_group.test.name}"
+  storage_account_name = "${azurerm_storage_account.test.name}"
+  table_name           = "${azurerm_storage_table.test.name}"
+
+  partition_key = "test_partition%d"
+  row_key       = "test_row%d"
+
+  entity = {
+    Foo  = "Bar"
+    Test = "Updated"
+  }
+}
+`, template, rInt, rInt)
+}
@@ -4,8 +4,9 @@ import (
 	"fmt"
 	"log"
 
-	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2018-04-01/network"
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2018-08-01/network"
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
@@ -66,6 +67,60 @@ func resourceArmSubnet() *schema.Resource {
 				Optional: true,
 				Elem:     &schema.Schema{Type: schema.TypeString},
 			},
+
+			"delegation": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.NoZeroValues,
+						},
+						"service_delegation": {
+							Type:     schema.TypeList,
+							Required: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"name": {
+										Type:     schema.TypeString,
+										Required: true,
+										ValidateFunc: validation.StringInSlice([]string{
+											"Microsoft.BareMetal/AzureVMware",
+											"Microsoft.BareMetal/CrayServers",
+											"Microsoft.Batch/batchAccounts",
+											"Microsoft.ContainerInstance/containerGroups",
+											"Microsoft.Databricks/workspaces",
+											"Microsoft.HardwareSecurityModules/dedicatedHSMs",
+											"Microsoft.Logic/integrationServiceEnvironments",
+											"Microsoft.Netapp/volumes",
+											"Microsoft.ServiceFabricMesh/networks",
+											"Microsoft.Sql/managedInstances",
+											"Microsoft.Sql/servers",
+											"Microsoft.Web/serverFarms",
+										}, false),
+									},
+									"actions": {
+										Type:     schema.TypeList,
+										Optional: true,
+										Computed: true,
+										Elem: &schema.Schema{
+											Type: schema.TypeString,
+											ValidateFunc: validation.StringInSlice([]string{
+												"Microsoft.Network/virtualNetworks/subnets/action",
+												"Microsoft.Network/virtualNetworks/subnets/join/action",
+												"Microsoft.Network/virtualNetworks/subnets/prepareNetworkPolicies/action",
+											}, false),
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
 		},
 	}
 }
@@ -129,6 +184,9 @@ func resourceArmSubnetCreate(d *schema.ResourceData, meta interface{}) error {
 
 	properties.ServiceEndpoints = &serviceEndpoints
 
+	delegationsRaw := d.Get("delegation").([]interface{})
+	properties.Delegations = expandSubnetDelegation(delegationsRaw)
+
 	subnet := network.Subnet{
 		Name: &name,
 		SubnetPropertiesFormat: &properties,
@@ -205,6 +263,11 @@ func resourceArmSubnetRead(d *schema.ResourceData, meta interface{}) error {
 		if err := d.Set("service_endpoints", serviceEndpoints); err != nil {
 			return err
 		}
+
+		delegations := flattenSubnetDelegation(props.Delegations)
+		if err := d.Set("delegation", delegations); err != nil {
+			return fmt.Errorf("Error flattening `delegation`: %+v", err)
+		}
 	}
 
 	return nil
@@ -302,3 +365,68 @@ func flattenSubnetIPConfigurations(ipConfigurations *[]network.IPConfiguration)
 
 	return ips
 }
+
+func expandSubnetDelegation(input []interface{}) *[]network.Delegation {
+	retDelegations := make([]network.Delegation, 0)
+
+	for _, deleValue := range input {
+		deleData := deleValue.(map[string]interface{})
+		deleName := deleData["name"].(string)
+		srvDelegations := deleData["service_delegation"].([]interface{})
+		srvDelegation := srvDelegations[0].(map[string]interface{})
+		srvName := srvDelegation["name"].(string)
+		srvActions := srvDelegation["actions"].([]interface{})
+
+		retSrvActions := make([]string, 0)
+		for _, srvAction := range srvActions {
+			retSrvActions = append(retSrvActions, srvAction.(string))
+		}
+
+		retDelegation := network.Delegation{
+			Name: &deleName,
+			ServiceDelegationPropertiesFormat: &network.ServiceDelegationPropertiesFormat{
+				ServiceName: &srvName,
+				Actions:     &retSrvActions,
+			},
+		}
+
+		retDelegations = append(retDelegations, retDelegation)
+	}
+
+	return &retDelegations
+}
+
+func flattenSubnetDelegation(delegations *[]network.Delegation) []interface{} {
+	if delegations == nil {
+		return []interface{}{}
+	}
+
+	retDeles := make([]interface{}, 0)
+
+	for _, dele := range *delegations {
+		retDele := make(map[string]interface{})
+		if v := dele.Name; v != nil {
+			retDele["name"] = *v
+		}
+
+		svcDeles := make([]interface{}, 0)
+		svcDele := make(map[string]interface{})
+		if props := dele.ServiceDelegationPropertiesFormat; props != nil {
+			if v := props.ServiceName; v != nil {
+				svcDele["name"] = *v
+			}
+
+			if v := props.Actions; v != nil {
+				svcDele["actions"] = *v
+			}
+		}
+
+		svcDeles = append(svcDeles, svcDele)
+
+		retDele["service_delegation"] = svcDeles
+
+		retDeles = append(retDeles, retDele)
+	}
+
+	return retDeles
+}
@@ -0,0 +1,222 @@
+package azurerm
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"strconv"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/resources/mgmt/2018-05-01/resources"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmSubscriptionTemplateDeployment() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmSubscriptionTemplateDeploymentCreateUpdate,
+		Read:   resourceArmSubscriptionTemplateDeploymentRead,
+		Update: resourceArmSubscriptionTemplateDeploymentCreateUpdate,
+		Delete: resourceArmSubscriptionTemplateDeploymentDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+
+			// the location where the deployment data is stored, rather than where resources are deployed
+			"location": locationSchema(),
+
+			"template_body": {
+				Type:         schema.TypeString,
+				Required:     true,
+				StateFunc:    normalizeJson,
+				ValidateFunc: validation.ValidateJsonString,
+			},
+
+			"parameters_body": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				StateFunc:    normalizeJson,
+				ValidateFunc: validation.ValidateJsonString,
+			},
+
+			"outputs": {
+				Type:     schema.TypeMap,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceArmSubscriptionTemplateDeploymentCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).subscriptionDeploymentsClient
+	ctx := meta.(*ArmClient).StopContext
+
+	name := d.Get("name").(string)
+	location := azureRMNormalizeLocation(d.Get("location").(string))
+
+	log.Printf("[INFO] preparing arguments for AzureRM Subscription Template Deployment creation/update.")
+
+	template, err := expandTemplateBody(d.Get("template_body").(string))
+	if err != nil {
+		return err
+	}
+
+	// only Incremental deployments are supported at the Subscription scope
+	properties := resources.DeploymentProperties{
+		Mode:     resources.Incremental,
+		Template: &template,
+	}
+
+	if v, ok := d.GetOk("parameters_body"); ok {
+		params, err := expandParametersBody(v.(string))
+		if err != nil {
+			return err
+		}
+
+		properties.Parameters = &params
+	}
+
+	deployment := resources.Deployment{
+		Location:   utils.String(location),
+		Properties: &properties,
+	}
+
+	future, err := client.CreateOrUpdateAtSubscriptionScope(ctx, name, deployment)
+	if err != nil {
+		return fmt.Errorf("Error creating/updating Subscription Template Deployment %q: %+v", name, err)
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("Error waiting for creation/update of Subscription Template Deployment %q: %+v", name, err)
+	}
+
+	read, err := client.GetAtSubscriptionScope(ctx, name)
+	if err != nil {
+		return fmt.Errorf("Error retrieving Subscription Template Deployment %q: %+v", name, err)
+	}
+	if read.ID == nil {
+		return fmt.Errorf("Cannot read Subscription Template Deployment %q ID", name)
+	}
+
+	d.SetId(*read.ID)
+
+	return resourceArmSubscriptionTemplateDeploymentRead(d, meta)
+}
+
+func resourceArmSubscriptionTemplateDeploymentRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).subscriptionDeploymentsClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	name := id.Path["deployments"]
+
+	resp, err := client.GetAtSubscriptionScope(ctx, name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] Subscription Template Deployment %q was not found - removing from state", name)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error retrieving Subscription Template Deployment %q: %+v", name, err)
+	}
+
+	d.Set("name", resp.Name)
+	if location := resp.Location; location != nil {
+		d.Set("location", azureRMNormalizeLocation(*location))
+	}
+
+	outputs := make(map[string]string)
+	if props := resp.Properties; props != nil {
+		outputs = flattenTemplateDeploymentOutputs(props.Outputs)
+	}
+
+	if err := d.Set("outputs", outputs); err != nil {
+		return fmt.Errorf("Error setting `outputs`: %+v", err)
+	}
+
+	// the Template and Parameters are only returned via the Export API
+	if _, ok := d.GetOk("template_body"); !ok {
+		export, err := client.ExportTemplateAtSubscriptionScope(ctx, name)
+		if err != nil {
+			return fmt.Errorf("Error exporting the Template for Subscription Template Deployment %q: %+v", name, err)
+		}
+
+		if template := export.Template; template != nil {
+			body, err := flattenSubscriptionTemplateDeploymentTemplate(template)
+			if err != nil {
+				return err
+			}
+			d.Set("template_body", body)
+		}
+	}
+
+	return nil
+}
+
+func resourceArmSubscriptionTemplateDeploymentDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).subscriptionDeploymentsClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	name := id.Path["deployments"]
+
+	// NOTE: this only removes the Deployment record - resources created by the Template are left in place,
+	// which matches the behaviour of the `azurerm_template_deployment` resource
+	if _, err := client.DeleteAtSubscriptionScope(ctx, name); err != nil {
+		return fmt.Errorf("Error deleting Subscription Template Deployment %q: %+v", name, err)
+	}
+
+	return waitForSubscriptionTemplateDeploymentToBeDeleted(ctx, client, name)
+}
+
+func flattenSubscriptionTemplateDeploymentTemplate(input interface{}) (string, error) {
+	body, err := json.Marshal(input)
+	if err != nil {
+		return "", fmt.Errorf("Error serializing the Template: %+v", err)
+	}
+
+	return string(body), nil
+}
+
+func waitForSubscriptionTemplateDeploymentToBeDeleted(ctx context.Context, client resources.DeploymentsClient, name string) error {
+	// as with Resource Group scoped Deployments the API returns a 200 until it's deleted, so we poll for a 404
+	log.Printf("[DEBUG] Waiting for Subscription Template Deployment %q to be deleted", name)
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{"200"},
+		Target:  []string{"404"},
+		Refresh: func() (interface{}, string, error) {
+			res, err := client.GetAtSubscriptionScope(ctx, name)
+			if err != nil {
+				if utils.ResponseWasNotFound(res.Response) {
+					return res, strconv.Itoa(res.StatusCode), nil
+				}
+				return nil, "", fmt.Errorf("Error polling for the status of the Subscription Template Deployment %q: %+v", name, err)
+			}
+
+			return res, strconv.Itoa(res.StatusCode), nil
+		},
+		Timeout: 40 * time.Minute,
+	}
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("Error waiting for Subscription Template Deployment %q to be deleted: %+v", name, err)
+	}
+
+	return nil
+}
@@ -169,7 +169,39 @@ func resourceArmTemplateDeploymentRead(d *schema.ResourceData, meta interface{})
 	}
 
 	outputs := make(map[string]string)
-	if outs := resp.Properties.Outputs; outs != nil {
+	if props := resp.Properties; props != nil {
+		outputs = flattenTemplateDeploymentOutputs(props.Outputs)
+	}
+
+	return d.Set("outputs", outputs)
+}
+
+func resourceArmTemplateDeploymentDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient)
+	deployClient := client.deploymentsClient
+	ctx := client.StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	name := id.Path["deployments"]
+	if name == "" {
+		name = id.Path["Deployments"]
+	}
+
+	_, err = deployClient.Delete(ctx, resourceGroup, name)
+	if err != nil {
+		return err
+	}
+
+	return waitForTemplateDeploymentToBeDeleted(ctx, deployClient, resourceGroup, name)
+}
+
+func flattenTemplateDeploymentOutputs(outs interface{}) map[string]string {
+	outputs := make(map[string]string)
+	if outs != nil {
 		outsVal := outs.(map[string]interface{})
 		if len(outsVal) > 0 {
 			for key, output := range outsVal {
@@ -198,7 +230,7 @@ func resourceArmTemplateDeploymentRead(d *schema.ResourceData, meta interface{})
 					outputValueString = fmt.Sprint(outputValue)
 
 				default:
-					log.Printf("[WARN] Ignoring output %s: Outputs of type %s are not currently supported in azurerm_template_deployment.",
+					log.Printf("[WARN] Ignoring output %s: Outputs of type %s are not currently supported.",
 						key, outputType)
 					continue
 				}
@@ -207,30 +239,7 @@ func resourceArmTemplateDeploymentRead(d *schema.ResourceData, meta interface{})
 		}
 	}
 
-	return d.Set("outputs", outputs)
-}
-
-func resourceArmTemplateDeploymentDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ArmClient)
-	deployClient := client.deploymentsClient
-	ctx := client.StopContext
-
-	id, err := parseAzureResourceID(d.Id())
-	if err != nil {
-		return err
-	}
-	resourceGroup := id.ResourceGroup
-	name := id.Path["deployments"]
-	if name == "" {
-		name = id.Path["Deployments"]
-	}
-
-	_, err = deployClient.Delete(ctx, resourceGroup, name)
-	if err != nil {
-		return err
-	}
-
-	return waitForTemplateDeploymentToBeDeleted(ctx, deployClient, resourceGroup, name)
+	return outputs
 }
 
 // TODO: move this out into the new `helpers` structure
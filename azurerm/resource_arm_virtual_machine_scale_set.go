@@ -135,6 +135,22 @@ func resourceArmVirtualMachineScaleSet() *schema.Resource {
 				Default:  false,
 			},
 
+			"automatic_os_upgrade_policy": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"disable_automatic_rollback": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+					},
+				},
+			},
+
 			"rolling_upgrade_policy": {
 				Type:     schema.TypeList,
 				Optional: true,
@@ -788,6 +804,7 @@ func resourceArmVirtualMachineScaleSetCreate(d *schema.ResourceData, meta interf
 			Mode:                 compute.UpgradeMode(upgradePolicy),
 			AutomaticOSUpgrade:   utils.Bool(automaticOsUpgrade),
 			RollingUpgradePolicy: expandAzureRmRollingUpgradePolicy(d),
+			AutoOSUpgradePolicy:  expandAzureRmVirtualMachineScaleSetAutomaticOSUpgradePolicy(d),
 		},
 		VirtualMachineProfile: &compute.VirtualMachineScaleSetVMProfile{
 			NetworkProfile:   expandAzureRmVirtualMachineScaleSetNetworkProfile(d),
@@ -901,6 +918,10 @@ func resourceArmVirtualMachineScaleSetRead(d *schema.ResourceData, meta interfac
 			d.Set("upgrade_policy_mode", upgradePolicy.Mode)
 			d.Set("automatic_os_upgrade", upgradePolicy.AutomaticOSUpgrade)
 
+			if err := d.Set("automatic_os_upgrade_policy", flattenAzureRmVirtualMachineScaleSetAutomaticOSUpgradePolicy(upgradePolicy.AutoOSUpgradePolicy)); err != nil {
+				return fmt.Errorf("[DEBUG] Error setting Virtual Machine Scale Set Automatic OS Upgrade Policy error: %#v", err)
+			}
+
 			if rollingUpgradePolicy := upgradePolicy.RollingUpgradePolicy; rollingUpgradePolicy != nil {
 				if err := d.Set("rolling_upgrade_policy", flattenAzureRmVirtualMachineScaleSetRollingUpgradePolicy(rollingUpgradePolicy)); err != nil {
 					return fmt.Errorf("[DEBUG] Error setting Virtual Machine Scale Set Rolling Upgrade Policy error: %#v", err)
@@ -1174,6 +1195,23 @@ func flattenAzureRmVirtualMachineScaleSetBootDiagnostics(bootDiagnostic *compute
 	return []interface{}{b}
 }
 
+func flattenAzureRmVirtualMachineScaleSetAutomaticOSUpgradePolicy(input *compute.AutoOSUpgradePolicy) []interface{} {
+	if input == nil {
+		return make([]interface{}, 0)
+	}
+
+	disableAutomaticRollback := false
+	if v := input.DisableAutoRollback; v != nil {
+		disableAutomaticRollback = *v
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"disable_automatic_rollback": disableAutomaticRollback,
+		},
+	}
+}
+
 func flattenAzureRmVirtualMachineScaleSetRollingUpgradePolicy(rollingUpgradePolicy *compute.RollingUpgradePolicy) []interface{} {
 	b := make(map[string]interface{})
 
@@ -1566,6 +1604,16 @@ func expandVirtualMachineScaleSetSku(d *schema.ResourceData) (*compute.Sku, erro
 	return sku, nil
 }
 
+func expandAzureRmVirtualMachineScaleSetAutomaticOSUpgradePolicy(d *schema.ResourceData) *compute.AutoOSUpgradePolicy {
+	if config, ok := d.GetOk("automatic_os_upgrade_policy.0"); ok {
+		policy := config.(map[string]interface{})
+		return &compute.AutoOSUpgradePolicy{
+			DisableAutoRollback: utils.Bool(policy["disable_automatic_rollback"].(bool)),
+		}
+	}
+	return nil
+}
+
 func expandAzureRmRollingUpgradePolicy(d *schema.ResourceData) *compute.RollingUpgradePolicy {
 	if config, ok := d.GetOk("rolling_upgrade_policy.0"); ok {
 		policy := config.(map[string]interface{})
@@ -2191,5 +2239,40 @@ func azureRmVirtualMachineScaleSetCustomizeDiff(d *schema.ResourceDiff, _ interf
 			}
 		}
 	}
+
+	// Automatic OS Upgrades require the health of each instance to be monitored, either via
+	// a Load Balancer Health Probe or the Application Health extension
+	if d.Get("automatic_os_upgrade").(bool) {
+		if !azureRmVirtualMachineScaleSetHasHealthMonitoring(d) {
+			return fmt.Errorf("If `automatic_os_upgrade` is enabled, either `health_probe_id` must be set or an `extension` of type `ApplicationHealthLinux` / `ApplicationHealthWindows` must be configured")
+		}
+	} else if policyRaw, ok := d.GetOk("automatic_os_upgrade_policy.0"); ok && d.HasChange("automatic_os_upgrade_policy") {
+		policy := policyRaw.(map[string]interface{})
+		if policy["disable_automatic_rollback"].(bool) {
+			return fmt.Errorf("`automatic_os_upgrade_policy` can only be configured when `automatic_os_upgrade` is enabled")
+		}
+	}
+
 	return nil
 }
+
+func azureRmVirtualMachineScaleSetHasHealthMonitoring(d *schema.ResourceDiff) bool {
+	// these values may not be known until apply-time (e.g. when the Health Probe is created alongside the Scale Set)
+	if !d.NewValueKnown("health_probe_id") || !d.NewValueKnown("extension") {
+		return true
+	}
+
+	if v := d.Get("health_probe_id").(string); v != "" {
+		return true
+	}
+
+	for _, raw := range d.Get("extension").(*schema.Set).List() {
+		extension := raw.(map[string]interface{})
+		extensionType := strings.ToLower(extension["type"].(string))
+		if extensionType == "applicationhealthlinux" || extensionType == "applicationhealthwindows" {
+			return true
+		}
+	}
+
+	return false
+}
@@ -7,9 +7,10 @@ import (
 	"log"
 	"net/http"
 
-	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2018-04-01/network"
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2018-08-01/network"
 	"github.com/hashicorp/terraform/helper/hashcode"
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
@@ -44,6 +45,26 @@ func resourceArmVirtualNetwork() *schema.Resource {
 				},
 			},
 
+			"ddos_protection_plan": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: azure.ValidateResourceID,
+						},
+
+						"enable": {
+							Type:     schema.TypeBool,
+							Required: true,
+						},
+					},
+				},
+			},
+
 			"dns_servers": {
 				Type:     schema.TypeList,
 				Optional: true,
@@ -123,6 +144,17 @@ func resourceArmVirtualNetworkCreate(d *schema.ResourceData, meta interface{}) e
 	azureRMLockMultipleByName(&networkSecurityGroupNames, networkSecurityGroupResourceName)
 	defer azureRMUnlockMultipleByName(&networkSecurityGroupNames, networkSecurityGroupResourceName)
 
+	if plan := vnet.VirtualNetworkPropertiesFormat.DdosProtectionPlan; plan != nil && plan.ID != nil {
+		planId, err := parseAzureResourceID(*plan.ID)
+		if err != nil {
+			return err
+		}
+		planName := planId.Path["ddosProtectionPlans"]
+
+		azureRMLockByName(planName, ddosProtectionPlanResourceName)
+		defer azureRMUnlockByName(planName, ddosProtectionPlanResourceName)
+	}
+
 	future, err := client.CreateOrUpdate(ctx, resGroup, name, vnet)
 	if err != nil {
 		return fmt.Errorf("Error Creating/Updating Virtual Network %q (Resource Group %q): %+v", name, resGroup, err)
@@ -187,6 +219,9 @@ func resourceArmVirtualNetworkRead(d *schema.ResourceData, meta interface{}) err
 			return fmt.Errorf("Error setting `dns_servers`: %+v", err)
 		}
 
+		if err := d.Set("ddos_protection_plan", flattenVirtualNetworkDDoSProtectionPlan(props)); err != nil {
+			return fmt.Errorf("Error setting `ddos_protection_plan`: %+v", err)
+		}
 	}
 
 	flattenAndSetTags(d, resp.Tags)
@@ -289,9 +324,56 @@ func expandVirtualNetworkProperties(ctx context.Context, d *schema.ResourceData,
 		},
 		Subnets: &subnets,
 	}
+
+	if v, ok := d.GetOk("ddos_protection_plan"); ok {
+		rawList := v.([]interface{})
+
+		var ddosPlanId *string
+		var ddosEnabled *bool
+		if len(rawList) > 0 {
+			ddosPlan := rawList[0].(map[string]interface{})
+
+			if v, ok := ddosPlan["id"]; ok {
+				ddosPlanId = utils.String(v.(string))
+			}
+
+			if v, ok := ddosPlan["enable"]; ok {
+				ddosEnabled = utils.Bool(v.(bool))
+			}
+		}
+
+		if ddosPlanId != nil {
+			properties.DdosProtectionPlan = &network.SubResource{
+				ID: ddosPlanId,
+			}
+		}
+
+		if ddosEnabled != nil {
+			properties.EnableDdosProtection = ddosEnabled
+		}
+	}
+
 	// finally; return the struct:
 	return properties, nil
 }
+
+func flattenVirtualNetworkDDoSProtectionPlan(input *network.VirtualNetworkPropertiesFormat) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+
+	if input.DdosProtectionPlan == nil || input.DdosProtectionPlan.ID == nil || input.EnableDdosProtection == nil {
+		return []interface{}{}
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"id":     *input.DdosProtectionPlan.ID,
+			"enable": *input.EnableDdosProtection,
+		},
+	}
+}
+
 func flattenVirtualNetworkSubnets(input *[]network.Subnet) *schema.Set {
 	results := &schema.Set{
 		F: resourceAzureSubnetHash,
@@ -385,6 +467,14 @@ func getExistingSubnet(ctx context.Context, resGroup string, vnetName string, su
 		existingSubnet.SubnetPropertiesFormat.IPConfigurations = resp.SubnetPropertiesFormat.IPConfigurations
 	}
 
+	if resp.SubnetPropertiesFormat.ServiceEndpoints != nil {
+		existingSubnet.SubnetPropertiesFormat.ServiceEndpoints = resp.SubnetPropertiesFormat.ServiceEndpoints
+	}
+
+	if resp.SubnetPropertiesFormat.Delegations != nil {
+		existingSubnet.SubnetPropertiesFormat.Delegations = resp.SubnetPropertiesFormat.Delegations
+	}
+
 	return &existingSubnet, nil
 }
 
@@ -55,6 +55,15 @@ func testSweepVirtualNetworks(region string) error {
 			continue
 		}
 
+		inUse, err := sweeperResourceGroupInUse(ctx, armClient, resourceGroupName)
+		if err != nil {
+			return err
+		}
+
+		if inUse {
+			continue
+		}
+
 		log.Printf("Deleting Virtual Network %q", name)
 		future, err := client.Delete(ctx, resourceGroupName, name)
 		if err != nil {
@@ -105,6 +114,72 @@ func TestAccAzureRMVirtualNetwork_basic(t *testing.T) {
 	})
 }
 
+func TestAccAzureRMVirtualNetwork_basicUpdated(t *testing.T) {
+	resourceName := "azurerm_virtual_network.test"
+	ri := acctest.RandInt()
+	location := testLocation()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMVirtualNetworkDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMVirtualNetwork_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMVirtualNetworkExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "ddos_protection_plan.#", "0"),
+				),
+			},
+			{
+				Config: testAccAzureRMVirtualNetwork_ddosProtectionPlan(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMVirtualNetworkExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "ddos_protection_plan.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "ddos_protection_plan.0.enable", "true"),
+					resource.TestCheckResourceAttrSet(resourceName, "ddos_protection_plan.0.id"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAzureRMVirtualNetwork_updateWithSubnetServiceEndpoints(t *testing.T) {
+	resourceName := "azurerm_virtual_network.test"
+	subnetResourceName := "azurerm_subnet.test"
+	ri := acctest.RandInt()
+	location := testLocation()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMVirtualNetworkDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMVirtualNetwork_subnetServiceEndpoints(ri, location, "Production"),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMVirtualNetworkExists(resourceName),
+					resource.TestCheckResourceAttr(subnetResourceName, "service_endpoints.#", "1"),
+				),
+			},
+			{
+				// updating the Virtual Network shouldn't remove the Service Endpoints from the existing Subnet
+				Config: testAccAzureRMVirtualNetwork_subnetServiceEndpoints(ri, location, "staging"),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMVirtualNetworkExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.environment", "staging"),
+					resource.TestCheckResourceAttr(subnetResourceName, "service_endpoints.#", "1"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccAzureRMVirtualNetwork_disappears(t *testing.T) {
 	resourceName := "azurerm_virtual_network.test"
 	ri := acctest.RandInt()
@@ -294,6 +369,66 @@ resource "azurerm_virtual_network" "test" {
 `, rInt, location, rInt)
 }
 
+func testAccAzureRMVirtualNetwork_ddosProtectionPlan(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_network_ddos_protection_plan" "test" {
+  name                = "acctestddospplan-%d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+}
+
+resource "azurerm_virtual_network" "test" {
+  name                = "acctestvirtnet%d"
+  address_space       = ["10.0.0.0/16"]
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+
+  ddos_protection_plan {
+    id     = "${azurerm_network_ddos_protection_plan.test.id}"
+    enable = true
+  }
+
+  subnet {
+    name           = "subnet1"
+    address_prefix = "10.0.1.0/24"
+  }
+}
+`, rInt, location, rInt, rInt)
+}
+
+func testAccAzureRMVirtualNetwork_subnetServiceEndpoints(rInt int, location string, environment string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_virtual_network" "test" {
+  name                = "acctestvirtnet%d"
+  address_space       = ["10.0.0.0/16"]
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+
+  tags {
+    environment = "%s"
+  }
+}
+
+resource "azurerm_subnet" "test" {
+  name                 = "acctestsubnet%d"
+  resource_group_name  = "${azurerm_resource_group.test.name}"
+  virtual_network_name = "${azurerm_virtual_network.test.name}"
+  address_prefix       = "10.0.1.0/24"
+  service_endpoints    = ["Microsoft.Sql"]
+}
+`, rInt, location, rInt, environment, rInt)
+}
+
 func testAccAzureRMVirtualNetwork_withTags(rInt int, location string) string {
 	return fmt.Sprintf(`
 resource "azurerm_resource_group" "test" {
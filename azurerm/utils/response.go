@@ -11,6 +11,10 @@ func ResponseWasNotFound(resp autorest.Response) bool {
 	return responseWasStatusCode(resp, http.StatusNotFound)
 }
 
+func ResponseWasBadRequest(resp autorest.Response) bool {
+	return responseWasStatusCode(resp, http.StatusBadRequest)
+}
+
 func ResponseErrorIsRetryable(err error) bool {
 	if arerr, ok := err.(autorest.DetailedError); ok {
 		err = arerr.Original